@@ -2,43 +2,52 @@ package main
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
-	"net"
-	"net/http"
-	"os"
-	"os/signal"
-	"syscall"
+	"log/slog"
 	"time"
 
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/reflection"
 
 	partApiV1 "github.com/xgmsx/rsf/inventory/internal/api/v1/part"
+	"github.com/xgmsx/rsf/inventory/internal/config"
 	partRepo "github.com/xgmsx/rsf/inventory/internal/repository/part"
 	partService "github.com/xgmsx/rsf/inventory/internal/service/part"
+	"github.com/xgmsx/rsf/shared/pkg/app"
+	"github.com/xgmsx/rsf/shared/pkg/auth"
 	"github.com/xgmsx/rsf/shared/pkg/interceptor"
+	"github.com/xgmsx/rsf/shared/pkg/logger"
 	genInventoryV1 "github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1"
-	"github.com/xgmsx/rsf/shared/pkg/swagger"
+	"github.com/xgmsx/rsf/shared/pkg/tracing"
 )
 
-const (
-	grpcPort = 50051
-	httpPort = 8080
-)
+// serviceName - имя сервиса в трассировке и логах
+const serviceName = "api-inventory"
 
 func main() {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
+	if err := run(); err != nil {
+		logger.Fatal("ошибка работы сервиса", slog.Any("error", err))
+	}
+}
+
+// run запускает сервис и возвращает управление после его остановки
+func run() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("ошибка конфигурации: %w", err)
+	}
+	err = logger.Init(serviceName, cfg.LogLevel)
 	if err != nil {
-		log.Printf("failed to listen: %v\n", err)
-		return
+		return fmt.Errorf("ошибка инициализации логгера: %w", err)
+	}
+
+	// Инициализируем трассировку до создания серверов
+	shutdownTracing, err := tracing.Init(context.Background(), serviceName, cfg.Tracing)
+	if err != nil {
+		return fmt.Errorf("ошибка инициализации трассировки: %w", err)
 	}
 	defer func() {
-		if cerr := lis.Close(); cerr != nil {
-			log.Printf("failed to close listener: %v\n", cerr)
+		if terr := shutdownTracing(context.Background()); terr != nil {
+			slog.Error("failed to shutdown tracing", slog.Any("error", terr))
 		}
 	}()
 
@@ -47,80 +56,48 @@ func main() {
 	service := partService.NewPartService(repo)
 	api := partApiV1.NewPartAPI(service)
 
-	// Инициализируем gRPC сервер
-	server := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			grpc.UnaryServerInterceptor(interceptor.LoggerInterceptor()),
-		),
-	)
-	genInventoryV1.RegisterInventoryServiceServer(server, api)
-	reflection.Register(server)
-
-	// Запускаем gRPC сервер
-	go func() {
-		log.Printf("🚀 gRPC server listening on %d\n", grpcPort)
-		err = server.Serve(lis)
-		if err != nil {
-			log.Printf("failed to serve: %v\n", err)
-			return
-		}
-	}()
-
-	// Инициализируем HTTP сервер с gRPC Gateway и Swagger UI
-	go func() {
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-
-		// Создаем мультиплексор для HTTP запросов в gRPC-gateway
-		mux := runtime.NewServeMux()
-		err = genInventoryV1.RegisterInventoryServiceHandlerFromEndpoint(
-			ctx,
-			mux,
-			fmt.Sprintf("localhost:%d", grpcPort),
-			[]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())},
-		)
-		if err != nil {
-			log.Printf("Failed to register gateway: %v\n", err)
-			return
+	var interceptors []grpc.UnaryServerInterceptor
+	if cfg.AuthEnabled() {
+		verifier, authErr := auth.NewVerifier(cfg.Auth())
+		if authErr != nil {
+			return fmt.Errorf("ошибка инициализации проверки токенов: %w", authErr)
 		}
+		interceptors = append(interceptors, interceptor.AuthInterceptor(verifier))
+	}
 
-		// Создаем мультиплексор для Swagger UI
-		httpMux := http.NewServeMux()
-		httpMux.Handle("/api/", mux)
-
-		httpMux.Handle("/swagger/", swagger.NewSwaggerHandler(
-			"/swagger/", "inventory.swagger.json", "api"))
-
-		httpMux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if r.URL.Path == "/" {
-				http.Redirect(w, r, "/swagger/", http.StatusMovedPermanently)
+	// Запускаем gRPC сервер и HTTP gateway со Swagger UI
+	a := app.New(app.Config{
+		Name:              serviceName,
+		GRPCPort:          cfg.GRPCPort,
+		HTTPPort:          cfg.HTTPPort,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		ShutdownTimeout:   cfg.ShutdownTimeout,
+		SwaggerFile:       "inventory.swagger.json",
+		Interceptors:      interceptors,
+	})
+	a.RegisterService(&genInventoryV1.InventoryService_ServiceDesc, api,
+		genInventoryV1.RegisterInventoryServiceHandlerFromEndpoint)
+
+	// Периодически возвращаем на склад детали из истекших резервов
+	a.Go(func(ctx context.Context) {
+		ticker := time.NewTicker(cfg.ReservationExpiryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
 				return
+			case <-ticker.C:
+				expired, expErr := service.ExpireReservations(ctx)
+				if expErr != nil {
+					slog.ErrorContext(ctx, "failed to expire reservations", slog.Any("error", expErr))
+					continue
+				}
+				if expired > 0 {
+					slog.InfoContext(ctx, "reservations expired", slog.Int("count", expired))
+				}
 			}
-			http.NotFound(w, r)
-		}))
-
-		// Создаем HTTP gateway сервер
-		gwServer := &http.Server{
-			Addr:              fmt.Sprintf(":%d", httpPort),
-			Handler:           httpMux,
-			ReadHeaderTimeout: 10 * time.Second,
-		}
-
-		// Запускаем HTTP сервер
-		log.Printf("🌐 HTTP server with gRPC-Gateway and Swagger UI listening on %d\n", httpPort)
-		err = gwServer.ListenAndServe()
-		if err != nil && errors.Is(err, http.ErrServerClosed) {
-			log.Printf("Failed to serve HTTP: %v\n", err)
-			return
 		}
-	}()
-
-	// Graceful shutdown
-	notify := make(chan os.Signal, 1)
-	signal.Notify(notify, syscall.SIGINT, syscall.SIGTERM)
-	<-notify
+	})
 
-	log.Println("🛑 Завершение работы сервера...")
-	server.GracefulStop()
-	log.Println("✅ Сервер остановлен")
+	return a.Run(context.Background())
 }
@@ -27,11 +27,6 @@ func (h *partAPI) GetPart(ctx context.Context, req *genInventoryV1.GetPartReques
 }
 
 func (h *partAPI) ListParts(ctx context.Context, req *genInventoryV1.ListPartsRequest) (*genInventoryV1.ListPartsResponse, error) {
-	err := req.ValidateAll()
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "validate error: %v", err)
-	}
-
 	parts, err := h.service.ListParts(ctx, converter.PartFilterFromProto(req.Filter))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "internal error: %v", err)
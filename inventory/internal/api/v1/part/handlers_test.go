@@ -2,13 +2,17 @@ package part
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/brianvoe/gofakeit/v7"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/xgmsx/rsf/inventory/internal/model"
 	"github.com/xgmsx/rsf/inventory/internal/model/converter"
 	"github.com/xgmsx/rsf/inventory/tests/testutil"
+	"github.com/xgmsx/rsf/shared/pkg/interceptor"
 	genInventoryV1 "github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1"
 )
 
@@ -65,7 +69,7 @@ func (s *ServiceSuite) TestListPartsHandlerSuccess() {
 			tc.setupMock(converter.PartFilterFromProto(tc.gotFilter), tc.gotParts, tc.gotErr)
 
 			// act
-			resp, err := s.api.ListParts(s.ctx, &genInventoryV1.ListPartsRequest{Filter: tc.gotFilter})
+			resp, err := interceptor.InvokeValidated(s.ctx, &genInventoryV1.ListPartsRequest{Filter: tc.gotFilter}, s.api.ListParts)
 
 			// assert
 			if tc.expectedCode == codes.OK {
@@ -85,3 +89,199 @@ func (s *ServiceSuite) TestListPartsHandlerSuccess() {
 		})
 	}
 }
+
+func (s *ServiceSuite) TestReservePartsHandler() {
+	reservationID := gofakeit.UUID()
+	items := []*genInventoryV1.ReservationItem{{PartUuid: gofakeit.UUID(), Quantity: 2}}
+
+	testCases := []struct {
+		name         string
+		req          *genInventoryV1.ReservePartsRequest
+		gotErr       error
+		expectedCode codes.Code
+		setupMock    func(*genInventoryV1.ReservePartsRequest, error)
+	}{
+		{
+			name: "Happy path",
+			req:  &genInventoryV1.ReservePartsRequest{ReservationId: reservationID, Items: items},
+			setupMock: func(req *genInventoryV1.ReservePartsRequest, err error) {
+				s.service.On("ReserveParts", s.ctx, req.ReservationId, converter.ReservationItemsFromProto(req.Items), time.Duration(0)).
+					Return(model.Reservation{ID: req.ReservationId, Status: model.ReservationStatus_RESERVATION_STATUS_PENDING}, err).Once()
+			},
+		},
+		{
+			name:         "Insufficient stock",
+			req:          &genInventoryV1.ReservePartsRequest{ReservationId: reservationID, Items: items, Ttl: durationpb.New(time.Minute)},
+			gotErr:       fmt.Errorf("part: %w", model.ErrInsufficientStock),
+			expectedCode: codes.FailedPrecondition,
+			setupMock: func(req *genInventoryV1.ReservePartsRequest, err error) {
+				s.service.On("ReserveParts", s.ctx, req.ReservationId, converter.ReservationItemsFromProto(req.Items), time.Minute).
+					Return(model.Reservation{}, err).Once()
+			},
+		},
+		{
+			name:         "Reservation id reused",
+			req:          &genInventoryV1.ReservePartsRequest{ReservationId: reservationID, Items: items},
+			gotErr:       model.ErrReservationExists,
+			expectedCode: codes.AlreadyExists,
+			setupMock: func(req *genInventoryV1.ReservePartsRequest, err error) {
+				s.service.On("ReserveParts", s.ctx, req.ReservationId, converter.ReservationItemsFromProto(req.Items), time.Duration(0)).
+					Return(model.Reservation{}, err).Once()
+			},
+		},
+		{
+			name:         "Request validation error",
+			req:          &genInventoryV1.ReservePartsRequest{ReservationId: reservationID},
+			expectedCode: codes.InvalidArgument,
+			setupMock:    func(req *genInventoryV1.ReservePartsRequest, err error) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			tc.setupMock(tc.req, tc.gotErr)
+
+			// act
+			resp, err := interceptor.InvokeValidated(s.ctx, tc.req, s.api.ReserveParts)
+
+			// assert
+			if tc.expectedCode == codes.OK {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.req.ReservationId, resp.GetReservation().GetReservationId())
+				s.Require().Equal(genInventoryV1.ReservationStatus_RESERVATION_STATUS_PENDING, resp.GetReservation().GetStatus())
+			} else {
+				s.Require().Nil(resp)
+
+				st, ok := status.FromError(err)
+				s.Require().True(ok)
+				s.Require().Equal(tc.expectedCode, st.Code())
+			}
+		})
+	}
+}
+
+func (s *ServiceSuite) TestGetPartHandler() {
+	part := testutil.GetNewPart()
+
+	testCases := []struct {
+		name         string
+		uuid         string
+		gotErr       error
+		expectedCode codes.Code
+		setupMock    func(uuid string, err error)
+	}{
+		{
+			name: "Happy path",
+			uuid: part.UUID,
+			setupMock: func(uuid string, err error) {
+				s.service.On("GetPart", s.ctx, uuid).Return(part, err).Once()
+			},
+		},
+		{
+			name:         "Part not found",
+			uuid:         part.UUID,
+			gotErr:       model.ErrPartDoesNotExist,
+			expectedCode: codes.NotFound,
+			setupMock: func(uuid string, err error) {
+				s.service.On("GetPart", s.ctx, uuid).Return(model.Part{}, err).Once()
+			},
+		},
+		{
+			name:         "Request validation error",
+			uuid:         "invalid-uuid",
+			expectedCode: codes.InvalidArgument,
+			setupMock:    func(uuid string, err error) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			tc.setupMock(tc.uuid, tc.gotErr)
+
+			// act
+			resp, err := interceptor.InvokeValidated(s.ctx, &genInventoryV1.GetPartRequest{Uuid: tc.uuid}, s.api.GetPart)
+
+			// assert
+			if tc.expectedCode == codes.OK {
+				s.Require().NoError(err)
+				s.Require().Equal(converter.PartToProto(part), resp.GetPart())
+			} else {
+				s.Require().Nil(resp)
+
+				st, ok := status.FromError(err)
+				s.Require().True(ok)
+				s.Require().Equal(tc.expectedCode, st.Code())
+			}
+		})
+	}
+}
+
+func (s *ServiceSuite) TestReservationHandlersValidation() {
+	testCases := []struct {
+		name          string
+		call          func(reservationID string) error
+		setupMock     func(reservationID string)
+		reservationID string
+		expectedCode  codes.Code
+	}{
+		{
+			name: "Commit",
+			call: func(reservationID string) error {
+				_, err := interceptor.InvokeValidated(s.ctx, &genInventoryV1.CommitReservationRequest{ReservationId: reservationID}, s.api.CommitReservation)
+				return err
+			},
+			setupMock: func(reservationID string) {
+				s.service.On("CommitReservation", s.ctx, reservationID).
+					Return(model.Reservation{ID: reservationID, Status: model.ReservationStatus_RESERVATION_STATUS_COMMITTED}, nil).Once()
+			},
+			reservationID: gofakeit.UUID(),
+		},
+		{
+			name: "Commit with malformed reservation id",
+			call: func(reservationID string) error {
+				_, err := interceptor.InvokeValidated(s.ctx, &genInventoryV1.CommitReservationRequest{ReservationId: reservationID}, s.api.CommitReservation)
+				return err
+			},
+			setupMock:     func(string) {},
+			reservationID: "reservation-1",
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Release",
+			call: func(reservationID string) error {
+				_, err := interceptor.InvokeValidated(s.ctx, &genInventoryV1.ReleaseReservationRequest{ReservationId: reservationID}, s.api.ReleaseReservation)
+				return err
+			},
+			setupMock: func(reservationID string) {
+				s.service.On("ReleaseReservation", s.ctx, reservationID).
+					Return(model.Reservation{ID: reservationID, Status: model.ReservationStatus_RESERVATION_STATUS_RELEASED}, nil).Once()
+			},
+			reservationID: gofakeit.UUID(),
+		},
+		{
+			name: "Release with malformed reservation id",
+			call: func(reservationID string) error {
+				_, err := interceptor.InvokeValidated(s.ctx, &genInventoryV1.ReleaseReservationRequest{ReservationId: reservationID}, s.api.ReleaseReservation)
+				return err
+			},
+			setupMock:     func(string) {},
+			reservationID: "reservation-1",
+			expectedCode:  codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			tc.setupMock(tc.reservationID)
+
+			// act
+			err := tc.call(tc.reservationID)
+
+			// assert
+			s.Require().Equal(tc.expectedCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package part
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/xgmsx/rsf/inventory/internal/model"
+	"github.com/xgmsx/rsf/inventory/internal/model/converter"
+	genInventoryV1 "github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1"
+)
+
+func (h *partAPI) ReserveParts(ctx context.Context, req *genInventoryV1.ReservePartsRequest) (*genInventoryV1.ReservePartsResponse, error) {
+	reservation, err := h.service.ReserveParts(
+		ctx,
+		req.GetReservationId(),
+		converter.ReservationItemsFromProto(req.GetItems()),
+		req.GetTtl().AsDuration(),
+	)
+	if err != nil {
+		return nil, reservationError(err)
+	}
+
+	return &genInventoryV1.ReservePartsResponse{
+		Reservation: converter.ReservationToProto(reservation),
+	}, nil
+}
+
+func (h *partAPI) CommitReservation(ctx context.Context, req *genInventoryV1.CommitReservationRequest) (*genInventoryV1.CommitReservationResponse, error) {
+	reservation, err := h.service.CommitReservation(ctx, req.GetReservationId())
+	if err != nil {
+		return nil, reservationError(err)
+	}
+
+	return &genInventoryV1.CommitReservationResponse{
+		Reservation: converter.ReservationToProto(reservation),
+	}, nil
+}
+
+func (h *partAPI) ReleaseReservation(ctx context.Context, req *genInventoryV1.ReleaseReservationRequest) (*genInventoryV1.ReleaseReservationResponse, error) {
+	reservation, err := h.service.ReleaseReservation(ctx, req.GetReservationId())
+	if err != nil {
+		return nil, reservationError(err)
+	}
+
+	return &genInventoryV1.ReleaseReservationResponse{
+		Reservation: converter.ReservationToProto(reservation),
+	}, nil
+}
+
+// reservationError преобразует ошибку резервирования в gRPC статус
+func reservationError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrPartDoesNotExist), errors.Is(err, model.ErrReservationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrReservationExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, model.ErrInsufficientStock),
+		errors.Is(err, model.ErrReservationExpired),
+		errors.Is(err, model.ErrReservationReleased):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
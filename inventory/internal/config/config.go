@@ -0,0 +1,66 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/xgmsx/rsf/shared/pkg/auth"
+	"github.com/xgmsx/rsf/shared/pkg/config"
+	"github.com/xgmsx/rsf/shared/pkg/tracing"
+)
+
+// Config - настройки api-inventory
+type Config struct {
+	GRPCPort int `env:"INVENTORY_GRPC_PORT" default:"50051" min:"1" max:"65535"`
+	HTTPPort int `env:"INVENTORY_HTTP_PORT" default:"8080" min:"1" max:"65535"`
+	// ReadHeaderTimeout ограничивает чтение заголовков запроса HTTP gateway
+	ReadHeaderTimeout time.Duration `env:"INVENTORY_READ_HEADER_TIMEOUT" default:"10s" min:"1ms"`
+	// ShutdownTimeout ограничивает каждый этап остановки: HTTP gateway,
+	// gRPC сервер и фоновые задачи
+	ShutdownTimeout time.Duration `env:"INVENTORY_SHUTDOWN_TIMEOUT" default:"10s" min:"1ms"`
+	// LogLevel - минимальный уровень записей лога
+	LogLevel string `env:"INVENTORY_LOG_LEVEL" default:"info" oneof:"debug info warn error"`
+	// ReservationExpiryInterval - период проверки истекших резервов
+	ReservationExpiryInterval time.Duration `env:"INVENTORY_RESERVATION_EXPIRY_INTERVAL" default:"30s" min:"1ms"`
+
+	// Проверка токенов вызывающих включается, если задан HMAC-секрет или JWKS-файл
+	JWTSecret   string `env:"INVENTORY_JWT_SECRET"`
+	JWKSFile    string `env:"INVENTORY_JWKS_FILE"`
+	JWTIssuer   string `env:"INVENTORY_JWT_ISSUER"`
+	JWTAudience string `env:"INVENTORY_JWT_AUDIENCE"`
+
+	Tracing tracing.Config
+}
+
+func (c Config) Validate() error {
+	var errs []error
+	if c.GRPCPort == c.HTTPPort {
+		errs = append(errs, errors.New("INVENTORY_GRPC_PORT and INVENTORY_HTTP_PORT must differ"))
+	}
+	if c.JWTSecret != "" && c.JWKSFile != "" {
+		errs = append(errs, errors.New("only one of INVENTORY_JWT_SECRET and INVENTORY_JWKS_FILE can be set"))
+	}
+	return errors.Join(errs...)
+}
+
+// AuthEnabled сообщает, нужно ли проверять токены вызывающих
+func (c Config) AuthEnabled() bool {
+	return c.JWTSecret != "" || c.JWKSFile != ""
+}
+
+// Auth возвращает настройки проверки токенов
+func (c Config) Auth() auth.Config {
+	return auth.Config{
+		Secret:   c.JWTSecret,
+		JWKSFile: c.JWKSFile,
+		Issuer:   c.JWTIssuer,
+		Audience: c.JWTAudience,
+	}
+}
+
+// Load читает настройки из переменных окружения и файла из CONFIG_FILE
+func Load() (Config, error) {
+	var cfg Config
+	err := config.Load(&cfg)
+	return cfg, err
+}
@@ -4,6 +4,8 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/xgmsx/rsf/inventory/internal/model"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+	genCommonV1 "github.com/xgmsx/rsf/shared/pkg/proto/common/v1"
 	genInventoryV1 "github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1"
 )
 
@@ -29,7 +31,8 @@ func PartToProto(p model.Part) *genInventoryV1.Part {
 		Uuid:          p.UUID,
 		Name:          p.Name,
 		Description:   p.Description,
-		Price:         p.Price,
+		Price:         p.Price.Float64(), //nolint:staticcheck // устаревшее поле заполняется для старых клиентов
+		PriceMoney:    MoneyToProto(p.Price),
 		StockQuantity: p.StockQuantity,
 		Category:      genInventoryV1.Category(p.Category),
 		Dimensions:    DimensionsToProto(p.Dimensions),
@@ -101,3 +104,10 @@ func ValueToProto(v *model.Value) *genInventoryV1.Value {
 		return &genInventoryV1.Value{}
 	}
 }
+
+func MoneyToProto(m money.Money) *genCommonV1.Money {
+	return &genCommonV1.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
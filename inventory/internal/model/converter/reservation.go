@@ -0,0 +1,36 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/xgmsx/rsf/inventory/internal/model"
+	genInventoryV1 "github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1"
+)
+
+func ReservationItemsFromProto(items []*genInventoryV1.ReservationItem) []model.ReservationItem {
+	result := make([]model.ReservationItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, model.ReservationItem{
+			PartUUID: item.GetPartUuid(),
+			Quantity: item.GetQuantity(),
+		})
+	}
+	return result
+}
+
+func ReservationToProto(r model.Reservation) *genInventoryV1.Reservation {
+	items := make([]*genInventoryV1.ReservationItem, 0, len(r.Items))
+	for _, item := range r.Items {
+		items = append(items, &genInventoryV1.ReservationItem{
+			PartUuid: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+	return &genInventoryV1.Reservation{
+		ReservationId: r.ID,
+		Items:         items,
+		Status:        genInventoryV1.ReservationStatus(r.Status),
+		ExpiresAt:     timestamppb.New(r.ExpiresAt),
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+	}
+}
@@ -2,4 +2,12 @@ package model
 
 import "errors"
 
-var ErrPartDoesNotExist = errors.New("part does not exist")
+var (
+	ErrPartDoesNotExist = errors.New("part does not exist")
+
+	ErrInsufficientStock   = errors.New("insufficient stock")
+	ErrReservationNotFound = errors.New("reservation not found")
+	ErrReservationExists   = errors.New("reservation with this id already exists with different items")
+	ErrReservationExpired  = errors.New("reservation expired")
+	ErrReservationReleased = errors.New("reservation released")
+)
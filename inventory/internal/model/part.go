@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/xgmsx/rsf/shared/pkg/money"
+)
 
 type PartCategory string
 
@@ -36,7 +40,7 @@ type Part struct {
 	UUID          string
 	Name          string
 	Description   string
-	Price         float64
+	Price         money.Money
 	StockQuantity int64
 	Category      Category
 	Dimensions    *Dimensions
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+type ReservationStatus int32
+
+const (
+	ReservationStatus_RESERVATION_STATUS_UNSPECIFIED ReservationStatus = 0
+	ReservationStatus_RESERVATION_STATUS_PENDING     ReservationStatus = 1
+	ReservationStatus_RESERVATION_STATUS_COMMITTED   ReservationStatus = 2
+	ReservationStatus_RESERVATION_STATUS_RELEASED    ReservationStatus = 3
+	ReservationStatus_RESERVATION_STATUS_EXPIRED     ReservationStatus = 4
+)
+
+type ReservationItem struct {
+	PartUUID string
+	Quantity int64
+}
+
+type Reservation struct {
+	ID        string
+	Items     []ReservationItem
+	Status    ReservationStatus
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	// ClosedAt - время снятия или истечения резерва
+	ClosedAt time.Time
+}
@@ -22,6 +22,119 @@ func (_m *PartRepository) EXPECT() *PartRepository_Expecter {
 	return &PartRepository_Expecter{mock: &_m.Mock}
 }
 
+// CommitReservation provides a mock function with given fields: ctx, reservationID
+func (_m *PartRepository) CommitReservation(ctx context.Context, reservationID string) (model.Reservation, error) {
+	ret := _m.Called(ctx, reservationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Reservation, error)); ok {
+		return rf(ctx, reservationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Reservation); ok {
+		r0 = rf(ctx, reservationID)
+	} else {
+		r0 = ret.Get(0).(model.Reservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, reservationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartRepository_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type PartRepository_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationID string
+func (_e *PartRepository_Expecter) CommitReservation(ctx interface{}, reservationID interface{}) *PartRepository_CommitReservation_Call {
+	return &PartRepository_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, reservationID)}
+}
+
+func (_c *PartRepository_CommitReservation_Call) Run(run func(ctx context.Context, reservationID string)) *PartRepository_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PartRepository_CommitReservation_Call) Return(_a0 model.Reservation, _a1 error) *PartRepository_CommitReservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartRepository_CommitReservation_Call) RunAndReturn(run func(context.Context, string) (model.Reservation, error)) *PartRepository_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireReservations provides a mock function with given fields: ctx
+func (_m *PartRepository) ExpireReservations(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireReservations")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartRepository_ExpireReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireReservations'
+type PartRepository_ExpireReservations_Call struct {
+	*mock.Call
+}
+
+// ExpireReservations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PartRepository_Expecter) ExpireReservations(ctx interface{}) *PartRepository_ExpireReservations_Call {
+	return &PartRepository_ExpireReservations_Call{Call: _e.mock.On("ExpireReservations", ctx)}
+}
+
+func (_c *PartRepository_ExpireReservations_Call) Run(run func(ctx context.Context)) *PartRepository_ExpireReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PartRepository_ExpireReservations_Call) Return(_a0 int, _a1 error) *PartRepository_ExpireReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartRepository_ExpireReservations_Call) RunAndReturn(run func(context.Context) (int, error)) *PartRepository_ExpireReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, uuid
 func (_m *PartRepository) GetPart(ctx context.Context, uuid string) (model.Part, error) {
 	ret := _m.Called(ctx, uuid)
@@ -138,6 +251,120 @@ func (_c *PartRepository_ListParts_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ReleaseReservation provides a mock function with given fields: ctx, reservationID
+func (_m *PartRepository) ReleaseReservation(ctx context.Context, reservationID string) (model.Reservation, error) {
+	ret := _m.Called(ctx, reservationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Reservation, error)); ok {
+		return rf(ctx, reservationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Reservation); ok {
+		r0 = rf(ctx, reservationID)
+	} else {
+		r0 = ret.Get(0).(model.Reservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, reservationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartRepository_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type PartRepository_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationID string
+func (_e *PartRepository_Expecter) ReleaseReservation(ctx interface{}, reservationID interface{}) *PartRepository_ReleaseReservation_Call {
+	return &PartRepository_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, reservationID)}
+}
+
+func (_c *PartRepository_ReleaseReservation_Call) Run(run func(ctx context.Context, reservationID string)) *PartRepository_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PartRepository_ReleaseReservation_Call) Return(_a0 model.Reservation, _a1 error) *PartRepository_ReleaseReservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartRepository_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string) (model.Reservation, error)) *PartRepository_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function with given fields: ctx, reservation
+func (_m *PartRepository) ReserveParts(ctx context.Context, reservation model.Reservation) (model.Reservation, error) {
+	ret := _m.Called(ctx, reservation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Reservation) (model.Reservation, error)); ok {
+		return rf(ctx, reservation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Reservation) model.Reservation); ok {
+		r0 = rf(ctx, reservation)
+	} else {
+		r0 = ret.Get(0).(model.Reservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Reservation) error); ok {
+		r1 = rf(ctx, reservation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartRepository_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type PartRepository_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservation model.Reservation
+func (_e *PartRepository_Expecter) ReserveParts(ctx interface{}, reservation interface{}) *PartRepository_ReserveParts_Call {
+	return &PartRepository_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, reservation)}
+}
+
+func (_c *PartRepository_ReserveParts_Call) Run(run func(ctx context.Context, reservation model.Reservation)) *PartRepository_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Reservation))
+	})
+	return _c
+}
+
+func (_c *PartRepository_ReserveParts_Call) Return(_a0 model.Reservation, _a1 error) *PartRepository_ReserveParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartRepository_ReserveParts_Call) RunAndReturn(run func(context.Context, model.Reservation) (model.Reservation, error)) *PartRepository_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPartRepository creates a new instance of PartRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPartRepository(t interface {
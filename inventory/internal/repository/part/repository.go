@@ -9,13 +9,15 @@ import (
 	"github.com/xgmsx/rsf/inventory/internal/model"
 	def "github.com/xgmsx/rsf/inventory/internal/repository"
 	"github.com/xgmsx/rsf/inventory/internal/utils"
+	"github.com/xgmsx/rsf/shared/pkg/money"
 )
 
 var _ def.PartRepository = (*partsRepository)(nil)
 
 type partsRepository struct {
-	mu   sync.RWMutex
-	data map[string]*model.Part
+	mu           sync.RWMutex
+	data         map[string]*model.Part
+	reservations map[string]*model.Reservation
 }
 
 func NewPartRepository() *partsRepository {
@@ -25,7 +27,7 @@ func NewPartRepository() *partsRepository {
 		UUID:          "111e4567-e89b-12d3-a456-426614174001",
 		Name:          "Hyperdrive Engine",
 		Description:   "A class-9 hyperdrive engine capable of faster-than-light travel.",
-		Price:         450000.00,
+		Price:         money.New(45000000, money.DefaultCurrency),
 		StockQuantity: 3,
 		Category:      model.Category_CATEGORY_ENGINE,
 		Dimensions: &model.Dimensions{
@@ -52,7 +54,7 @@ func NewPartRepository() *partsRepository {
 		UUID:          "222e4567-e89b-12d3-a456-426614174002",
 		Name:          "Quantum Shield Generator",
 		Description:   "Advanced shield generator providing protection against cosmic radiation.",
-		Price:         175000.00,
+		Price:         money.New(17500000, money.DefaultCurrency),
 		StockQuantity: 5,
 		Category:      model.Category_CATEGORY_SHIELD,
 		Dimensions: &model.Dimensions{
@@ -81,7 +83,8 @@ func NewPartRepository() *partsRepository {
 	parts[part2.UUID] = part2
 
 	return &partsRepository{
-		data: parts,
+		data:         parts,
+		reservations: make(map[string]*model.Reservation),
 	}
 }
 
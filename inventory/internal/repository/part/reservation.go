@@ -0,0 +1,170 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/xgmsx/rsf/inventory/internal/model"
+)
+
+// reservationRetention - сколько хранятся снятые и истекшие резервы. Пока резерв
+// хранится, повторные запросы по нему получают ошибку завершенного резерва,
+// а не создают новый резерв.
+const reservationRetention = 24 * time.Hour
+
+func (r *partsRepository) ReserveParts(_ context.Context, reservation model.Reservation) (model.Reservation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.expireReservationsLocked(now)
+
+	if existing, ok := r.reservations[reservation.ID]; ok {
+		if !maps.Equal(quantitiesByPart(existing.Items), quantitiesByPart(reservation.Items)) {
+			return model.Reservation{}, model.ErrReservationExists
+		}
+		// Снятый или истекший резерв больше не держит детали
+		switch existing.Status {
+		case model.ReservationStatus_RESERVATION_STATUS_RELEASED:
+			return model.Reservation{}, model.ErrReservationReleased
+		case model.ReservationStatus_RESERVATION_STATUS_EXPIRED:
+			return model.Reservation{}, model.ErrReservationExpired
+		}
+		return cloneReservation(existing), nil
+	}
+
+	// Сначала проверяем все детали, чтобы не изменить остатки частично
+	quantities := quantitiesByPart(reservation.Items)
+	for partUUID, quantity := range quantities {
+		part, ok := r.data[partUUID]
+		if !ok {
+			return model.Reservation{}, fmt.Errorf("part %s: %w", partUUID, model.ErrPartDoesNotExist)
+		}
+		if part.StockQuantity < quantity {
+			return model.Reservation{}, fmt.Errorf("part %s: requested %d, available %d: %w",
+				partUUID, quantity, part.StockQuantity, model.ErrInsufficientStock)
+		}
+	}
+	r.adjustStockLocked(quantities, -1, now)
+
+	reservation.Status = model.ReservationStatus_RESERVATION_STATUS_PENDING
+	reservation.CreatedAt = now
+	stored := cloneReservation(&reservation)
+	r.reservations[reservation.ID] = &stored
+
+	return cloneReservation(&stored), nil
+}
+
+func (r *partsRepository) CommitReservation(_ context.Context, reservationID string) (model.Reservation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expireReservationsLocked(time.Now())
+
+	reservation, ok := r.reservations[reservationID]
+	if !ok {
+		return model.Reservation{}, model.ErrReservationNotFound
+	}
+
+	switch reservation.Status {
+	case model.ReservationStatus_RESERVATION_STATUS_PENDING:
+		reservation.Status = model.ReservationStatus_RESERVATION_STATUS_COMMITTED
+	case model.ReservationStatus_RESERVATION_STATUS_EXPIRED:
+		return model.Reservation{}, model.ErrReservationExpired
+	case model.ReservationStatus_RESERVATION_STATUS_RELEASED:
+		return model.Reservation{}, model.ErrReservationReleased
+	}
+
+	return cloneReservation(reservation), nil
+}
+
+func (r *partsRepository) ReleaseReservation(_ context.Context, reservationID string) (model.Reservation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.expireReservationsLocked(now)
+
+	reservation, ok := r.reservations[reservationID]
+	if !ok {
+		return model.Reservation{}, model.ErrReservationNotFound
+	}
+
+	switch reservation.Status {
+	case model.ReservationStatus_RESERVATION_STATUS_PENDING, model.ReservationStatus_RESERVATION_STATUS_COMMITTED:
+		r.adjustStockLocked(quantitiesByPart(reservation.Items), 1, now)
+		reservation.Status = model.ReservationStatus_RESERVATION_STATUS_RELEASED
+		reservation.ClosedAt = now
+	}
+
+	return cloneReservation(reservation), nil
+}
+
+func (r *partsRepository) ExpireReservations(_ context.Context) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	expired := r.expireReservationsLocked(now)
+	r.pruneReservationsLocked(now)
+	return expired, nil
+}
+
+// expireReservationsLocked снимает просроченные неподтвержденные резервы.
+// Вызывается под r.mu, поэтому истекший резерв не может быть подтвержден.
+func (r *partsRepository) expireReservationsLocked(now time.Time) int {
+	var expired int
+	for _, reservation := range r.reservations {
+		if reservation.Status != model.ReservationStatus_RESERVATION_STATUS_PENDING || now.Before(reservation.ExpiresAt) {
+			continue
+		}
+		r.adjustStockLocked(quantitiesByPart(reservation.Items), 1, now)
+		reservation.Status = model.ReservationStatus_RESERVATION_STATUS_EXPIRED
+		reservation.ClosedAt = now
+		expired++
+	}
+	return expired
+}
+
+// pruneReservationsLocked удаляет снятые и истекшие резервы, закрытые
+// раньше reservationRetention назад. Вызывается под r.mu.
+func (r *partsRepository) pruneReservationsLocked(now time.Time) {
+	for id, reservation := range r.reservations {
+		switch reservation.Status {
+		case model.ReservationStatus_RESERVATION_STATUS_RELEASED, model.ReservationStatus_RESERVATION_STATUS_EXPIRED:
+			if now.Sub(reservation.ClosedAt) >= reservationRetention {
+				delete(r.reservations, id)
+			}
+		}
+	}
+}
+
+// adjustStockLocked изменяет остатки деталей на sign * quantity
+func (r *partsRepository) adjustStockLocked(quantities map[string]int64, sign int64, now time.Time) {
+	for partUUID, quantity := range quantities {
+		part, ok := r.data[partUUID]
+		if !ok {
+			continue
+		}
+		part.StockQuantity += sign * quantity
+		part.UpdatedAt = now
+	}
+}
+
+// quantitiesByPart суммирует количество по каждой детали резерва
+func quantitiesByPart(items []model.ReservationItem) map[string]int64 {
+	quantities := make(map[string]int64, len(items))
+	for _, item := range items {
+		quantities[item.PartUUID] += item.Quantity
+	}
+	return quantities
+}
+
+func cloneReservation(reservation *model.Reservation) model.Reservation {
+	res := *reservation
+	res.Items = slices.Clone(reservation.Items)
+	return res
+}
@@ -0,0 +1,212 @@
+package part
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgmsx/rsf/inventory/internal/model"
+)
+
+const hyperdriveUUID = "111e4567-e89b-12d3-a456-426614174001"
+
+func newTestReservation(quantity int64, ttl time.Duration) model.Reservation {
+	return model.Reservation{
+		ID:        gofakeit.UUID(),
+		Items:     []model.ReservationItem{{PartUUID: hyperdriveUUID, Quantity: quantity}},
+		ExpiresAt: time.Now().Add(ttl),
+	}
+}
+
+func stockOf(t *testing.T, repo *partsRepository, uuid string) int64 {
+	t.Helper()
+
+	part, err := repo.GetPart(context.Background(), uuid)
+	require.NoError(t, err)
+	return part.StockQuantity
+}
+
+func TestReserveParts(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Reserve decrements stock", func(t *testing.T) {
+		repo := NewPartRepository()
+		reservation, err := repo.ReserveParts(ctx, newTestReservation(2, time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, model.ReservationStatus_RESERVATION_STATUS_PENDING, reservation.Status)
+		require.EqualValues(t, 1, stockOf(t, repo, hyperdriveUUID))
+	})
+
+	t.Run("Insufficient stock changes nothing", func(t *testing.T) {
+		repo := NewPartRepository()
+		reservation := newTestReservation(1, time.Minute)
+		reservation.Items = append(reservation.Items, model.ReservationItem{
+			PartUUID: "222e4567-e89b-12d3-a456-426614174002",
+			Quantity: 6,
+		})
+		_, err := repo.ReserveParts(ctx, reservation)
+		require.ErrorIs(t, err, model.ErrInsufficientStock)
+		require.EqualValues(t, 3, stockOf(t, repo, hyperdriveUUID))
+		require.EqualValues(t, 5, stockOf(t, repo, "222e4567-e89b-12d3-a456-426614174002"))
+	})
+
+	t.Run("Unknown part", func(t *testing.T) {
+		repo := NewPartRepository()
+		reservation := newTestReservation(1, time.Minute)
+		reservation.Items[0].PartUUID = gofakeit.UUID()
+		_, err := repo.ReserveParts(ctx, reservation)
+		require.ErrorIs(t, err, model.ErrPartDoesNotExist)
+	})
+
+	t.Run("Repeated reservation is idempotent", func(t *testing.T) {
+		repo := NewPartRepository()
+		reservation := newTestReservation(1, time.Minute)
+		first, err := repo.ReserveParts(ctx, reservation)
+		require.NoError(t, err)
+		second, err := repo.ReserveParts(ctx, reservation)
+		require.NoError(t, err)
+		require.Equal(t, first, second)
+		require.EqualValues(t, 2, stockOf(t, repo, hyperdriveUUID))
+
+		reservation.Items[0].Quantity = 2
+		_, err = repo.ReserveParts(ctx, reservation)
+		require.ErrorIs(t, err, model.ErrReservationExists)
+	})
+
+	t.Run("Repeated reservation after release or expiry", func(t *testing.T) {
+		repo := NewPartRepository()
+		released := newTestReservation(1, time.Minute)
+		_, err := repo.ReserveParts(ctx, released)
+		require.NoError(t, err)
+		_, err = repo.ReleaseReservation(ctx, released.ID)
+		require.NoError(t, err)
+
+		_, err = repo.ReserveParts(ctx, released)
+		require.ErrorIs(t, err, model.ErrReservationReleased)
+
+		expired := newTestReservation(1, -time.Second)
+		_, err = repo.ReserveParts(ctx, expired)
+		require.NoError(t, err)
+		_, err = repo.ExpireReservations(ctx)
+		require.NoError(t, err)
+
+		_, err = repo.ReserveParts(ctx, expired)
+		require.ErrorIs(t, err, model.ErrReservationExpired)
+		require.EqualValues(t, 3, stockOf(t, repo, hyperdriveUUID))
+	})
+
+	t.Run("Concurrent reservations never oversell", func(t *testing.T) {
+		repo := NewPartRepository()
+
+		var (
+			wg       sync.WaitGroup
+			mu       sync.Mutex
+			reserved int
+		)
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := repo.ReserveParts(ctx, newTestReservation(1, time.Minute))
+				if err == nil {
+					mu.Lock()
+					reserved++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		require.Equal(t, 3, reserved)
+		require.EqualValues(t, 0, stockOf(t, repo, hyperdriveUUID))
+	})
+}
+
+func TestReservationLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Commit keeps stock reserved", func(t *testing.T) {
+		repo := NewPartRepository()
+		reservation, err := repo.ReserveParts(ctx, newTestReservation(1, time.Minute))
+		require.NoError(t, err)
+
+		committed, err := repo.CommitReservation(ctx, reservation.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ReservationStatus_RESERVATION_STATUS_COMMITTED, committed.Status)
+		require.EqualValues(t, 2, stockOf(t, repo, hyperdriveUUID))
+
+		_, err = repo.CommitReservation(ctx, reservation.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("Release returns stock once", func(t *testing.T) {
+		repo := NewPartRepository()
+		reservation, err := repo.ReserveParts(ctx, newTestReservation(2, time.Minute))
+		require.NoError(t, err)
+
+		released, err := repo.ReleaseReservation(ctx, reservation.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.ReservationStatus_RESERVATION_STATUS_RELEASED, released.Status)
+		require.EqualValues(t, 3, stockOf(t, repo, hyperdriveUUID))
+
+		_, err = repo.ReleaseReservation(ctx, reservation.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, stockOf(t, repo, hyperdriveUUID))
+
+		_, err = repo.CommitReservation(ctx, reservation.ID)
+		require.ErrorIs(t, err, model.ErrReservationReleased)
+	})
+
+	t.Run("Expired reservation returns stock", func(t *testing.T) {
+		repo := NewPartRepository()
+		reservation, err := repo.ReserveParts(ctx, newTestReservation(3, -time.Second))
+		require.NoError(t, err)
+
+		expired, err := repo.ExpireReservations(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, expired)
+		require.EqualValues(t, 3, stockOf(t, repo, hyperdriveUUID))
+
+		_, err = repo.CommitReservation(ctx, reservation.ID)
+		require.ErrorIs(t, err, model.ErrReservationExpired)
+	})
+
+	t.Run("Closed reservations pruned after retention", func(t *testing.T) {
+		repo := NewPartRepository()
+		old, err := repo.ReserveParts(ctx, newTestReservation(1, time.Minute))
+		require.NoError(t, err)
+		recent, err := repo.ReserveParts(ctx, newTestReservation(1, time.Minute))
+		require.NoError(t, err)
+		committed, err := repo.ReserveParts(ctx, newTestReservation(1, time.Minute))
+		require.NoError(t, err)
+		_, err = repo.CommitReservation(ctx, committed.ID)
+		require.NoError(t, err)
+		for _, id := range []string{old.ID, recent.ID} {
+			_, err = repo.ReleaseReservation(ctx, id)
+			require.NoError(t, err)
+		}
+		repo.reservations[old.ID].ClosedAt = time.Now().Add(-reservationRetention)
+
+		_, err = repo.ExpireReservations(ctx)
+		require.NoError(t, err)
+
+		_, err = repo.CommitReservation(ctx, old.ID)
+		require.ErrorIs(t, err, model.ErrReservationNotFound)
+		_, err = repo.CommitReservation(ctx, recent.ID)
+		require.ErrorIs(t, err, model.ErrReservationReleased)
+		_, err = repo.CommitReservation(ctx, committed.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("Unknown reservation", func(t *testing.T) {
+		repo := NewPartRepository()
+		_, err := repo.CommitReservation(ctx, gofakeit.UUID())
+		require.ErrorIs(t, err, model.ErrReservationNotFound)
+		_, err = repo.ReleaseReservation(ctx, gofakeit.UUID())
+		require.ErrorIs(t, err, model.ErrReservationNotFound)
+	})
+}
@@ -9,4 +9,18 @@ import (
 type PartRepository interface {
 	GetPart(ctx context.Context, uuid string) (model.Part, error)
 	ListParts(ctx context.Context, filter *model.PartsFilter) ([]model.Part, error)
+	// ReserveParts атомарно уменьшает остатки всех деталей резерва. Если хотя бы
+	// одной детали не хватает, остатки не меняются и возвращается model.ErrInsufficientStock.
+	// Повторный вызов с тем же ID и составом возвращает существующий резерв,
+	// а для снятого или истекшего резерва - model.ErrReservationReleased
+	// или model.ErrReservationExpired.
+	ReserveParts(ctx context.Context, reservation model.Reservation) (model.Reservation, error)
+	// CommitReservation подтверждает резерв, после чего он больше не истекает
+	CommitReservation(ctx context.Context, reservationID string) (model.Reservation, error)
+	// ReleaseReservation снимает резерв и возвращает детали на склад.
+	// Повторное снятие уже снятого или истекшего резерва ничего не меняет.
+	ReleaseReservation(ctx context.Context, reservationID string) (model.Reservation, error)
+	// ExpireReservations снимает неподтвержденные резервы с истекшим сроком
+	// и возвращает их количество. Давно снятые и истекшие резервы удаляются.
+	ExpireReservations(ctx context.Context) (int, error)
 }
@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 	model "github.com/xgmsx/rsf/inventory/internal/model"
+
+	time "time"
 )
 
 // PartService is an autogenerated mock type for the PartService type
@@ -22,6 +24,119 @@ func (_m *PartService) EXPECT() *PartService_Expecter {
 	return &PartService_Expecter{mock: &_m.Mock}
 }
 
+// CommitReservation provides a mock function with given fields: ctx, reservationID
+func (_m *PartService) CommitReservation(ctx context.Context, reservationID string) (model.Reservation, error) {
+	ret := _m.Called(ctx, reservationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Reservation, error)); ok {
+		return rf(ctx, reservationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Reservation); ok {
+		r0 = rf(ctx, reservationID)
+	} else {
+		r0 = ret.Get(0).(model.Reservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, reservationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type PartService_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationID string
+func (_e *PartService_Expecter) CommitReservation(ctx interface{}, reservationID interface{}) *PartService_CommitReservation_Call {
+	return &PartService_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, reservationID)}
+}
+
+func (_c *PartService_CommitReservation_Call) Run(run func(ctx context.Context, reservationID string)) *PartService_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PartService_CommitReservation_Call) Return(_a0 model.Reservation, _a1 error) *PartService_CommitReservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_CommitReservation_Call) RunAndReturn(run func(context.Context, string) (model.Reservation, error)) *PartService_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireReservations provides a mock function with given fields: ctx
+func (_m *PartService) ExpireReservations(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireReservations")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_ExpireReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireReservations'
+type PartService_ExpireReservations_Call struct {
+	*mock.Call
+}
+
+// ExpireReservations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PartService_Expecter) ExpireReservations(ctx interface{}) *PartService_ExpireReservations_Call {
+	return &PartService_ExpireReservations_Call{Call: _e.mock.On("ExpireReservations", ctx)}
+}
+
+func (_c *PartService_ExpireReservations_Call) Run(run func(ctx context.Context)) *PartService_ExpireReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PartService_ExpireReservations_Call) Return(_a0 int, _a1 error) *PartService_ExpireReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_ExpireReservations_Call) RunAndReturn(run func(context.Context) (int, error)) *PartService_ExpireReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, uuid
 func (_m *PartService) GetPart(ctx context.Context, uuid string) (model.Part, error) {
 	ret := _m.Called(ctx, uuid)
@@ -138,6 +253,122 @@ func (_c *PartService_ListParts_Call) RunAndReturn(run func(context.Context, *mo
 	return _c
 }
 
+// ReleaseReservation provides a mock function with given fields: ctx, reservationID
+func (_m *PartService) ReleaseReservation(ctx context.Context, reservationID string) (model.Reservation, error) {
+	ret := _m.Called(ctx, reservationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Reservation, error)); ok {
+		return rf(ctx, reservationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Reservation); ok {
+		r0 = rf(ctx, reservationID)
+	} else {
+		r0 = ret.Get(0).(model.Reservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, reservationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type PartService_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationID string
+func (_e *PartService_Expecter) ReleaseReservation(ctx interface{}, reservationID interface{}) *PartService_ReleaseReservation_Call {
+	return &PartService_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, reservationID)}
+}
+
+func (_c *PartService_ReleaseReservation_Call) Run(run func(ctx context.Context, reservationID string)) *PartService_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PartService_ReleaseReservation_Call) Return(_a0 model.Reservation, _a1 error) *PartService_ReleaseReservation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_ReleaseReservation_Call) RunAndReturn(run func(context.Context, string) (model.Reservation, error)) *PartService_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function with given fields: ctx, reservationID, items, ttl
+func (_m *PartService) ReserveParts(ctx context.Context, reservationID string, items []model.ReservationItem, ttl time.Duration) (model.Reservation, error) {
+	ret := _m.Called(ctx, reservationID, items, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 model.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.ReservationItem, time.Duration) (model.Reservation, error)); ok {
+		return rf(ctx, reservationID, items, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.ReservationItem, time.Duration) model.Reservation); ok {
+		r0 = rf(ctx, reservationID, items, ttl)
+	} else {
+		r0 = ret.Get(0).(model.Reservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []model.ReservationItem, time.Duration) error); ok {
+		r1 = rf(ctx, reservationID, items, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type PartService_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationID string
+//   - items []model.ReservationItem
+//   - ttl time.Duration
+func (_e *PartService_Expecter) ReserveParts(ctx interface{}, reservationID interface{}, items interface{}, ttl interface{}) *PartService_ReserveParts_Call {
+	return &PartService_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, reservationID, items, ttl)}
+}
+
+func (_c *PartService_ReserveParts_Call) Run(run func(ctx context.Context, reservationID string, items []model.ReservationItem, ttl time.Duration)) *PartService_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]model.ReservationItem), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *PartService_ReserveParts_Call) Return(_a0 model.Reservation, _a1 error) *PartService_ReserveParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_ReserveParts_Call) RunAndReturn(run func(context.Context, string, []model.ReservationItem, time.Duration) (model.Reservation, error)) *PartService_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPartService creates a new instance of PartService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPartService(t interface {
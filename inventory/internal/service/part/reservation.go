@@ -0,0 +1,35 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/xgmsx/rsf/inventory/internal/model"
+)
+
+// defaultReservationTTL - время жизни резерва, если клиент его не указал
+const defaultReservationTTL = 15 * time.Minute
+
+func (r *partService) ReserveParts(ctx context.Context, reservationID string, items []model.ReservationItem, ttl time.Duration) (model.Reservation, error) {
+	if ttl <= 0 {
+		ttl = defaultReservationTTL
+	}
+
+	return r.repository.ReserveParts(ctx, model.Reservation{
+		ID:        reservationID,
+		Items:     items,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+}
+
+func (r *partService) CommitReservation(ctx context.Context, reservationID string) (model.Reservation, error) {
+	return r.repository.CommitReservation(ctx, reservationID)
+}
+
+func (r *partService) ReleaseReservation(ctx context.Context, reservationID string) (model.Reservation, error) {
+	return r.repository.ReleaseReservation(ctx, reservationID)
+}
+
+func (r *partService) ExpireReservations(ctx context.Context) (int, error) {
+	return r.repository.ExpireReservations(ctx)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/xgmsx/rsf/inventory/internal/model"
 )
@@ -9,4 +10,8 @@ import (
 type PartService interface {
 	GetPart(ctx context.Context, uuid string) (model.Part, error)
 	ListParts(ctx context.Context, filter *model.PartsFilter) ([]model.Part, error)
+	ReserveParts(ctx context.Context, reservationID string, items []model.ReservationItem, ttl time.Duration) (model.Reservation, error)
+	CommitReservation(ctx context.Context, reservationID string) (model.Reservation, error)
+	ReleaseReservation(ctx context.Context, reservationID string) (model.Reservation, error)
+	ExpireReservations(ctx context.Context) (int, error)
 }
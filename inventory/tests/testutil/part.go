@@ -5,6 +5,7 @@ import (
 
 	"github.com/xgmsx/rsf/inventory/internal/model"
 	"github.com/xgmsx/rsf/inventory/internal/utils"
+	"github.com/xgmsx/rsf/shared/pkg/money"
 )
 
 func GetNewPart() model.Part {
@@ -12,7 +13,7 @@ func GetNewPart() model.Part {
 		UUID:          gofakeit.UUID(),
 		Name:          gofakeit.ProductName(),
 		Description:   gofakeit.Product().Description,
-		Price:         gofakeit.Product().Price,
+		Price:         money.New(int64(gofakeit.IntRange(100, 100_000_000)), money.DefaultCurrency),
 		StockQuantity: int64(gofakeit.Int8()),
 		Category:      model.Category_CATEGORY_ENGINE,
 		Dimensions: &model.Dimensions{
@@ -3,54 +3,161 @@ package main
 import (
 	"context"
 	"errors"
-	"log"
-	"net"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
-	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
 
 	orderApiV1 "github.com/xgmsx/rsf/order/internal/api/v1/order"
+	streamApiV1 "github.com/xgmsx/rsf/order/internal/api/v1/stream"
+	"github.com/xgmsx/rsf/order/internal/broker"
+	kafkaBroker "github.com/xgmsx/rsf/order/internal/broker/kafka"
+	memoryBroker "github.com/xgmsx/rsf/order/internal/broker/memory"
 	inventoryClient "github.com/xgmsx/rsf/order/internal/client/inventory"
 	paymentClient "github.com/xgmsx/rsf/order/internal/client/payment"
+	"github.com/xgmsx/rsf/order/internal/client/resilience"
+	webhookClient "github.com/xgmsx/rsf/order/internal/client/webhook"
+	"github.com/xgmsx/rsf/order/internal/config"
+	"github.com/xgmsx/rsf/order/internal/hub"
+	"github.com/xgmsx/rsf/order/internal/migrator"
+	"github.com/xgmsx/rsf/order/internal/repository"
+	idempotencyRepo "github.com/xgmsx/rsf/order/internal/repository/idempotency"
 	orderRepo "github.com/xgmsx/rsf/order/internal/repository/order"
+	sagaRepo "github.com/xgmsx/rsf/order/internal/repository/saga"
+	webhookRepo "github.com/xgmsx/rsf/order/internal/repository/webhook"
 	orderService "github.com/xgmsx/rsf/order/internal/service/order"
+	webhookService "github.com/xgmsx/rsf/order/internal/service/webhook"
+	"github.com/xgmsx/rsf/order/internal/worker/expiry"
+	"github.com/xgmsx/rsf/order/internal/worker/outbox"
+	sagaWorker "github.com/xgmsx/rsf/order/internal/worker/saga"
+	webhookWorker "github.com/xgmsx/rsf/order/internal/worker/webhook"
+	"github.com/xgmsx/rsf/order/migrations"
+	"github.com/xgmsx/rsf/shared/pkg/auth"
+	"github.com/xgmsx/rsf/shared/pkg/health"
+	"github.com/xgmsx/rsf/shared/pkg/logger"
+	"github.com/xgmsx/rsf/shared/pkg/metrics"
 	genOrderV1 "github.com/xgmsx/rsf/shared/pkg/openapi/order/v1"
 	"github.com/xgmsx/rsf/shared/pkg/swagger"
+	"github.com/xgmsx/rsf/shared/pkg/tracing"
 )
 
-const (
-	httpPort          = "8080"
-	readHeaderTimeout = 5 * time.Second
-	shutdownTimeout   = 10 * time.Second
-)
+// serviceName - имя сервиса в трассировке и логах
+const serviceName = "api-order"
 
 func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		logger.Fatal("ошибка конфигурации", slog.Any("error", err))
+	}
+	err = logger.Init(serviceName, cfg.LogLevel)
+	if err != nil {
+		logger.Fatal("ошибка инициализации логгера", slog.Any("error", err))
+	}
+
+	// Инициализируем трассировку до создания серверов и клиентов
+	shutdownTracing, err := tracing.Init(context.Background(), serviceName, cfg.Tracing)
+	if err != nil {
+		logger.Fatal("ошибка инициализации трассировки", slog.Any("error", err))
+	}
+	defer func() {
+		tCtx, tCancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+		defer tCancel()
+		if terr := shutdownTracing(tCtx); terr != nil {
+			slog.Error("ошибка при остановке трассировки", slog.Any("error", terr))
+		}
+	}()
+
+	// Инициализируем хранилища
+	repos, closeRepositories, err := newRepositories(context.Background(), cfg)
+	if err != nil {
+		logger.Fatal("ошибка инициализации хранилищ", slog.Any("error", err))
+	}
+	defer closeRepositories()
+
+	// Инициализируем брокер сообщений для событий заказов
+	publisher := newPublisher(cfg)
+	defer func() {
+		if cerr := publisher.Close(); cerr != nil {
+			slog.Error("ошибка при закрытии брокера сообщений", slog.Any("error", cerr))
+		}
+	}()
+
 	// Инициализируем grpc-клиенты к другим сервисам
-	paymentServiceClient := paymentClient.NewClient("api-payment:50051")
-	inventoryServiceClient := inventoryClient.NewClient("api-inventory:50051")
+	clientConfig := resilience.Config(cfg.GRPCClient)
+	paymentServiceClient, err := paymentClient.NewClient(cfg.PaymentAddr, clientConfig)
+	if err != nil {
+		logger.Fatal("ошибка инициализации клиента payment", slog.Any("error", err))
+	}
+	inventoryServiceClient, err := inventoryClient.NewClient(cfg.InventoryAddr, clientConfig)
+	if err != nil {
+		logger.Fatal("ошибка инициализации клиента inventory", slog.Any("error", err))
+	}
+
+	// Готовность сервиса зависит от хранилища и доступности inventory и payment
+	checker := health.NewChecker()
+	if repos.check != nil {
+		checker.Add("postgres", repos.check)
+	}
+	checker.Add("inventory", inventoryServiceClient.Check)
+	checker.Add("payment", paymentServiceClient.Check)
 
 	// Инициализируем слои приложения
-	repository := orderRepo.NewOrderRepository()
-	service := orderService.NewOrderService(repository, inventoryServiceClient, paymentServiceClient)
-	api := orderApiV1.NewOrderAPI(service)
+	service := orderService.NewOrderService(
+		repos.orders, repos.sagas, repos.idempotency, inventoryServiceClient, paymentServiceClient,
+		cfg.PaymentTimeout, cfg.IdempotencyKeyTTL)
+	webhooks := webhookService.NewWebhookService(repos.webhooks, webhookClient.NewClient(cfg.WebhookTimeout))
+	api := orderApiV1.NewOrderAPI(service, webhooks)
+	// Hub уведомляет SSE-подписчиков о событиях заказов, опубликованных из outbox
+	orderHub := hub.NewHub()
+
+	// Запросы к API проходят только с действительным токеном пользователя
+	verifier, err := auth.NewVerifier(cfg.Auth())
+	if err != nil {
+		logger.Fatal("ошибка инициализации проверки токенов", slog.Any("error", err))
+	}
+	orderApiRouter, err := genOrderV1.NewServer(api, orderApiV1.NewSecurityHandler(verifier))
+	if err != nil {
+		logger.Fatal("ошибка инициализации openapi.order.v1", slog.Any("error", err))
+	}
+	route := newRoutePattern(orderApiRouter)
+
+	// Поток статусов заказа закрывается в начале остановки сервера
+	streamHandler := streamApiV1.NewHandler(service, orderHub, cfg.StreamHeartbeat)
 
 	// Инициализируем HTTP сервер
 	r := chi.NewRouter()
-	r.Use(middleware.Logger)
+	r.Use(tracing.HTTPMiddleware(serviceName, route))
+	r.Use(metrics.HTTPMiddleware(route))
+	// X-Request-ID запроса попадает в логи и передается в inventory и payment
+	r.Use(logger.RequestIDMiddleware)
+	r.Use(logger.AccessLogMiddleware)
 	r.Use(middleware.Recoverer)
-	r.Use(middleware.Timeout(10 * time.Second))
 
-	// Монтируем order API
-	orderApiRouter, err := genOrderV1.NewServer(api)
-	if err != nil {
-		log.Fatalf("ошибка инициализации openapi.order.v1: %v", err)
-	}
-	r.Mount("/api/", orderApiRouter)
+	r.Group(func(r chi.Router) {
+		r.Use(auth.Middleware(verifier))
+
+		// Поток статусов заказа открыт, пока клиент не отключится,
+		// поэтому таймаут запросов на него не распространяется
+		r.Get("/api/v1/orders/{order_uuid}/events", streamHandler.ServeHTTP)
+
+		// Монтируем order API
+		r.With(middleware.Timeout(cfg.RequestTimeout)).Mount("/api/", orderApiRouter)
+	})
+
+	// Метрики HTTP, gRPC-клиентов и бизнес-событий в формате Prometheus
+	r.Handle("/metrics", metrics.Handler())
+
+	// Проверки живости и готовности
+	r.Handle("/healthz", checker.LivenessHandler())
+	r.Handle("/readyz", checker.ReadinessHandler())
 
 	// Монтируем Swagger UI
 	r.Mount("/swagger", swagger.NewSwaggerHandler(
@@ -61,31 +168,173 @@ func main() {
 
 	// Запускаем HTTP сервер
 	server := &http.Server{
-		Addr:              net.JoinHostPort("0.0.0.0", httpPort),
+		Addr:              fmt.Sprintf(":%d", cfg.HTTPPort),
 		Handler:           r,
-		ReadHeaderTimeout: readHeaderTimeout,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
 	}
+	// Shutdown ждет завершения всех запросов, поэтому открытые потоки
+	// закрываются сразу, а не по истечении таймаута остановки
+	server.RegisterOnShutdown(streamHandler.Shutdown)
 
 	go func() {
-		log.Printf("🚀 HTTP server listening on %s\n", httpPort)
+		slog.Info("HTTP server listening", slog.Int("port", cfg.HTTPPort))
 		err = server.ListenAndServe()
 		if err != nil && !errors.Is(err, http.ErrServerClosed) {
-			log.Printf("❌ Failed to serve HTTP: %v\n", err)
+			slog.Error("failed to serve HTTP", slog.Any("error", err))
 		}
 	}()
 
+	// Запускаем фоновое истечение неоплаченных заказов и ключей идемпотентности, публикацию событий,
+	// доставку вебхуков и восстановление прерванных саг
+	workerCtx, workerCancel := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	workers.Add(4)
+	go func() {
+		defer workers.Done()
+		expiry.NewWorker(service, cfg.ExpiryInterval).Run(workerCtx)
+	}()
+	go func() {
+		defer workers.Done()
+		outbox.NewRelay(repos.outbox, publisher, cfg.EventsTopic, cfg.OutboxInterval,
+			orderHub.NotifyOutbox, webhooks.EnqueueDeliveries).Run(workerCtx)
+	}()
+	go func() {
+		defer workers.Done()
+		webhookWorker.NewWorker(webhooks, cfg.WebhookInterval).Run(workerCtx)
+	}()
+	go func() {
+		defer workers.Done()
+		sagaWorker.NewWorker(service, cfg.SagaRecoveryInterval).Run(workerCtx)
+	}()
+	workerDone := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(workerDone)
+	}()
+
 	// Создаем graceful shutdown контекст для ожидания сигнала завершения
 	notify := make(chan os.Signal, 1)
 	signal.Notify(notify, syscall.SIGINT, syscall.SIGTERM)
 	<-notify
 
-	tCtx, tCancel := context.WithTimeout(context.Background(), shutdownTimeout)
-	defer tCancel()
+	slog.Info("завершение работы сервера")
+	checker.Shutdown()
+	serverCtx, serverCancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer serverCancel()
+	err = server.Shutdown(serverCtx)
+	if err != nil {
+		slog.Error("ошибка при остановке сервера", slog.Any("error", err))
+	}
+
+	// Фоновым задачам отводится свой таймаут: остановка сервера
+	// могла израсходовать весь свой
+	workerCancel()
+	drainCtx, drainCancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer drainCancel()
+	select {
+	case <-workerDone:
+	case <-drainCtx.Done():
+		slog.Error("истек таймаут остановки фоновых задач")
+	}
+	slog.Info("сервер остановлен")
+}
+
+// newRoutePattern возвращает шаблон маршрута запроса для метрик и трассировки.
+// Все операции order API смонтированы в chi как "/api/*", поэтому их шаблон
+// берется из маршрутизатора ogen.
+func newRoutePattern(api *genOrderV1.Server) func(*http.Request) string {
+	return func(r *http.Request) string {
+		if route, ok := api.FindRoute(r.Method, r.URL.Path); ok {
+			return route.PathPattern()
+		}
+		if rctx := chi.RouteContext(r.Context()); rctx != nil {
+			return rctx.RoutePattern()
+		}
+		return ""
+	}
+}
+
+type repositories struct {
+	orders      repository.OrderRepository
+	outbox      repository.OutboxRepository
+	sagas       repository.SagaRepository
+	idempotency repository.IdempotencyRepository
+	webhooks    repository.WebhookRepository
+	// check проверяет подключение к хранилищу, nil для хранилища в памяти
+	check health.Check
+}
+
+// newRepositories создает хранилища, выбранные в ORDER_STORAGE: "memory" или "postgres".
+// Для PostgreSQL перед началом работы применяются миграции.
+func newRepositories(ctx context.Context, cfg config.Config) (repositories, func(), error) {
+	if cfg.Storage == config.StorageMemory {
+		slog.Info("using in-memory order storage")
+		orders := orderRepo.NewOrderRepository()
+		return repositories{
+			orders:      orders,
+			outbox:      orders,
+			sagas:       sagaRepo.NewSagaRepository(),
+			idempotency: idempotencyRepo.NewIdempotencyRepository(),
+			webhooks:    webhookRepo.NewWebhookRepository(),
+		}, func() {}, nil
+	}
+
+	pool, err := newPostgresPool(ctx, cfg.PostgresDSN)
+	if err != nil {
+		return repositories{}, nil, err
+	}
+
+	slog.Info("using postgres order storage")
+	orders := orderRepo.NewPostgresOrderRepository(pool)
+	return repositories{
+		orders:      orders,
+		outbox:      orders,
+		sagas:       sagaRepo.NewPostgresSagaRepository(pool),
+		idempotency: idempotencyRepo.NewPostgresIdempotencyRepository(pool),
+		webhooks:    webhookRepo.NewPostgresWebhookRepository(pool),
+		check:       pool.Ping,
+	}, pool.Close, nil
+}
+
+// newPublisher создает брокер сообщений, выбранный в ORDER_BROKER:
+// "memory" или "kafka" с адресами из KAFKA_BROKERS.
+func newPublisher(cfg config.Config) broker.Publisher {
+	if cfg.Broker == config.BrokerKafka {
+		slog.Info("using kafka message broker")
+		return kafkaBroker.NewPublisher(cfg.KafkaBrokers...)
+	}
+
+	slog.Info("using in-memory message broker")
+	return memoryBroker.NewBroker()
+}
 
-	log.Println("🛑 Завершение работы сервера...")
-	err = server.Shutdown(tCtx)
+// newPostgresPool подключается к PostgreSQL и применяет миграции
+func newPostgresPool(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
+	pool, err := pgxpool.New(ctx, dsn)
 	if err != nil {
-		log.Printf("❌ Ошибка при остановке сервера: %v\n", err)
+		return nil, fmt.Errorf("failed to create postgres pool: %w", err)
 	}
-	log.Println("✅ Сервер остановлен")
+	err = pool.Ping(ctx)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+
+	db := stdlib.OpenDBFromPool(pool)
+	defer func() {
+		if cerr := db.Close(); cerr != nil {
+			slog.ErrorContext(ctx, "failed to close migration connection", slog.Any("error", cerr))
+		}
+	}()
+
+	m, err := migrator.NewMigrator(db, migrations.FS)
+	if err == nil {
+		err = m.Up(ctx)
+	}
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return pool, nil
 }
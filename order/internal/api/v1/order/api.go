@@ -2,30 +2,47 @@ package order
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
+	"github.com/ogen-go/ogen/ogenerrors"
+
+	"github.com/xgmsx/rsf/order/internal/model"
 	"github.com/xgmsx/rsf/order/internal/service"
+	"github.com/xgmsx/rsf/shared/pkg/auth"
 	genOrderV1 "github.com/xgmsx/rsf/shared/pkg/openapi/order/v1"
 )
 
 var _ genOrderV1.Handler = (*orderApi)(nil)
 
 type orderApi struct {
-	orderService service.OrderService
+	orderService   service.OrderService
+	webhookService service.WebhookService
 }
 
-func NewOrderAPI(orderService service.OrderService) *orderApi {
+func NewOrderAPI(orderService service.OrderService, webhookService service.WebhookService) *orderApi {
 	return &orderApi{
-		orderService: orderService,
+		orderService:   orderService,
+		webhookService: webhookService,
 	}
 }
 
-// NewError создает новую ошибку в формате GenericError
+// NewError создает новую ошибку в формате GenericError. Недоступность
+// inventory или payment возвращается как 503, чтобы клиент повторил запрос позже,
+// а запрос без действительного токена - как 401.
 func (h *orderApi) NewError(_ context.Context, err error) *genOrderV1.GenericErrorStatusCode {
+	code := http.StatusInternalServerError
+	var securityErr *ogenerrors.SecurityError
+	switch {
+	case errors.Is(err, model.ErrServiceUnavailable):
+		code = http.StatusServiceUnavailable
+	case errors.As(err, &securityErr), errors.Is(err, auth.ErrMissingToken), errors.Is(err, auth.ErrInvalidToken):
+		code = http.StatusUnauthorized
+	}
 	return &genOrderV1.GenericErrorStatusCode{
-		StatusCode: http.StatusInternalServerError,
+		StatusCode: code,
 		Response: genOrderV1.GenericError{
-			Code:    genOrderV1.NewOptInt(http.StatusInternalServerError),
+			Code:    genOrderV1.NewOptInt(code),
 			Message: genOrderV1.NewOptString(err.Error()),
 		},
 	}
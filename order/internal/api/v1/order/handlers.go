@@ -8,12 +8,18 @@ import (
 
 	"github.com/xgmsx/rsf/order/internal/model"
 	"github.com/xgmsx/rsf/order/internal/model/converter"
+	"github.com/xgmsx/rsf/shared/pkg/auth"
 	genOrderV1 "github.com/xgmsx/rsf/shared/pkg/openapi/order/v1"
 )
 
 // CreateOrder implements shared/pkg/openapi/order/v1.
-func (h *orderApi) CreateOrder(ctx context.Context, req *genOrderV1.CreateOrderRequest) (genOrderV1.CreateOrderRes, error) {
-	input := converter.CreateOrderInputFromRequest(*req)
+func (h *orderApi) CreateOrder(ctx context.Context, req *genOrderV1.CreateOrderRequest, params genOrderV1.CreateOrderParams) (genOrderV1.CreateOrderRes, error) {
+	// Заказ создается от имени пользователя из токена
+	identity, ok := auth.IdentityFromContext(ctx)
+	if !ok {
+		return nil, h.NewError(ctx, auth.ErrMissingToken)
+	}
+	input := converter.CreateOrderInputFromRequest(identity.UserUUID, *req, params)
 	output, err := h.orderService.CreateOrder(ctx, input)
 	if err != nil {
 		if errors.Is(err, model.ErrPartDoesNotExist) {
@@ -22,6 +28,24 @@ func (h *orderApi) CreateOrder(ctx context.Context, req *genOrderV1.CreateOrderR
 				Message: err.Error(),
 			}, nil
 		}
+		if errors.Is(err, model.ErrPartsOutOfStock) {
+			return &genOrderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.Is(err, model.ErrIdempotencyKeyInProgress) {
+			return &genOrderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.Is(err, model.ErrIdempotencyKeyReused) {
+			return &genOrderV1.UnprocessableEntityError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		}
 		return nil, h.NewError(ctx, err)
 	}
 
@@ -44,6 +68,38 @@ func (h *orderApi) GetOrder(ctx context.Context, params genOrderV1.GetOrderParam
 	return converter.GetOrderOutputToResponse(output), nil
 }
 
+// GetOrderHistory implements shared/pkg/openapi/order/v1.
+func (h *orderApi) GetOrderHistory(ctx context.Context, params genOrderV1.GetOrderHistoryParams) (genOrderV1.GetOrderHistoryRes, error) {
+	history, err := h.orderService.GetOrderStatusHistory(ctx, params.OrderUUID.String())
+	if err != nil {
+		if errors.Is(err, model.ErrOrderNotFound) {
+			return &genOrderV1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: "Order with UUID: '" + params.OrderUUID.String() + "' not found",
+			}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return converter.OrderHistoryToResponse(history), nil
+}
+
+// ListOrders implements shared/pkg/openapi/order/v1.
+func (h *orderApi) ListOrders(ctx context.Context, params genOrderV1.ListOrdersParams) (genOrderV1.ListOrdersRes, error) {
+	output, err := h.orderService.ListOrders(ctx, converter.ListOrdersInputFromParams(params))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidCursor) {
+			return &genOrderV1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return converter.ListOrdersOutputToResponse(output), nil
+}
+
 // PayOrder implements shared/pkg/openapi/order/v1.
 func (h *orderApi) PayOrder(ctx context.Context, req *genOrderV1.PayOrderRequest, params genOrderV1.PayOrderParams) (genOrderV1.PayOrderRes, error) {
 	input := converter.PayOrderInputFromRequest(*req, params)
@@ -55,12 +111,54 @@ func (h *orderApi) PayOrder(ctx context.Context, req *genOrderV1.PayOrderRequest
 				Message: "Order with UUID: '" + params.OrderUUID.String() + "' not found",
 			}, nil
 		}
+		if errors.Is(err, model.ErrOrderConflict) {
+			return &genOrderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: "Order was modified concurrently, payment was not processed",
+			}, nil
+		}
+		if errors.Is(err, model.ErrInvalidStatusTransition) {
+			return &genOrderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.Is(err, model.ErrReservationExpired) {
+			return &genOrderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: "Parts reservation expired, please create a new order",
+			}, nil
+		}
+		if errors.Is(err, model.ErrPaymentDeadlineExceeded) {
+			return &genOrderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: "Payment deadline exceeded, please create a new order",
+			}, nil
+		}
+		if errors.Is(err, model.ErrIdempotencyKeyInProgress) {
+			return &genOrderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.Is(err, model.ErrIdempotencyKeyReused) {
+			return &genOrderV1.UnprocessableEntityError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		}
 		if errors.Is(err, model.ErrPaymentMethodIsNotSupported) {
 			return &genOrderV1.BadRequestError{
 				Code:    http.StatusBadRequest,
 				Message: fmt.Sprintf("Payment method %v is not supported", req.PaymentMethod),
 			}, nil
 		}
+		if errors.Is(err, model.ErrPaymentDeclined) {
+			return &genOrderV1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			}, nil
+		}
 
 		return &genOrderV1.InternalServerError{
 			Code:    500,
@@ -71,6 +169,50 @@ func (h *orderApi) PayOrder(ctx context.Context, req *genOrderV1.PayOrderRequest
 	return converter.PayOrderOutputToResponse(output), nil
 }
 
+// RefundOrder implements shared/pkg/openapi/order/v1.
+func (h *orderApi) RefundOrder(ctx context.Context, req *genOrderV1.RefundOrderRequest, params genOrderV1.RefundOrderParams) (genOrderV1.RefundOrderRes, error) {
+	input := converter.RefundOrderInputFromRequest(*req, params)
+	output, err := h.orderService.RefundOrder(ctx, input)
+	if err != nil {
+		if errors.Is(err, model.ErrOrderNotFound) {
+			return &genOrderV1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: "Order with UUID: '" + params.OrderUUID.String() + "' not found",
+			}, nil
+		}
+		if errors.Is(err, model.ErrInvalidRefundAmount) {
+			return &genOrderV1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.Is(err, model.ErrOrderConflict) {
+			return &genOrderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: "Order was modified concurrently, refund was not processed",
+			}, nil
+		}
+		if errors.Is(err, model.ErrInvalidStatusTransition) ||
+			errors.Is(err, model.ErrRefundExceedsPayment) ||
+			errors.Is(err, model.ErrRefundInProgress) ||
+			errors.Is(err, model.ErrIdempotencyKeyInProgress) {
+			return &genOrderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.Is(err, model.ErrIdempotencyKeyReused) {
+			return &genOrderV1.UnprocessableEntityError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return converter.RefundOrderOutputToResponse(output), nil
+}
+
 // CancelOrder implements shared/pkg/openapi/order/v1.
 func (h *orderApi) CancelOrder(ctx context.Context, params genOrderV1.CancelOrderParams) (genOrderV1.CancelOrderRes, error) {
 	_, err := h.orderService.CancelOrder(ctx, params.OrderUUID.String())
@@ -81,10 +223,16 @@ func (h *orderApi) CancelOrder(ctx context.Context, params genOrderV1.CancelOrde
 				Message: "Order with UUID: '" + params.OrderUUID.String() + "' not found",
 			}, nil
 		}
-		if errors.Is(err, model.ErrOrderAlreadyPaid) {
-			return &genOrderV1.NotFoundError{
+		if errors.Is(err, model.ErrInvalidStatusTransition) {
+			return &genOrderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}, nil
+		}
+		if errors.Is(err, model.ErrOrderConflict) {
+			return &genOrderV1.ConflictError{
 				Code:    http.StatusConflict,
-				Message: "Order already paid and cannot be cancelled",
+				Message: "Order was modified concurrently",
 			}, nil
 		}
 		return nil, h.NewError(ctx, err)
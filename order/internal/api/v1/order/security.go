@@ -0,0 +1,35 @@
+package order
+
+import (
+	"context"
+
+	"github.com/xgmsx/rsf/shared/pkg/auth"
+	genOrderV1 "github.com/xgmsx/rsf/shared/pkg/openapi/order/v1"
+)
+
+var _ genOrderV1.SecurityHandler = (*securityHandler)(nil)
+
+type securityHandler struct {
+	verifier *auth.Verifier
+}
+
+// NewSecurityHandler проверяет bearer-токен запросов order API
+func NewSecurityHandler(verifier *auth.Verifier) *securityHandler {
+	return &securityHandler{
+		verifier: verifier,
+	}
+}
+
+// HandleBearerAuth implements shared/pkg/openapi/order/v1. Если токен уже
+// проверен middleware HTTP-сервера, повторная проверка не выполняется.
+func (h *securityHandler) HandleBearerAuth(ctx context.Context, _ genOrderV1.OperationName, t genOrderV1.BearerAuth) (context.Context, error) {
+	if identity, ok := auth.IdentityFromContext(ctx); ok && identity.Token == t.Token {
+		return ctx, nil
+	}
+
+	identity, err := h.verifier.Verify(t.Token)
+	if err != nil {
+		return nil, err
+	}
+	return auth.ContextWithIdentity(ctx, identity), nil
+}
@@ -0,0 +1,160 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/model/converter"
+	genOrderV1 "github.com/xgmsx/rsf/shared/pkg/openapi/order/v1"
+)
+
+// CreateWebhook implements shared/pkg/openapi/order/v1.
+func (h *orderApi) CreateWebhook(ctx context.Context, req *genOrderV1.WebhookRequest) (genOrderV1.CreateWebhookRes, error) {
+	subscription, err := h.webhookService.CreateWebhook(ctx, converter.WebhookInputFromRequest(*req))
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			return webhooksNotFound(), nil
+		}
+		if isInvalidWebhookInput(err) {
+			return &genOrderV1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return converter.WebhookToResponse(subscription, true), nil
+}
+
+// ListWebhooks implements shared/pkg/openapi/order/v1.
+func (h *orderApi) ListWebhooks(ctx context.Context) (genOrderV1.ListWebhooksRes, error) {
+	subscriptions, err := h.webhookService.ListWebhooks(ctx)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			return webhooksNotFound(), nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return converter.WebhooksToResponse(subscriptions), nil
+}
+
+// GetWebhook implements shared/pkg/openapi/order/v1.
+func (h *orderApi) GetWebhook(ctx context.Context, params genOrderV1.GetWebhookParams) (genOrderV1.GetWebhookRes, error) {
+	subscription, err := h.webhookService.GetWebhook(ctx, params.WebhookUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			return webhookNotFound(params.WebhookUUID), nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return converter.WebhookToResponse(subscription, false), nil
+}
+
+// UpdateWebhook implements shared/pkg/openapi/order/v1.
+func (h *orderApi) UpdateWebhook(ctx context.Context, req *genOrderV1.WebhookRequest, params genOrderV1.UpdateWebhookParams) (genOrderV1.UpdateWebhookRes, error) {
+	subscription, err := h.webhookService.UpdateWebhook(ctx, params.WebhookUUID, converter.WebhookInputFromRequest(*req))
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			return webhookNotFound(params.WebhookUUID), nil
+		}
+		if isInvalidWebhookInput(err) {
+			return &genOrderV1.BadRequestError{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return converter.WebhookToResponse(subscription, false), nil
+}
+
+// DeleteWebhook implements shared/pkg/openapi/order/v1.
+func (h *orderApi) DeleteWebhook(ctx context.Context, params genOrderV1.DeleteWebhookParams) (genOrderV1.DeleteWebhookRes, error) {
+	err := h.webhookService.DeleteWebhook(ctx, params.WebhookUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			return webhookNotFound(params.WebhookUUID), nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return &genOrderV1.DeleteWebhookNoContent{}, nil
+}
+
+// RotateWebhookSecret implements shared/pkg/openapi/order/v1.
+func (h *orderApi) RotateWebhookSecret(ctx context.Context, params genOrderV1.RotateWebhookSecretParams) (genOrderV1.RotateWebhookSecretRes, error) {
+	subscription, err := h.webhookService.RotateWebhookSecret(ctx, params.WebhookUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			return webhookNotFound(params.WebhookUUID), nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return converter.WebhookToResponse(subscription, true), nil
+}
+
+// ListWebhookDeadLetters implements shared/pkg/openapi/order/v1.
+func (h *orderApi) ListWebhookDeadLetters(ctx context.Context, params genOrderV1.ListWebhookDeadLettersParams) (genOrderV1.ListWebhookDeadLettersRes, error) {
+	deliveries, err := h.webhookService.ListDeadLetters(ctx, params.WebhookUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			return webhookNotFound(params.WebhookUUID), nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return converter.WebhookDeliveriesToResponse(deliveries), nil
+}
+
+// ReplayWebhookDeadLetter implements shared/pkg/openapi/order/v1.
+func (h *orderApi) ReplayWebhookDeadLetter(ctx context.Context, params genOrderV1.ReplayWebhookDeadLetterParams) (genOrderV1.ReplayWebhookDeadLetterRes, error) {
+	delivery, err := h.webhookService.ReplayDeadLetter(ctx, params.WebhookUUID, params.DeliveryUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			return webhookNotFound(params.WebhookUUID), nil
+		}
+		if errors.Is(err, model.ErrWebhookDeliveryNotFound) {
+			return &genOrderV1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: "Webhook delivery with UUID: '" + params.DeliveryUUID.String() + "' not found",
+			}, nil
+		}
+		if errors.Is(err, model.ErrWebhookDeliveryNotDead) {
+			return &genOrderV1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, h.NewError(ctx, err)
+	}
+
+	return converter.WebhookDeliveryToResponse(delivery), nil
+}
+
+func webhookNotFound(webhookUUID uuid.UUID) *genOrderV1.NotFoundError {
+	return &genOrderV1.NotFoundError{
+		Code:    http.StatusNotFound,
+		Message: "Webhook with UUID: '" + webhookUUID.String() + "' not found",
+	}
+}
+
+// webhooksNotFound - ответ на запрос к списку вебхуков от вызывающего без роли администратора
+func webhooksNotFound() *genOrderV1.NotFoundError {
+	return &genOrderV1.NotFoundError{
+		Code:    http.StatusNotFound,
+		Message: "Webhooks not found",
+	}
+}
+
+func isInvalidWebhookInput(err error) bool {
+	return errors.Is(err, model.ErrInvalidWebhookURL) || errors.Is(err, model.ErrInvalidWebhookEventTypes)
+}
@@ -0,0 +1,183 @@
+package stream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/rsf/order/internal/hub"
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/model/converter"
+	"github.com/xgmsx/rsf/order/internal/service"
+)
+
+const (
+	// EventStatus - тип SSE-события с переходом статуса заказа
+	EventStatus = "status"
+
+	// retryInterval - через сколько браузер переподключается после обрыва
+	retryInterval = 3 * time.Second
+)
+
+// handler отдает переходы статуса заказа как Server-Sent Events.
+// Идентификатор события - номер перехода в истории статусов заказа,
+// поэтому клиент может продолжить поток с Last-Event-ID после переподключения.
+type handler struct {
+	orderService service.OrderService
+	hub          hub.Hub
+	heartbeat    time.Duration
+
+	// closing закрывается при остановке сервера и завершает открытые потоки
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+func NewHandler(orderService service.OrderService, hub hub.Hub, heartbeat time.Duration) *handler {
+	return &handler{
+		orderService: orderService,
+		hub:          hub,
+		heartbeat:    heartbeat,
+		closing:      make(chan struct{}),
+	}
+}
+
+// Shutdown завершает открытые потоки, чтобы остановка HTTP-сервера не ждала,
+// пока клиенты отключатся сами. Клиенты переподключаются с Last-Event-ID
+// к другой реплике или к перезапущенному сервису.
+func (h *handler) Shutdown() {
+	h.closeOnce.Do(func() { close(h.closing) })
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	orderUUID, err := uuid.Parse(chi.URLParam(r, "order_uuid"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid order_uuid")
+		return
+	}
+	sent, err := parseLastEventID(r.Header.Get("Last-Event-ID"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "streaming is not supported")
+		return
+	}
+
+	// Подписываемся до чтения истории, чтобы не пропустить переход,
+	// случившийся между чтением и подпиской
+	updates, unsubscribe := h.hub.Subscribe(orderUUID)
+	defer unsubscribe()
+
+	history, err := h.orderService.GetOrderStatusHistory(ctx, orderUUID.String())
+	if err != nil {
+		if errors.Is(err, model.ErrOrderNotFound) {
+			writeError(w, http.StatusNotFound, "Order with UUID: '"+orderUUID.String()+"' not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Отключаем буферизацию ответа в nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	_, err = fmt.Fprintf(w, "retry: %d\n\n", retryInterval.Milliseconds())
+	if err != nil {
+		return
+	}
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		sent, err = writeChanges(w, history, sent)
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.closing:
+			return
+		case <-updates:
+		case <-ticker.C:
+			// Heartbeat не дает прокси закрыть простаивающее соединение.
+			// Историю перечитываем и здесь: переходы без событий outbox
+			// и изменения, опубликованные другой репликой, в hub не попадают.
+			_, err = fmt.Fprint(w, ": heartbeat\n\n")
+			if err != nil {
+				return
+			}
+		}
+
+		history, err = h.orderService.GetOrderStatusHistory(ctx, orderUUID.String())
+		if err != nil {
+			if ctx.Err() == nil {
+				slog.ErrorContext(ctx, "failed to read order status history",
+					slog.String("order_uuid", orderUUID.String()),
+					slog.Any("error", err),
+				)
+			}
+			return
+		}
+	}
+}
+
+// writeChanges отправляет переходы истории после sent и возвращает номер последнего отправленного
+func writeChanges(w http.ResponseWriter, history []model.OrderStatusChange, sent int) (int, error) {
+	for sent < len(history) {
+		item := converter.OrderStatusChangeToResponse(history[sent])
+		data, err := item.MarshalJSON()
+		if err != nil {
+			return sent, err
+		}
+
+		_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", sent+1, EventStatus, data)
+		if err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+func parseLastEventID(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	id, err := strconv.Atoi(value)
+	if err != nil || id < 0 {
+		return 0, fmt.Errorf("invalid Last-Event-ID %q", value)
+	}
+	return id, nil
+}
+
+// writeError отвечает ошибкой в том же формате, что и order API
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(map[string]any{
+		"code":    code,
+		"message": message,
+	})
+	if err != nil {
+		slog.Error("failed to write error response", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,172 @@
+package stream
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgmsx/rsf/order/internal/hub"
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/service/mocks"
+)
+
+var testHistory = []model.OrderStatusChange{
+	{To: model.OrderStatusPENDINGPAYMENT, Reason: "order created", ChangedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+	{From: model.OrderStatusPENDINGPAYMENT, To: model.OrderStatusPAID, Reason: "payment succeeded", ChangedAt: time.Date(2025, 1, 1, 0, 1, 0, 0, time.UTC)},
+	{From: model.OrderStatusPAID, To: model.OrderStatusREFUNDED, Reason: "refund requested", ChangedAt: time.Date(2025, 1, 1, 0, 2, 0, 0, time.UTC)},
+}
+
+func newTestServer(t *testing.T, orderService *mocks.OrderService, orderHub hub.Hub, heartbeat time.Duration) *httptest.Server {
+	t.Helper()
+
+	r := chi.NewRouter()
+	r.Get("/api/v1/orders/{order_uuid}/events", NewHandler(orderService, orderHub, heartbeat).ServeHTTP)
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// openStream подключается к потоку статусов и возвращает читатель тела ответа
+func openStream(t *testing.T, ctx context.Context, url, lastEventID string) (*http.Response, *bufio.Reader) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	require.NoError(t, err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = res.Body.Close() })
+	return res, bufio.NewReader(res.Body)
+}
+
+// readEvent читает следующее событие потока без учета строки retry
+func readEvent(t *testing.T, body *bufio.Reader) string {
+	t.Helper()
+
+	var lines []string
+	for {
+		line, err := body.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		if line != "" {
+			if !strings.HasPrefix(line, "retry:") {
+				lines = append(lines, line)
+			}
+			continue
+		}
+		if len(lines) > 0 {
+			return strings.Join(lines, "\n")
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("History sent and new transitions pushed", func(t *testing.T) {
+		orderService := mocks.NewOrderService(t)
+		orderHub := hub.NewHub()
+		server := newTestServer(t, orderService, orderHub, time.Hour)
+		orderUUID := uuid.New()
+
+		orderService.On("GetOrderStatusHistory", mock.Anything, orderUUID.String()).Return(testHistory[:2], nil).Once()
+		orderService.On("GetOrderStatusHistory", mock.Anything, orderUUID.String()).Return(testHistory, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		res, body := openStream(t, ctx, server.URL+"/api/v1/orders/"+orderUUID.String()+"/events", "")
+
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+		require.Equal(t, "id: 1\nevent: status\n"+
+			`data: {"to_status":"PENDING_PAYMENT","reason":"order created","changed_at":"2025-01-01T00:00:00Z"}`,
+			readEvent(t, body))
+		require.Equal(t, "id: 2\nevent: status\n"+
+			`data: {"from_status":"PENDING_PAYMENT","to_status":"PAID","reason":"payment succeeded","changed_at":"2025-01-01T00:01:00Z"}`,
+			readEvent(t, body))
+
+		orderHub.Notify(orderUUID)
+		require.Contains(t, readEvent(t, body), "id: 3\n")
+	})
+
+	t.Run("Stream resumed after Last-Event-ID", func(t *testing.T) {
+		orderService := mocks.NewOrderService(t)
+		server := newTestServer(t, orderService, hub.NewHub(), time.Hour)
+		orderUUID := uuid.New()
+
+		orderService.On("GetOrderStatusHistory", mock.Anything, orderUUID.String()).Return(testHistory, nil).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, body := openStream(t, ctx, server.URL+"/api/v1/orders/"+orderUUID.String()+"/events", "2")
+
+		require.Contains(t, readEvent(t, body), "id: 3\n")
+	})
+
+	t.Run("Heartbeat sent while order is unchanged", func(t *testing.T) {
+		orderService := mocks.NewOrderService(t)
+		server := newTestServer(t, orderService, hub.NewHub(), 10*time.Millisecond)
+		orderUUID := uuid.New()
+
+		orderService.On("GetOrderStatusHistory", mock.Anything, orderUUID.String()).Return(testHistory, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, body := openStream(t, ctx, server.URL+"/api/v1/orders/"+orderUUID.String()+"/events", "3")
+
+		require.Equal(t, ": heartbeat", readEvent(t, body))
+	})
+
+	t.Run("Stream closed on server shutdown", func(t *testing.T) {
+		orderService := mocks.NewOrderService(t)
+		handler := NewHandler(orderService, hub.NewHub(), time.Hour)
+		r := chi.NewRouter()
+		r.Get("/api/v1/orders/{order_uuid}/events", handler.ServeHTTP)
+		server := httptest.NewUnstartedServer(r)
+		server.Config.RegisterOnShutdown(handler.Shutdown)
+		server.Start()
+		t.Cleanup(server.Close)
+		orderUUID := uuid.New()
+
+		orderService.On("GetOrderStatusHistory", mock.Anything, orderUUID.String()).Return(testHistory, nil).Once()
+
+		_, body := openStream(t, context.Background(), server.URL+"/api/v1/orders/"+orderUUID.String()+"/events", "")
+		require.Contains(t, readEvent(t, body), "id: 1\n")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		require.NoError(t, server.Config.Shutdown(ctx))
+		_, err := io.ReadAll(body)
+		require.NoError(t, err)
+	})
+
+	t.Run("Order not found", func(t *testing.T) {
+		orderService := mocks.NewOrderService(t)
+		server := newTestServer(t, orderService, hub.NewHub(), time.Hour)
+		orderUUID := uuid.New()
+
+		orderService.On("GetOrderStatusHistory", mock.Anything, orderUUID.String()).Return(nil, model.ErrOrderNotFound).Once()
+
+		res, _ := openStream(t, context.Background(), server.URL+"/api/v1/orders/"+orderUUID.String()+"/events", "")
+
+		require.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("Invalid Last-Event-ID", func(t *testing.T) {
+		server := newTestServer(t, mocks.NewOrderService(t), hub.NewHub(), time.Hour)
+
+		res, _ := openStream(t, context.Background(), server.URL+"/api/v1/orders/"+uuid.NewString()+"/events", "abc")
+
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
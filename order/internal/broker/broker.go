@@ -0,0 +1,20 @@
+package broker
+
+import (
+	"context"
+)
+
+// Message - сообщение для публикации в брокер
+type Message struct {
+	// Key определяет партицию: сообщения с одним ключом сохраняют порядок
+	Key     []byte
+	Value   []byte
+	Headers map[string]string
+}
+
+type Publisher interface {
+	// Publish публикует сообщения в топик и возвращает управление только после
+	// подтверждения брокером. При ошибке часть сообщений могла быть опубликована.
+	Publish(ctx context.Context, topic string, messages ...Message) error
+	Close() error
+}
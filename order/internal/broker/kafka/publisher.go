@@ -0,0 +1,55 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/segmentio/kafka-go"
+
+	def "github.com/xgmsx/rsf/order/internal/broker"
+)
+
+var _ def.Publisher = (*publisher)(nil)
+
+type publisher struct {
+	writer *kafka.Writer
+}
+
+// NewPublisher создает публикатора в Kafka. Запись подтверждается всеми
+// синхронными репликами, сообщения с одним ключом попадают в одну партицию.
+func NewPublisher(brokers ...string) *publisher {
+	return &publisher{
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+		},
+	}
+}
+
+func (p *publisher) Publish(ctx context.Context, topic string, messages ...def.Message) error {
+	kafkaMessages := make([]kafka.Message, len(messages))
+	for i, msg := range messages {
+		headers := make([]kafka.Header, 0, len(msg.Headers))
+		for key, value := range msg.Headers {
+			headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+		}
+		kafkaMessages[i] = kafka.Message{
+			Topic:   topic,
+			Key:     msg.Key,
+			Value:   msg.Value,
+			Headers: headers,
+		}
+	}
+
+	err := p.writer.WriteMessages(ctx, kafkaMessages...)
+	if err != nil {
+		return fmt.Errorf("failed to publish to kafka topic %s: %w", topic, err)
+	}
+	return nil
+}
+
+func (p *publisher) Close() error {
+	return p.writer.Close()
+}
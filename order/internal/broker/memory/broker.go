@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	def "github.com/xgmsx/rsf/order/internal/broker"
+)
+
+var _ def.Publisher = (*broker)(nil)
+
+// broker хранит опубликованные сообщения в памяти процесса.
+// Используется в тестах и при локальном запуске без Kafka.
+type broker struct {
+	mu     sync.RWMutex
+	topics map[string][]def.Message
+}
+
+func NewBroker() *broker {
+	return &broker{
+		topics: make(map[string][]def.Message),
+	}
+}
+
+func (b *broker) Publish(ctx context.Context, topic string, messages ...def.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.topics[topic] = append(b.topics[topic], messages...)
+	return nil
+}
+
+// Messages возвращает сообщения топика в порядке публикации
+func (b *broker) Messages(topic string) []def.Message {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return slices.Clone(b.topics[topic])
+}
+
+func (b *broker) Close() error {
+	return nil
+}
@@ -2,17 +2,40 @@ package client
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/shared/pkg/money"
 	genInventoryV1 "github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1"
 )
 
 type InventoryClient interface {
 	GetParts(ctx context.Context, uuids []uuid.UUID) (parts []*genInventoryV1.Part, err error)
+	// ReserveParts резервирует детали позиций заказа в нужном количестве на время ttl.
+	// Возвращает model.ErrPartsOutOfStock, если деталей не хватает.
+	ReserveParts(ctx context.Context, reservationID uuid.UUID, items []model.OrderItem, ttl time.Duration) error
+	// CommitReservation подтверждает резерв. Возвращает model.ErrReservationExpired,
+	// если резерв уже истек или был снят.
+	CommitReservation(ctx context.Context, reservationID uuid.UUID) error
+	ReleaseReservation(ctx context.Context, reservationID uuid.UUID) error
+	// Check проверяет, что inventory готов обрабатывать запросы
+	Check(ctx context.Context) error
+}
+
+type WebhookClient interface {
+	// Send отправляет доставку на url с подписью secret. Возвращает ошибку,
+	// если получатель недоступен или ответил статусом вне диапазона 2xx.
+	Send(ctx context.Context, url, secret string, delivery model.WebhookDelivery) error
 }
 
 type PaymentClient interface {
-	PayOrder(ctx context.Context, userUUID, orderUUID uuid.UUID, paymentMethod model.PaymentMethod) (txUUID *uuid.UUID, err error)
+	PayOrder(ctx context.Context, userUUID, orderUUID uuid.UUID, paymentMethod model.PaymentMethod, amount money.Money) (txUUID *uuid.UUID, err error)
+	// RefundPayment возвращает amount по транзакции оплаты. Повторный вызов
+	// с тем же refundUUID не возвращает средства еще раз. Возвращает
+	// model.ErrRefundExceedsPayment, если сумма больше невозвращенного остатка.
+	RefundPayment(ctx context.Context, txUUID, refundUUID uuid.UUID, amount money.Money, reason string) error
+	// Check проверяет, что payment готов обрабатывать запросы
+	Check(ctx context.Context) error
 }
@@ -2,13 +2,19 @@ package inventory
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	def "github.com/xgmsx/rsf/order/internal/client"
+	"github.com/xgmsx/rsf/order/internal/client/resilience"
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/shared/pkg/health"
 	genInventoryV1 "github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1"
 )
 
@@ -16,20 +22,35 @@ var _ def.InventoryClient = (*client)(nil)
 
 type client struct {
 	generatedClient genInventoryV1.InventoryServiceClient
+	healthClient    healthpb.HealthClient
 }
 
-func NewClient(addr string) *client {
-	conn, err := grpc.NewClient(
-		addr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+const serviceName = "inventory"
+
+// NewClient создает клиент inventory. Все методы inventory идемпотентны:
+// резерв создается и снимается по идентификатору заказа, поэтому
+// все вызовы повторяются при недоступности сервиса.
+func NewClient(addr string, cfg resilience.Config) (*client, error) {
+	conn, err := resilience.NewConn(serviceName, addr, cfg,
+		genInventoryV1.InventoryService_GetPart_FullMethodName,
+		genInventoryV1.InventoryService_ListParts_FullMethodName,
+		genInventoryV1.InventoryService_ReserveParts_FullMethodName,
+		genInventoryV1.InventoryService_CommitReservation_FullMethodName,
+		genInventoryV1.InventoryService_ReleaseReservation_FullMethodName,
 	)
 	if err != nil {
-		log.Fatalf("failed to connect to %s: %v\n", addr, err)
+		return nil, err
 	}
 
 	return &client{
 		generatedClient: genInventoryV1.NewInventoryServiceClient(conn),
-	}
+		healthClient:    healthpb.NewHealthClient(conn),
+	}, nil
+}
+
+// Check проверяет готовность inventory через grpc.health.v1
+func (c *client) Check(ctx context.Context) error {
+	return health.GRPCCheck(c.healthClient, genInventoryV1.InventoryService_ServiceDesc.ServiceName)(ctx)
 }
 
 func (c *client) GetParts(ctx context.Context, uuids []uuid.UUID) ([]*genInventoryV1.Part, error) {
@@ -46,8 +67,55 @@ func (c *client) GetParts(ctx context.Context, uuids []uuid.UUID) ([]*genInvento
 		Filter: partsFilter,
 	})
 	if err != nil {
-		return nil, err
+		return nil, resilience.WrapError(serviceName, err)
 	}
 
 	return res.Parts, nil
 }
+
+func (c *client) ReserveParts(ctx context.Context, reservationID uuid.UUID, items []model.OrderItem, ttl time.Duration) error {
+	reservationItems := make([]*genInventoryV1.ReservationItem, len(items))
+	for i, item := range items {
+		reservationItems[i] = &genInventoryV1.ReservationItem{
+			PartUuid: item.PartUUID.String(),
+			Quantity: item.Quantity,
+		}
+	}
+
+	_, err := c.generatedClient.ReserveParts(ctx, &genInventoryV1.ReservePartsRequest{
+		ReservationId: reservationID.String(),
+		Items:         reservationItems,
+		Ttl:           durationpb.New(ttl),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%s: %w", status.Convert(err).Message(), model.ErrPartsOutOfStock)
+	case codes.NotFound:
+		return fmt.Errorf("%s: %w", status.Convert(err).Message(), model.ErrPartDoesNotExist)
+	default:
+		return resilience.WrapError(serviceName, err)
+	}
+}
+
+func (c *client) CommitReservation(ctx context.Context, reservationID uuid.UUID) error {
+	_, err := c.generatedClient.CommitReservation(ctx, &genInventoryV1.CommitReservationRequest{
+		ReservationId: reservationID.String(),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.FailedPrecondition, codes.NotFound:
+		return fmt.Errorf("%s: %w", status.Convert(err).Message(), model.ErrReservationExpired)
+	default:
+		return resilience.WrapError(serviceName, err)
+	}
+}
+
+func (c *client) ReleaseReservation(ctx context.Context, reservationID uuid.UUID) error {
+	_, err := c.generatedClient.ReleaseReservation(ctx, &genInventoryV1.ReleaseReservationRequest{
+		ReservationId: reservationID.String(),
+	})
+	return resilience.WrapError(serviceName, err)
+}
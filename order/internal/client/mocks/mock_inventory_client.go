@@ -0,0 +1,291 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	inventory_v1 "github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1"
+
+	model "github.com/xgmsx/rsf/order/internal/model"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// InventoryClient is an autogenerated mock type for the InventoryClient type
+type InventoryClient struct {
+	mock.Mock
+}
+
+type InventoryClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InventoryClient) EXPECT() *InventoryClient_Expecter {
+	return &InventoryClient_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: ctx
+func (_m *InventoryClient) Check(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type InventoryClient_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InventoryClient_Expecter) Check(ctx interface{}) *InventoryClient_Check_Call {
+	return &InventoryClient_Check_Call{Call: _e.mock.On("Check", ctx)}
+}
+
+func (_c *InventoryClient_Check_Call) Run(run func(ctx context.Context)) *InventoryClient_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_Check_Call) Return(_a0 error) *InventoryClient_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_Check_Call) RunAndReturn(run func(context.Context) error) *InventoryClient_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CommitReservation provides a mock function with given fields: ctx, reservationID
+func (_m *InventoryClient) CommitReservation(ctx context.Context, reservationID uuid.UUID) error {
+	ret := _m.Called(ctx, reservationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, reservationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type InventoryClient_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationID uuid.UUID
+func (_e *InventoryClient_Expecter) CommitReservation(ctx interface{}, reservationID interface{}) *InventoryClient_CommitReservation_Call {
+	return &InventoryClient_CommitReservation_Call{Call: _e.mock.On("CommitReservation", ctx, reservationID)}
+}
+
+func (_c *InventoryClient_CommitReservation_Call) Run(run func(ctx context.Context, reservationID uuid.UUID)) *InventoryClient_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_CommitReservation_Call) Return(_a0 error) *InventoryClient_CommitReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_CommitReservation_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *InventoryClient_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetParts provides a mock function with given fields: ctx, uuids
+func (_m *InventoryClient) GetParts(ctx context.Context, uuids []uuid.UUID) ([]*inventory_v1.Part, error) {
+	ret := _m.Called(ctx, uuids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParts")
+	}
+
+	var r0 []*inventory_v1.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*inventory_v1.Part, error)); ok {
+		return rf(ctx, uuids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*inventory_v1.Part); ok {
+		r0 = rf(ctx, uuids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*inventory_v1.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, uuids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryClient_GetParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParts'
+type InventoryClient_GetParts_Call struct {
+	*mock.Call
+}
+
+// GetParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuids []uuid.UUID
+func (_e *InventoryClient_Expecter) GetParts(ctx interface{}, uuids interface{}) *InventoryClient_GetParts_Call {
+	return &InventoryClient_GetParts_Call{Call: _e.mock.On("GetParts", ctx, uuids)}
+}
+
+func (_c *InventoryClient_GetParts_Call) Run(run func(ctx context.Context, uuids []uuid.UUID)) *InventoryClient_GetParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_GetParts_Call) Return(parts []*inventory_v1.Part, err error) *InventoryClient_GetParts_Call {
+	_c.Call.Return(parts, err)
+	return _c
+}
+
+func (_c *InventoryClient_GetParts_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]*inventory_v1.Part, error)) *InventoryClient_GetParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReservation provides a mock function with given fields: ctx, reservationID
+func (_m *InventoryClient) ReleaseReservation(ctx context.Context, reservationID uuid.UUID) error {
+	ret := _m.Called(ctx, reservationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, reservationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_ReleaseReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReservation'
+type InventoryClient_ReleaseReservation_Call struct {
+	*mock.Call
+}
+
+// ReleaseReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationID uuid.UUID
+func (_e *InventoryClient_Expecter) ReleaseReservation(ctx interface{}, reservationID interface{}) *InventoryClient_ReleaseReservation_Call {
+	return &InventoryClient_ReleaseReservation_Call{Call: _e.mock.On("ReleaseReservation", ctx, reservationID)}
+}
+
+func (_c *InventoryClient_ReleaseReservation_Call) Run(run func(ctx context.Context, reservationID uuid.UUID)) *InventoryClient_ReleaseReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ReleaseReservation_Call) Return(_a0 error) *InventoryClient_ReleaseReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_ReleaseReservation_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *InventoryClient_ReleaseReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveParts provides a mock function with given fields: ctx, reservationID, items, ttl
+func (_m *InventoryClient) ReserveParts(ctx context.Context, reservationID uuid.UUID, items []model.OrderItem, ttl time.Duration) error {
+	ret := _m.Called(ctx, reservationID, items, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveParts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []model.OrderItem, time.Duration) error); ok {
+		r0 = rf(ctx, reservationID, items, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_ReserveParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveParts'
+type InventoryClient_ReserveParts_Call struct {
+	*mock.Call
+}
+
+// ReserveParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationID uuid.UUID
+//   - items []model.OrderItem
+//   - ttl time.Duration
+func (_e *InventoryClient_Expecter) ReserveParts(ctx interface{}, reservationID interface{}, items interface{}, ttl interface{}) *InventoryClient_ReserveParts_Call {
+	return &InventoryClient_ReserveParts_Call{Call: _e.mock.On("ReserveParts", ctx, reservationID, items, ttl)}
+}
+
+func (_c *InventoryClient_ReserveParts_Call) Run(run func(ctx context.Context, reservationID uuid.UUID, items []model.OrderItem, ttl time.Duration)) *InventoryClient_ReserveParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]model.OrderItem), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ReserveParts_Call) Return(_a0 error) *InventoryClient_ReserveParts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_ReserveParts_Call) RunAndReturn(run func(context.Context, uuid.UUID, []model.OrderItem, time.Duration) error) *InventoryClient_ReserveParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInventoryClient creates a new instance of InventoryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInventoryClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InventoryClient {
+	mock := &InventoryClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,199 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/xgmsx/rsf/order/internal/model"
+
+	money "github.com/xgmsx/rsf/shared/pkg/money"
+
+	uuid "github.com/google/uuid"
+)
+
+// PaymentClient is an autogenerated mock type for the PaymentClient type
+type PaymentClient struct {
+	mock.Mock
+}
+
+type PaymentClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PaymentClient) EXPECT() *PaymentClient_Expecter {
+	return &PaymentClient_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: ctx
+func (_m *PaymentClient) Check(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentClient_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type PaymentClient_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PaymentClient_Expecter) Check(ctx interface{}) *PaymentClient_Check_Call {
+	return &PaymentClient_Check_Call{Call: _e.mock.On("Check", ctx)}
+}
+
+func (_c *PaymentClient_Check_Call) Run(run func(ctx context.Context)) *PaymentClient_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_Check_Call) Return(_a0 error) *PaymentClient_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentClient_Check_Call) RunAndReturn(run func(context.Context) error) *PaymentClient_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PayOrder provides a mock function with given fields: ctx, userUUID, orderUUID, paymentMethod, amount
+func (_m *PaymentClient) PayOrder(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID, paymentMethod model.PaymentMethod, amount money.Money) (*uuid.UUID, error) {
+	ret := _m.Called(ctx, userUUID, orderUUID, paymentMethod, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PayOrder")
+	}
+
+	var r0 *uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, model.PaymentMethod, money.Money) (*uuid.UUID, error)); ok {
+		return rf(ctx, userUUID, orderUUID, paymentMethod, amount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, model.PaymentMethod, money.Money) *uuid.UUID); ok {
+		r0 = rf(ctx, userUUID, orderUUID, paymentMethod, amount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, model.PaymentMethod, money.Money) error); ok {
+		r1 = rf(ctx, userUUID, orderUUID, paymentMethod, amount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentClient_PayOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PayOrder'
+type PaymentClient_PayOrder_Call struct {
+	*mock.Call
+}
+
+// PayOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - orderUUID uuid.UUID
+//   - paymentMethod model.PaymentMethod
+//   - amount money.Money
+func (_e *PaymentClient_Expecter) PayOrder(ctx interface{}, userUUID interface{}, orderUUID interface{}, paymentMethod interface{}, amount interface{}) *PaymentClient_PayOrder_Call {
+	return &PaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, userUUID, orderUUID, paymentMethod, amount)}
+}
+
+func (_c *PaymentClient_PayOrder_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, orderUUID uuid.UUID, paymentMethod model.PaymentMethod, amount money.Money)) *PaymentClient_PayOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(model.PaymentMethod), args[4].(money.Money))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_PayOrder_Call) Return(txUUID *uuid.UUID, err error) *PaymentClient_PayOrder_Call {
+	_c.Call.Return(txUUID, err)
+	return _c
+}
+
+func (_c *PaymentClient_PayOrder_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, model.PaymentMethod, money.Money) (*uuid.UUID, error)) *PaymentClient_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundPayment provides a mock function with given fields: ctx, txUUID, refundUUID, amount, reason
+func (_m *PaymentClient) RefundPayment(ctx context.Context, txUUID uuid.UUID, refundUUID uuid.UUID, amount money.Money, reason string) error {
+	ret := _m.Called(ctx, txUUID, refundUUID, amount, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, money.Money, string) error); ok {
+		r0 = rf(ctx, txUUID, refundUUID, amount, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentClient_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type PaymentClient_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - txUUID uuid.UUID
+//   - refundUUID uuid.UUID
+//   - amount money.Money
+//   - reason string
+func (_e *PaymentClient_Expecter) RefundPayment(ctx interface{}, txUUID interface{}, refundUUID interface{}, amount interface{}, reason interface{}) *PaymentClient_RefundPayment_Call {
+	return &PaymentClient_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, txUUID, refundUUID, amount, reason)}
+}
+
+func (_c *PaymentClient_RefundPayment_Call) Run(run func(ctx context.Context, txUUID uuid.UUID, refundUUID uuid.UUID, amount money.Money, reason string)) *PaymentClient_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(money.Money), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_RefundPayment_Call) Return(_a0 error) *PaymentClient_RefundPayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentClient_RefundPayment_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, money.Money, string) error) *PaymentClient_RefundPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPaymentClient creates a new instance of PaymentClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPaymentClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PaymentClient {
+	mock := &PaymentClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
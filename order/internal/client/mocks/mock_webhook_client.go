@@ -0,0 +1,86 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/xgmsx/rsf/order/internal/model"
+)
+
+// WebhookClient is an autogenerated mock type for the WebhookClient type
+type WebhookClient struct {
+	mock.Mock
+}
+
+type WebhookClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookClient) EXPECT() *WebhookClient_Expecter {
+	return &WebhookClient_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, url, secret, delivery
+func (_m *WebhookClient) Send(ctx context.Context, url string, secret string, delivery model.WebhookDelivery) error {
+	ret := _m.Called(ctx, url, secret, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.WebhookDelivery) error); ok {
+		r0 = rf(ctx, url, secret, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookClient_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type WebhookClient_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - url string
+//   - secret string
+//   - delivery model.WebhookDelivery
+func (_e *WebhookClient_Expecter) Send(ctx interface{}, url interface{}, secret interface{}, delivery interface{}) *WebhookClient_Send_Call {
+	return &WebhookClient_Send_Call{Call: _e.mock.On("Send", ctx, url, secret, delivery)}
+}
+
+func (_c *WebhookClient_Send_Call) Run(run func(ctx context.Context, url string, secret string, delivery model.WebhookDelivery)) *WebhookClient_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.WebhookDelivery))
+	})
+	return _c
+}
+
+func (_c *WebhookClient_Send_Call) Return(_a0 error) *WebhookClient_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookClient_Send_Call) RunAndReturn(run func(context.Context, string, string, model.WebhookDelivery) error) *WebhookClient_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookClient creates a new instance of WebhookClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookClient {
+	mock := &WebhookClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,14 +2,19 @@ package payment
 
 import (
 	"context"
-	"log"
+	"fmt"
 
 	"github.com/google/uuid"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 
 	def "github.com/xgmsx/rsf/order/internal/client"
+	"github.com/xgmsx/rsf/order/internal/client/resilience"
 	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/shared/pkg/health"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+	genCommonV1 "github.com/xgmsx/rsf/shared/pkg/proto/common/v1"
 	genPaymentV1 "github.com/xgmsx/rsf/shared/pkg/proto/payment/v1"
 )
 
@@ -17,23 +22,34 @@ var _ def.PaymentClient = (*client)(nil)
 
 type client struct {
 	generatedClient genPaymentV1.PaymentServiceClient
+	healthClient    healthpb.HealthClient
 }
 
-func NewClient(addr string) *client {
-	conn, err := grpc.NewClient(
-		addr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+const serviceName = "payment"
+
+// NewClient создает клиент payment. Повторяется только PayOrder: повторная
+// оплата заказа возвращает уже проведенную транзакцию, а повтор возврата
+// вернул бы средства дважды.
+func NewClient(addr string, cfg resilience.Config) (*client, error) {
+	conn, err := resilience.NewConn(serviceName, addr, cfg,
+		genPaymentV1.PaymentService_PayOrder_FullMethodName,
 	)
 	if err != nil {
-		log.Fatalf("failed to connect to %s: %v\n", addr, err)
+		return nil, err
 	}
 
 	return &client{
 		generatedClient: genPaymentV1.NewPaymentServiceClient(conn),
-	}
+		healthClient:    healthpb.NewHealthClient(conn),
+	}, nil
 }
 
-func (c *client) PayOrder(ctx context.Context, userUUID, orderUUID uuid.UUID, paymentMethod model.PaymentMethod) (*uuid.UUID, error) {
+// Check проверяет готовность payment через grpc.health.v1
+func (c *client) Check(ctx context.Context) error {
+	return health.GRPCCheck(c.healthClient, genPaymentV1.PaymentService_ServiceDesc.ServiceName)(ctx)
+}
+
+func (c *client) PayOrder(ctx context.Context, userUUID, orderUUID uuid.UUID, paymentMethod model.PaymentMethod, amount money.Money) (*uuid.UUID, error) {
 	paymentMethodsMap := map[model.PaymentMethod]genPaymentV1.PaymentMethod{
 		model.PaymentMethodCARD:          genPaymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
 		model.PaymentMethodSBP:           genPaymentV1.PaymentMethod_PAYMENT_METHOD_SBP,
@@ -49,9 +65,17 @@ func (c *client) PayOrder(ctx context.Context, userUUID, orderUUID uuid.UUID, pa
 		OrderUuid:     orderUUID.String(),
 		UserUuid:      userUUID.String(),
 		PaymentMethod: genPaymentMethod,
+		Amount: &genCommonV1.Money{
+			Amount:   amount.Amount,
+			Currency: amount.Currency,
+		},
 	})
-	if err != nil {
-		return nil, err
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return nil, fmt.Errorf("%s: %w", status.Convert(err).Message(), model.ErrPaymentDeclined)
+	default:
+		return nil, resilience.WrapError(serviceName, err)
 	}
 
 	txUUID, err := uuid.Parse(res.TransactionUuid)
@@ -61,3 +85,25 @@ func (c *client) PayOrder(ctx context.Context, userUUID, orderUUID uuid.UUID, pa
 
 	return &txUUID, nil
 }
+
+func (c *client) RefundPayment(ctx context.Context, txUUID, refundUUID uuid.UUID, amount money.Money, reason string) error {
+	_, err := c.generatedClient.RefundPayment(ctx, &genPaymentV1.RefundPaymentRequest{
+		TransactionUuid: txUUID.String(),
+		Amount: &genCommonV1.Money{
+			Amount:   amount.Amount,
+			Currency: amount.Currency,
+		},
+		Reason:     reason,
+		RefundUuid: refundUUID.String(),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%s: %w", status.Convert(err).Message(), model.ErrRefundExceedsPayment)
+	case codes.InvalidArgument:
+		return fmt.Errorf("%s: %w", status.Convert(err).Message(), model.ErrInvalidRefundAmount)
+	default:
+		return fmt.Errorf("%w: %w", model.ErrFailedToProcessPayment, resilience.WrapError(serviceName, err))
+	}
+}
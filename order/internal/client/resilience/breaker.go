@@ -0,0 +1,134 @@
+package resilience
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type breakerState int
+
+const (
+	// stateClosed - вызовы проходят, неудачи подряд подсчитываются
+	stateClosed breakerState = iota
+	// stateOpen - вызовы сразу завершаются ошибкой UNAVAILABLE
+	stateOpen
+	// stateHalfOpen - пропускается один пробный вызов, по его результату
+	// circuit breaker замыкается или снова размыкается
+	stateHalfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case stateOpen:
+		return "open"
+	case stateHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// breaker - circuit breaker вызовов одной зависимости
+type breaker struct {
+	name        string
+	threshold   int
+	openTimeout time.Duration
+	now         func() time.Time
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+
+	stateGauge prometheus.Gauge
+	rejections prometheus.Counter
+}
+
+func newBreaker(name string, threshold int, openTimeout time.Duration) *breaker {
+	b := &breaker{
+		name:        name,
+		threshold:   max(threshold, 1),
+		openTimeout: openTimeout,
+		now:         time.Now,
+		stateGauge:  breakerStateGauge.WithLabelValues(name),
+		rejections:  breakerRejections.WithLabelValues(name),
+	}
+	b.stateGauge.Set(float64(stateClosed))
+	return b
+}
+
+// allow возвращает ошибку UNAVAILABLE, если вызов нужно отклонить без обращения к зависимости
+func (b *breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case stateOpen:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return b.reject()
+		}
+		b.setState(stateHalfOpen)
+		b.probing = true
+	case stateHalfOpen:
+		if b.probing {
+			return b.reject()
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+// record учитывает результат пропущенного вызова
+func (b *breaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if !isDependencyFailure(err) {
+		b.failures = 0
+		if b.state != stateClosed {
+			b.setState(stateClosed)
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == stateHalfOpen || b.failures >= b.threshold {
+		b.openedAt = b.now()
+		if b.state != stateOpen {
+			b.setState(stateOpen)
+		}
+	}
+}
+
+func (b *breaker) reject() error {
+	b.rejections.Inc()
+	return status.Errorf(codes.Unavailable, "circuit breaker for %s is open", b.name)
+}
+
+func (b *breaker) setState(state breakerState) {
+	slog.Warn("circuit breaker state changed",
+		slog.String("client", b.name),
+		slog.String("from", b.state.String()),
+		slog.String("to", state.String()),
+	)
+	b.state = state
+	b.stateGauge.Set(float64(state))
+}
+
+// isDependencyFailure сообщает, что ошибка вызвана недоступностью зависимости,
+// а не отказом по бизнес-правилам
+func isDependencyFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,90 @@
+package resilience
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestBreaker(now *time.Time) *breaker {
+	b := newBreaker("test-"+uuid.NewString(), 2, time.Minute)
+	b.now = func() time.Time { return *now }
+	return b
+}
+
+func TestBreaker(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	t.Run("Opens after consecutive failures", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		b := newTestBreaker(&now)
+
+		require.NoError(t, b.allow())
+		b.record(unavailable)
+		require.NoError(t, b.allow())
+		b.record(unavailable)
+
+		err := b.allow()
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, stateOpen, b.state)
+		require.Equal(t, float64(stateOpen), testutil.ToFloat64(b.stateGauge))
+		require.Equal(t, float64(1), testutil.ToFloat64(b.rejections))
+	})
+
+	t.Run("Business errors do not open", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		b := newTestBreaker(&now)
+
+		for range 5 {
+			require.NoError(t, b.allow())
+			b.record(status.Error(codes.FailedPrecondition, "not enough parts"))
+		}
+		require.Equal(t, stateClosed, b.state)
+	})
+
+	t.Run("Success resets failures", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		b := newTestBreaker(&now)
+
+		b.record(unavailable)
+		b.record(nil)
+		b.record(unavailable)
+		require.Equal(t, stateClosed, b.state)
+	})
+
+	t.Run("Half-open probe closes on success", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		b := newTestBreaker(&now)
+		b.record(unavailable)
+		b.record(unavailable)
+
+		now = now.Add(time.Minute)
+		require.NoError(t, b.allow())
+		require.Equal(t, stateHalfOpen, b.state)
+		// Пока пробный вызов не завершен, остальные отклоняются
+		require.Error(t, b.allow())
+
+		b.record(nil)
+		require.Equal(t, stateClosed, b.state)
+		require.NoError(t, b.allow())
+	})
+
+	t.Run("Half-open probe reopens on failure", func(t *testing.T) {
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		b := newTestBreaker(&now)
+		b.record(unavailable)
+		b.record(unavailable)
+
+		now = now.Add(time.Minute)
+		require.NoError(t, b.allow())
+		b.record(status.Error(codes.DeadlineExceeded, "timeout"))
+
+		require.Equal(t, stateOpen, b.state)
+		require.Error(t, b.allow())
+	})
+}
@@ -0,0 +1,23 @@
+package resilience
+
+import "time"
+
+// Config - настройки устойчивости gRPC-клиента
+type Config struct {
+	// Timeout ограничивает каждую попытку вызова, если у контекста нет более раннего дедлайна
+	Timeout time.Duration
+	// RetryAttempts - максимальное число попыток идемпотентного вызова
+	// при ответе UNAVAILABLE, включая первую
+	RetryAttempts int
+	// RetryBackoff - задержка перед второй попыткой, дальше удваивается
+	RetryBackoff time.Duration
+	// BreakerFailures - число неудачных вызовов подряд, после которого
+	// circuit breaker размыкается и вызовы сразу завершаются ошибкой
+	BreakerFailures int
+	// BreakerOpenTimeout - через сколько разомкнутый circuit breaker
+	// пропускает пробный вызов
+	BreakerOpenTimeout time.Duration
+	// ServiceToken передается в вызовах, сделанных не от имени пользователя,
+	// например фоновыми задачами; вызовы пользователя идут с его токеном
+	ServiceToken string
+}
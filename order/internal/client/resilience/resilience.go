@@ -0,0 +1,129 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/shared/pkg/interceptor"
+	"github.com/xgmsx/rsf/shared/pkg/tracing"
+)
+
+var (
+	breakerStateGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "grpc_client_breaker_state",
+		Help: "Circuit breaker state by dependency: 0 - closed, 1 - open, 2 - half-open.",
+	}, []string{"client"})
+	breakerRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_breaker_rejections_total",
+		Help: "Number of calls rejected by an open circuit breaker by dependency.",
+	}, []string{"client"})
+	retriesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_retries_total",
+		Help: "Number of retried calls by dependency.",
+	}, []string{"client"})
+)
+
+// NewConn создает соединение с зависимостью name по адресу addr. Каждый вызов
+// проходит через circuit breaker и ограничивается Config.Timeout, а вызовы
+// idempotentMethods (полные имена методов gRPC) повторяются при ответе UNAVAILABLE.
+// В вызов передается токен пользователя из контекста или Config.ServiceToken
+// и идентификатор запроса из контекста.
+func NewConn(name, addr string, cfg Config, idempotentMethods ...string) (*grpc.ClientConn, error) {
+	idempotent := make(map[string]bool, len(idempotentMethods))
+	for _, method := range idempotentMethods {
+		idempotent[method] = true
+	}
+
+	conn, err := grpc.NewClient(
+		addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(
+			interceptor.ForwardAuthInterceptor(cfg.ServiceToken),
+			interceptor.ForwardRequestIDInterceptor(),
+			breakerInterceptor(newBreaker(name, cfg.BreakerFailures, cfg.BreakerOpenTimeout)),
+			retryInterceptor(name, cfg, idempotent, retriesTotal.WithLabelValues(name)),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s client for %s: %w", name, addr, err)
+	}
+	return conn, nil
+}
+
+// breakerInterceptor отклоняет вызовы, пока circuit breaker разомкнут.
+// Повторы одного вызова учитываются как один вызов.
+func breakerInterceptor(b *breaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := b.allow()
+		if err != nil {
+			return err
+		}
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		b.record(err)
+		return err
+	}
+}
+
+// retryInterceptor ограничивает каждую попытку таймаутом и повторяет
+// идемпотентные вызовы при ответе UNAVAILABLE с экспоненциальной задержкой
+func retryInterceptor(name string, cfg Config, idempotent map[string]bool, retries prometheus.Counter) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		attempts := 1
+		if idempotent[method] {
+			attempts = max(cfg.RetryAttempts, 1)
+		}
+
+		backoff := cfg.RetryBackoff
+		for attempt := 1; ; attempt++ {
+			err := invokeWithTimeout(ctx, cfg.Timeout, method, req, reply, cc, invoker, opts...)
+			if err == nil || attempt >= attempts || status.Code(err) != codes.Unavailable {
+				return err
+			}
+
+			slog.WarnContext(ctx, "grpc call failed, retrying",
+				slog.String("client", name),
+				slog.String("method", method),
+				slog.Int("attempt", attempt),
+				slog.Int("attempts", attempts),
+				slog.Any("error", err),
+			)
+			retries.Inc()
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+	}
+}
+
+func invokeWithTimeout(ctx context.Context, timeout time.Duration, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// WrapError оборачивает ошибку недоступности зависимости name
+// в model.ErrServiceUnavailable, остальные ошибки возвращает как есть
+func WrapError(name string, err error) error {
+	if err == nil || errors.Is(err, model.ErrServiceUnavailable) || !isDependencyFailure(err) {
+		return err
+	}
+	return fmt.Errorf("%s: %w: %w", name, model.ErrServiceUnavailable, err)
+}
@@ -0,0 +1,125 @@
+package resilience
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+)
+
+const (
+	testIdempotentMethod = "/test.v1.TestService/Get"
+	testMethod           = "/test.v1.TestService/Create"
+)
+
+// fakeInvoker возвращает ошибки errs по очереди и запоминает дедлайны вызовов
+type fakeInvoker struct {
+	errs      []error
+	calls     int
+	deadlines []bool
+}
+
+func (f *fakeInvoker) invoke(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+	_, hasDeadline := ctx.Deadline()
+	f.deadlines = append(f.deadlines, hasDeadline)
+	f.calls++
+	if f.calls <= len(f.errs) {
+		return f.errs[f.calls-1]
+	}
+	return nil
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	cfg := Config{Timeout: time.Second, RetryAttempts: 3, RetryBackoff: time.Millisecond}
+	idempotent := map[string]bool{testIdempotentMethod: true}
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	testCases := []struct {
+		name          string
+		method        string
+		errs          []error
+		expectedCode  codes.Code
+		expectedCalls int
+	}{
+		{
+			name:          "Idempotent call retried until success",
+			method:        testIdempotentMethod,
+			errs:          []error{unavailable, unavailable},
+			expectedCode:  codes.OK,
+			expectedCalls: 3,
+		},
+		{
+			name:          "Retries limited by attempts",
+			method:        testIdempotentMethod,
+			errs:          []error{unavailable, unavailable, unavailable, unavailable},
+			expectedCode:  codes.Unavailable,
+			expectedCalls: 3,
+		},
+		{
+			name:          "Other codes not retried",
+			method:        testIdempotentMethod,
+			errs:          []error{status.Error(codes.FailedPrecondition, "not enough parts")},
+			expectedCode:  codes.FailedPrecondition,
+			expectedCalls: 1,
+		},
+		{
+			name:          "Non-idempotent call not retried",
+			method:        testMethod,
+			errs:          []error{unavailable},
+			expectedCode:  codes.Unavailable,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			invoker := &fakeInvoker{errs: tc.errs}
+			interceptor := retryInterceptor("test", cfg, idempotent, retriesTotal.WithLabelValues("test"))
+
+			// act
+			err := interceptor(context.Background(), tc.method, nil, nil, nil, invoker.invoke)
+
+			// assert
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			require.Equal(t, tc.expectedCalls, invoker.calls)
+			for _, hasDeadline := range invoker.deadlines {
+				require.True(t, hasDeadline)
+			}
+		})
+	}
+}
+
+func TestBreakerInterceptor(t *testing.T) {
+	b := newBreaker("test-interceptor", 1, time.Minute)
+	interceptor := breakerInterceptor(b)
+	invoker := &fakeInvoker{errs: []error{status.Error(codes.Unavailable, "connection refused")}}
+
+	err := interceptor(context.Background(), testMethod, nil, nil, nil, invoker.invoke)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Разомкнутый circuit breaker не обращается к зависимости
+	err = interceptor(context.Background(), testMethod, nil, nil, nil, invoker.invoke)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, 1, invoker.calls)
+}
+
+func TestWrapError(t *testing.T) {
+	require.NoError(t, WrapError("inventory", nil))
+
+	err := WrapError("inventory", status.Error(codes.Unavailable, "connection refused"))
+	require.ErrorIs(t, err, model.ErrServiceUnavailable)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	err = WrapError("inventory", status.Error(codes.DeadlineExceeded, "timeout"))
+	require.ErrorIs(t, err, model.ErrServiceUnavailable)
+
+	err = WrapError("inventory", status.Error(codes.Internal, "boom"))
+	require.NotErrorIs(t, err, model.ErrServiceUnavailable)
+}
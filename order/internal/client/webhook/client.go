@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	def "github.com/xgmsx/rsf/order/internal/client"
+	"github.com/xgmsx/rsf/order/internal/model"
+)
+
+var _ def.WebhookClient = (*client)(nil)
+
+const (
+	HeaderEventID   = "X-Webhook-Id"
+	HeaderEventType = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+	// maxErrorBodySize - сколько байт ответа получателя сохранять в описании ошибки
+	maxErrorBodySize = 512
+)
+
+type client struct {
+	httpClient *http.Client
+}
+
+// NewClient создает клиент, который отправляет вебхуки только на публичные адреса.
+// Адрес проверяется при подключении, после разрешения имени, поэтому запрет
+// нельзя обойти DNS-записью, измененной после регистрации, или редиректом.
+func NewClient(timeout time.Duration) *client {
+	return newClient(timeout, model.WebhookAddrAllowed)
+}
+
+func newClient(timeout time.Duration, allowed func(netip.Addr) bool) *client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !allowed(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", model.ErrWebhookAddrForbidden, address)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Без прокси: иначе проверялся бы адрес прокси, а не получателя
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &client{
+		httpClient: &http.Client{Timeout: timeout, Transport: transport},
+	}
+}
+
+func (c *client) Send(ctx context.Context, url, secret string, delivery model.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventID, delivery.EventUUID.String())
+	req.Header.Set(HeaderEventType, string(delivery.EventType))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(secret, timestamp, delivery.Payload))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return fmt.Errorf("%w: status %d: %s", model.ErrWebhookDeliveryRejected, resp.StatusCode, bytes.TrimSpace(body))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
+
+// Sign вычисляет подпись доставки: HMAC-SHA256 от строки "<timestamp>.<body>"
+// в шестнадцатеричном виде с префиксом "sha256=". Получатель должен вычислить
+// подпись тем же способом и сравнить ее со значением заголовка X-Webhook-Signature.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,110 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+)
+
+// allowAll разрешает отправку на локальные адреса тестовых получателей
+func allowAll(netip.Addr) bool { return true }
+
+func TestClientSend(t *testing.T) {
+	const secret = "whsec_test"
+	delivery := model.WebhookDelivery{
+		DeliveryUUID: uuid.New(),
+		EventUUID:    uuid.New(),
+		EventType:    model.WebhookEventOrderPaid,
+		Payload:      []byte(`{"type":"order.paid"}`),
+	}
+
+	testCases := []struct {
+		name        string
+		status      int
+		expectedErr error
+	}{
+		{
+			name:   "Accepted by receiver",
+			status: http.StatusNoContent,
+		},
+		{
+			name:        "Rejected by receiver",
+			status:      http.StatusInternalServerError,
+			expectedErr: model.ErrWebhookDeliveryRejected,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			var received *http.Request
+			var body []byte
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r
+				body, _ = io.ReadAll(r.Body)
+				w.WriteHeader(tc.status)
+			}))
+			defer receiver.Close()
+
+			// act
+			err := newClient(time.Second, allowAll).Send(context.Background(), receiver.URL, secret, delivery)
+
+			// assert
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NotNil(t, received)
+			require.Equal(t, delivery.Payload, body)
+			require.Equal(t, delivery.EventUUID.String(), received.Header.Get(HeaderEventID))
+			require.Equal(t, "order.paid", received.Header.Get(HeaderEventType))
+
+			expected := Sign(secret, received.Header.Get(HeaderTimestamp), body)
+			require.True(t, hmac.Equal([]byte(expected), []byte(received.Header.Get(HeaderSignature))))
+		})
+	}
+
+	t.Run("Receiver unavailable", func(t *testing.T) {
+		receiver := httptest.NewServer(http.NotFoundHandler())
+		receiver.Close()
+
+		err := newClient(time.Second, allowAll).Send(context.Background(), receiver.URL, secret, delivery)
+		require.Error(t, err)
+	})
+}
+
+func TestClientSendToForbiddenAddress(t *testing.T) {
+	received := false
+	receiver := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		received = true
+	}))
+	defer receiver.Close()
+
+	err := NewClient(time.Second).Send(context.Background(), receiver.URL, "whsec_test", model.WebhookDelivery{
+		EventUUID: uuid.New(),
+		EventType: model.WebhookEventOrderPaid,
+		Payload:   []byte(`{}`),
+	})
+
+	require.ErrorIs(t, err, model.ErrWebhookAddrForbidden)
+	require.False(t, received)
+}
+
+func TestSign(t *testing.T) {
+	signature := Sign("secret", "1700000000", []byte(`{}`))
+	require.Equal(t, "sha256=", signature[:7])
+	require.Len(t, signature, 7+64)
+	require.NotEqual(t, signature, Sign("other", "1700000000", []byte(`{}`)))
+	require.NotEqual(t, signature, Sign("secret", "1700000001", []byte(`{}`)))
+}
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/xgmsx/rsf/shared/pkg/auth"
+	"github.com/xgmsx/rsf/shared/pkg/config"
+	"github.com/xgmsx/rsf/shared/pkg/tracing"
+)
+
+const (
+	StorageMemory   = "memory"
+	StoragePostgres = "postgres"
+
+	BrokerMemory = "memory"
+	BrokerKafka  = "kafka"
+)
+
+// Config - настройки api-order
+type Config struct {
+	HTTPPort int `env:"ORDER_HTTP_PORT" default:"8080" min:"1" max:"65535"`
+	// ReadHeaderTimeout ограничивает чтение заголовков запроса
+	ReadHeaderTimeout time.Duration `env:"ORDER_READ_HEADER_TIMEOUT" default:"5s" min:"1ms"`
+	// RequestTimeout ограничивает обработку запросов order API
+	RequestTimeout time.Duration `env:"ORDER_REQUEST_TIMEOUT" default:"10s" min:"1ms"`
+	// ShutdownTimeout ограничивает остановку сервера и фоновых задач
+	ShutdownTimeout time.Duration `env:"ORDER_SHUTDOWN_TIMEOUT" default:"10s" min:"1ms"`
+	// LogLevel - минимальный уровень записей лога
+	LogLevel string `env:"ORDER_LOG_LEVEL" default:"info" oneof:"debug info warn error"`
+
+	Storage     string `env:"ORDER_STORAGE" default:"memory" oneof:"memory postgres"`
+	PostgresDSN string `env:"POSTGRES_DSN"`
+
+	PaymentTimeout time.Duration `env:"ORDER_PAYMENT_TIMEOUT" default:"15m" min:"1s"`
+	ExpiryInterval time.Duration `env:"ORDER_EXPIRY_INTERVAL" default:"30s" min:"1ms"`
+	// IdempotencyKeyTTL - срок хранения ключей идемпотентности и ответов на запросы с ними
+	IdempotencyKeyTTL time.Duration `env:"ORDER_IDEMPOTENCY_KEY_TTL" default:"24h" min:"1m"`
+
+	Broker         string        `env:"ORDER_BROKER" default:"memory" oneof:"memory kafka"`
+	KafkaBrokers   []string      `env:"KAFKA_BROKERS"`
+	EventsTopic    string        `env:"ORDER_EVENTS_TOPIC" default:"order.events"`
+	OutboxInterval time.Duration `env:"ORDER_OUTBOX_INTERVAL" default:"1s" min:"1ms"`
+
+	WebhookInterval time.Duration `env:"ORDER_WEBHOOK_INTERVAL" default:"5s" min:"1ms"`
+	WebhookTimeout  time.Duration `env:"ORDER_WEBHOOK_TIMEOUT" default:"10s" min:"1ms"`
+
+	SagaRecoveryInterval time.Duration `env:"ORDER_SAGA_RECOVERY_INTERVAL" default:"30s" min:"1ms"`
+	StreamHeartbeat      time.Duration `env:"ORDER_STREAM_HEARTBEAT" default:"15s" min:"1ms"`
+
+	// Токены пользователей проверяются HMAC-секретом или ключами из JWKS-файла
+	JWTSecret   string `env:"ORDER_JWT_SECRET"`
+	JWKSFile    string `env:"ORDER_JWKS_FILE"`
+	JWTIssuer   string `env:"ORDER_JWT_ISSUER"`
+	JWTAudience string `env:"ORDER_JWT_AUDIENCE"`
+
+	InventoryAddr string `env:"ORDER_INVENTORY_ADDR" default:"api-inventory:50051"`
+	PaymentAddr   string `env:"ORDER_PAYMENT_ADDR" default:"api-payment:50051"`
+	GRPCClient    GRPCClientConfig
+
+	Tracing tracing.Config
+}
+
+// GRPCClientConfig - настройки устойчивости gRPC-клиентов inventory и payment,
+// поля совпадают с resilience.Config
+type GRPCClientConfig struct {
+	Timeout            time.Duration `env:"ORDER_GRPC_TIMEOUT" default:"3s" min:"1ms"`
+	RetryAttempts      int           `env:"ORDER_GRPC_RETRY_ATTEMPTS" default:"3" min:"1"`
+	RetryBackoff       time.Duration `env:"ORDER_GRPC_RETRY_BACKOFF" default:"100ms" min:"1ms"`
+	BreakerFailures    int           `env:"ORDER_GRPC_BREAKER_FAILURES" default:"5" min:"1"`
+	BreakerOpenTimeout time.Duration `env:"ORDER_GRPC_BREAKER_OPEN_TIMEOUT" default:"30s" min:"1ms"`
+	ServiceToken       string        `env:"ORDER_GRPC_SERVICE_TOKEN"`
+}
+
+func (c Config) Validate() error {
+	var errs []error
+	if c.Storage == StoragePostgres && c.PostgresDSN == "" {
+		errs = append(errs, errors.New("POSTGRES_DSN is required for ORDER_STORAGE=postgres"))
+	}
+	if c.Broker == BrokerKafka && len(c.KafkaBrokers) == 0 {
+		errs = append(errs, errors.New("KAFKA_BROKERS is required for ORDER_BROKER=kafka"))
+	}
+	if (c.JWTSecret == "") == (c.JWKSFile == "") {
+		errs = append(errs, errors.New("exactly one of ORDER_JWT_SECRET and ORDER_JWKS_FILE is required"))
+	}
+	return errors.Join(errs...)
+}
+
+// Auth возвращает настройки проверки токенов пользователей
+func (c Config) Auth() auth.Config {
+	return auth.Config{
+		Secret:   c.JWTSecret,
+		JWKSFile: c.JWKSFile,
+		Issuer:   c.JWTIssuer,
+		Audience: c.JWTAudience,
+	}
+}
+
+// Load читает настройки из переменных окружения и файла из CONFIG_FILE
+func Load() (Config, error) {
+	var cfg Config
+	err := config.Load(&cfg)
+	return cfg, err
+}
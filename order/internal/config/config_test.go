@@ -0,0 +1,97 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgmsx/rsf/shared/pkg/config"
+)
+
+// lookupFrom возвращает переменные values поверх минимально необходимых
+func lookupFrom(values map[string]string) config.LookupFunc {
+	return func(key string) (string, bool) {
+		if value, ok := values[key]; ok {
+			return value, ok
+		}
+		if key == "ORDER_JWT_SECRET" {
+			return "secret", true
+		}
+		return "", false
+	}
+}
+
+func TestConfigDefaults(t *testing.T) {
+	var cfg Config
+	require.NoError(t, config.LoadFrom(&cfg, lookupFrom(nil)))
+
+	require.Equal(t, 8080, cfg.HTTPPort)
+	require.Equal(t, StorageMemory, cfg.Storage)
+	require.Equal(t, BrokerMemory, cfg.Broker)
+	require.Equal(t, 15*time.Minute, cfg.PaymentTimeout)
+	require.Equal(t, "api-inventory:50051", cfg.InventoryAddr)
+	require.Equal(t, "api-payment:50051", cfg.PaymentAddr)
+	require.Equal(t, 3*time.Second, cfg.GRPCClient.Timeout)
+	require.Equal(t, 5, cfg.GRPCClient.BreakerFailures)
+}
+
+func TestConfigValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  map[string]string
+		wantErr string
+	}{
+		{
+			name:   "Postgres with DSN",
+			values: map[string]string{"ORDER_STORAGE": "postgres", "POSTGRES_DSN": "postgres://localhost/order"},
+		},
+		{
+			name:    "Postgres without DSN",
+			values:  map[string]string{"ORDER_STORAGE": "postgres"},
+			wantErr: "POSTGRES_DSN is required",
+		},
+		{
+			name:    "Kafka without brokers",
+			values:  map[string]string{"ORDER_BROKER": "kafka"},
+			wantErr: "KAFKA_BROKERS is required",
+		},
+		{
+			name:    "Without JWT key",
+			values:  map[string]string{"ORDER_JWT_SECRET": ""},
+			wantErr: "ORDER_JWT_SECRET",
+		},
+		{
+			name:    "Both JWT keys",
+			values:  map[string]string{"ORDER_JWKS_FILE": "/etc/order/jwks.json"},
+			wantErr: "ORDER_JWKS_FILE",
+		},
+		{
+			name:    "Unknown storage",
+			values:  map[string]string{"ORDER_STORAGE": "mysql"},
+			wantErr: "ORDER_STORAGE",
+		},
+		{
+			name:    "Invalid port",
+			values:  map[string]string{"ORDER_HTTP_PORT": "70000"},
+			wantErr: "ORDER_HTTP_PORT",
+		},
+		{
+			name:    "Invalid timeout",
+			values:  map[string]string{"ORDER_GRPC_TIMEOUT": "soon"},
+			wantErr: "ORDER_GRPC_TIMEOUT",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg Config
+			err := config.LoadFrom(&cfg, lookupFrom(tt.values))
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
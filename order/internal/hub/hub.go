@@ -0,0 +1,82 @@
+package hub
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+)
+
+// Hub рассылает уведомления об изменении заказов подписчикам внутри процесса.
+// Уведомление не несет данных: подписчик сам перечитывает состояние заказа.
+type Hub interface {
+	// Subscribe подписывает на изменения заказа. Несколько изменений между
+	// чтениями канала объединяются в одно уведомление. unsubscribe нужно
+	// вызвать после окончания чтения.
+	Subscribe(orderUUID uuid.UUID) (updates <-chan struct{}, unsubscribe func())
+	// Notify уведомляет всех подписчиков заказа, не блокируясь на медленных
+	Notify(orderUUID uuid.UUID)
+}
+
+var _ Hub = (*hub)(nil)
+
+type hub struct {
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[chan struct{}]struct{}
+}
+
+func NewHub() *hub {
+	return &hub{
+		subscribers: make(map[uuid.UUID]map[chan struct{}]struct{}),
+	}
+}
+
+func (h *hub) Subscribe(orderUUID uuid.UUID) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.subscribers[orderUUID] == nil {
+		h.subscribers[orderUUID] = make(map[chan struct{}]struct{})
+	}
+	h.subscribers[orderUUID][ch] = struct{}{}
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			delete(h.subscribers[orderUUID], ch)
+			if len(h.subscribers[orderUUID]) == 0 {
+				delete(h.subscribers, orderUUID)
+			}
+		})
+	}
+	return ch, unsubscribe
+}
+
+func (h *hub) Notify(orderUUID uuid.UUID) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers[orderUUID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// Уведомление уже ждет чтения
+		}
+	}
+}
+
+// NotifyOutbox уведомляет подписчиков заказов из опубликованных событий outbox,
+// подходит как обработчик для outbox relay
+func (h *hub) NotifyOutbox(_ context.Context, messages []model.OutboxMessage) error {
+	for _, msg := range messages {
+		h.Notify(msg.OrderUUID)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package hub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+)
+
+func notified(updates <-chan struct{}) bool {
+	select {
+	case <-updates:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestHub(t *testing.T) {
+	t.Run("All subscribers of the order notified", func(t *testing.T) {
+		h := NewHub()
+		orderUUID := uuid.New()
+		first, unsubscribeFirst := h.Subscribe(orderUUID)
+		defer unsubscribeFirst()
+		second, unsubscribeSecond := h.Subscribe(orderUUID)
+		defer unsubscribeSecond()
+		other, unsubscribeOther := h.Subscribe(uuid.New())
+		defer unsubscribeOther()
+
+		h.Notify(orderUUID)
+
+		require.True(t, notified(first))
+		require.True(t, notified(second))
+		require.False(t, notified(other))
+	})
+
+	t.Run("Pending notifications coalesced", func(t *testing.T) {
+		h := NewHub()
+		orderUUID := uuid.New()
+		updates, unsubscribe := h.Subscribe(orderUUID)
+		defer unsubscribe()
+
+		h.Notify(orderUUID)
+		h.Notify(orderUUID)
+
+		require.True(t, notified(updates))
+		require.False(t, notified(updates))
+	})
+
+	t.Run("Unsubscribed channel not notified", func(t *testing.T) {
+		h := NewHub()
+		orderUUID := uuid.New()
+		updates, unsubscribe := h.Subscribe(orderUUID)
+		unsubscribe()
+		unsubscribe()
+
+		h.Notify(orderUUID)
+
+		require.False(t, notified(updates))
+		require.Empty(t, h.subscribers)
+	})
+
+	t.Run("Outbox events notify their orders", func(t *testing.T) {
+		h := NewHub()
+		orderUUID := uuid.New()
+		updates, unsubscribe := h.Subscribe(orderUUID)
+		defer unsubscribe()
+
+		err := h.NotifyOutbox(context.Background(), []model.OutboxMessage{{OrderUUID: uuid.New()}, {OrderUUID: orderUUID}})
+
+		require.NoError(t, err)
+		require.True(t, notified(updates))
+	})
+}
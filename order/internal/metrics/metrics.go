@@ -0,0 +1,73 @@
+// Package metrics считает бизнес-события заказов в формате Prometheus
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+)
+
+var (
+	ordersCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "order_orders_created_total",
+		Help: "Number of created orders.",
+	})
+	ordersPaid = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "order_orders_paid_total",
+		Help: "Number of paid orders by payment method.",
+	}, []string{"payment_method"})
+	ordersCancelled = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "order_orders_cancelled_total",
+		Help: "Number of orders cancelled by users.",
+	})
+	ordersExpired = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "order_orders_expired_total",
+		Help: "Number of orders expired without payment.",
+	})
+	ordersRefunded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "order_orders_refunded_total",
+		Help: "Number of refunds by resulting order status.",
+	}, []string{"status"})
+	revenue = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "order_revenue_total",
+		Help: "Paid amount in major currency units by payment method and currency.",
+	}, []string{"payment_method", "currency"})
+	refunded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "order_refunded_amount_total",
+		Help: "Refunded amount in major currency units by currency.",
+	}, []string{"currency"})
+	partsOutOfStock = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "order_parts_out_of_stock_total",
+		Help: "Number of orders rejected because parts were out of stock.",
+	})
+)
+
+func OrderCreated() {
+	ordersCreated.Inc()
+}
+
+// OrderPaid учитывает оплату заказа и ее сумму в выручке
+func OrderPaid(method model.PaymentMethod, amount money.Money) {
+	ordersPaid.WithLabelValues(string(method)).Inc()
+	revenue.WithLabelValues(string(method), amount.Currency).Add(amount.Float64())
+}
+
+func OrderCancelled() {
+	ordersCancelled.Inc()
+}
+
+func OrderExpired() {
+	ordersExpired.Inc()
+}
+
+// OrderRefunded учитывает возврат amount, после которого заказ перешел в status
+func OrderRefunded(status model.OrderStatus, amount money.Money) {
+	ordersRefunded.WithLabelValues(string(status)).Inc()
+	refunded.WithLabelValues(amount.Currency).Add(amount.Float64())
+}
+
+func PartsOutOfStock() {
+	partsOutOfStock.Inc()
+}
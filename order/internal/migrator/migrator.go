@@ -0,0 +1,38 @@
+package migrator
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"log/slog"
+
+	"github.com/pressly/goose/v3"
+)
+
+type Migrator struct {
+	provider *goose.Provider
+}
+
+func NewMigrator(db *sql.DB, migrations fs.FS) (*Migrator, error) {
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, migrations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration provider: %w", err)
+	}
+
+	return &Migrator{provider: provider}, nil
+}
+
+// Up применяет все еще не примененные миграции
+func (m *Migrator) Up(ctx context.Context) error {
+	results, err := m.provider.Up(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	for _, res := range results {
+		slog.InfoContext(ctx, "migration applied", slog.String("path", res.Source.Path), slog.Duration("duration", res.Duration))
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package converter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+	genCommonV1 "github.com/xgmsx/rsf/shared/pkg/proto/common/v1"
+	genOrderEventsV1 "github.com/xgmsx/rsf/shared/pkg/proto/order/v1"
+)
+
+func OrderCreatedToEvent(order model.Order) *genOrderEventsV1.OrderEvent {
+	items := make([]*genOrderEventsV1.OrderItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, &genOrderEventsV1.OrderItem{
+			PartUuid:  item.PartUUID.String(),
+			Quantity:  item.Quantity,
+			UnitPrice: MoneyToEvent(item.UnitPrice),
+			Name:      item.Name,
+		})
+	}
+	return &genOrderEventsV1.OrderEvent{
+		Payload: &genOrderEventsV1.OrderEvent_OrderCreated{
+			OrderCreated: &genOrderEventsV1.OrderCreated{
+				UserUuid:        order.UserUUID.String(),
+				Items:           items,
+				TotalPrice:      MoneyToEvent(order.TotalPrice),
+				PaymentDeadline: timestamppb.New(order.PaymentDeadline),
+			},
+		},
+	}
+}
+
+func OrderPaidToEvent(order model.Order) *genOrderEventsV1.OrderEvent {
+	paid := &genOrderEventsV1.OrderPaid{
+		UserUuid: order.UserUUID.String(),
+		Amount:   MoneyToEvent(order.TotalPrice),
+	}
+	if order.TransactionUUID != nil {
+		paid.TransactionUuid = order.TransactionUUID.String()
+	}
+	if order.PaymentMethod != nil {
+		paid.PaymentMethod = paymentMethodToEvent(*order.PaymentMethod)
+	}
+	return &genOrderEventsV1.OrderEvent{
+		Payload: &genOrderEventsV1.OrderEvent_OrderPaid{OrderPaid: paid},
+	}
+}
+
+func OrderCancelledToEvent(order model.Order, reason string) *genOrderEventsV1.OrderEvent {
+	return &genOrderEventsV1.OrderEvent{
+		Payload: &genOrderEventsV1.OrderEvent_OrderCancelled{
+			OrderCancelled: &genOrderEventsV1.OrderCancelled{
+				UserUuid: order.UserUUID.String(),
+				Reason:   reason,
+			},
+		},
+	}
+}
+
+func OrderExpiredToEvent(order model.Order, reason string) *genOrderEventsV1.OrderEvent {
+	return &genOrderEventsV1.OrderEvent{
+		Payload: &genOrderEventsV1.OrderEvent_OrderExpired{
+			OrderExpired: &genOrderEventsV1.OrderExpired{
+				UserUuid:        order.UserUUID.String(),
+				PaymentDeadline: timestamppb.New(order.PaymentDeadline),
+				Reason:          reason,
+			},
+		},
+	}
+}
+
+func OrderRefundedToEvent(order model.Order, refundUUID uuid.UUID, amount money.Money, reason string) *genOrderEventsV1.OrderEvent {
+	refunded := &genOrderEventsV1.OrderRefunded{
+		UserUuid:      order.UserUUID.String(),
+		RefundUuid:    refundUUID.String(),
+		Amount:        MoneyToEvent(amount),
+		TotalRefunded: MoneyToEvent(order.RefundedAmount),
+		FullyRefunded: order.Status == model.OrderStatusREFUNDED,
+		Reason:        reason,
+	}
+	if order.TransactionUUID != nil {
+		refunded.TransactionUuid = order.TransactionUUID.String()
+	}
+	return &genOrderEventsV1.OrderEvent{
+		Payload: &genOrderEventsV1.OrderEvent_OrderRefunded{OrderRefunded: refunded},
+	}
+}
+
+// EventToOutboxMessage заполняет конверт события и сериализует его для записи в outbox.
+// Тип события - полное имя сообщения из oneof payload.
+func EventToOutboxMessage(event *genOrderEventsV1.OrderEvent, orderUUID uuid.UUID, at time.Time) (model.OutboxMessage, error) {
+	eventUUID := uuid.New()
+	event.EventUuid = eventUUID.String()
+	event.OrderUuid = orderUUID.String()
+	event.OccurredAt = timestamppb.New(at)
+
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return model.OutboxMessage{}, fmt.Errorf("failed to marshal order event: %w", err)
+	}
+
+	m := event.ProtoReflect()
+	field := m.WhichOneof(m.Descriptor().Oneofs().ByName("payload"))
+	if field == nil {
+		return model.OutboxMessage{}, fmt.Errorf("order event %s has no payload", eventUUID)
+	}
+
+	return model.OutboxMessage{
+		EventUUID: eventUUID,
+		OrderUUID: orderUUID,
+		EventType: string(field.Message().FullName()),
+		Payload:   payload,
+		CreatedAt: at,
+	}, nil
+}
+
+func MoneyToEvent(m money.Money) *genCommonV1.Money {
+	return &genCommonV1.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+func paymentMethodToEvent(pm model.PaymentMethod) genOrderEventsV1.PaymentMethod {
+	switch pm {
+	case model.PaymentMethodCARD:
+		return genOrderEventsV1.PaymentMethod_PAYMENT_METHOD_CARD
+	case model.PaymentMethodSBP:
+		return genOrderEventsV1.PaymentMethod_PAYMENT_METHOD_SBP
+	case model.PaymentMethodCREDITCARD:
+		return genOrderEventsV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD
+	case model.PaymentMethodINVESTORMONEY:
+		return genOrderEventsV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY
+	default:
+		return genOrderEventsV1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	}
+}
@@ -1,28 +1,41 @@
 package converter
 
 import (
+	"github.com/google/uuid"
+
 	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/shared/pkg/money"
 	genOrderV1 "github.com/xgmsx/rsf/shared/pkg/openapi/order/v1"
 )
 
-func CreateOrderInputFromRequest(request genOrderV1.CreateOrderRequest) model.CreateOrderInput {
+func CreateOrderInputFromRequest(userUUID uuid.UUID, request genOrderV1.CreateOrderRequest, params genOrderV1.CreateOrderParams) model.CreateOrderInput {
+	items := make([]model.CreateOrderItem, 0, len(request.Items))
+	for _, item := range request.Items {
+		items = append(items, model.CreateOrderItem{
+			PartUUID: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
 	return model.CreateOrderInput{
-		UserUUID:  request.UserUUID,
-		PartUUIDs: request.PartUuids,
+		UserUUID:       userUUID,
+		Items:          items,
+		IdempotencyKey: params.IdempotencyKey.Or(""),
 	}
 }
 
 func CreateOrderOutputToResponse(output model.CreateOrderOutput) *genOrderV1.CreateOrderResponse {
 	return &genOrderV1.CreateOrderResponse{
-		OrderUUID:  output.OrderUUID,
-		TotalPrice: float32(output.TotalPrice),
+		OrderUUID:       output.OrderUUID,
+		TotalPrice:      output.TotalPrice.Float64(),
+		TotalPriceMoney: MoneyToResponse(output.TotalPrice),
 	}
 }
 
 func PayOrderInputFromRequest(request genOrderV1.PayOrderRequest, params genOrderV1.PayOrderParams) model.PayOrderInput {
 	return model.PayOrderInput{
-		OrderUUID:     params.OrderUUID,
-		PaymentMethod: model.PaymentMethod(request.PaymentMethod),
+		OrderUUID:      params.OrderUUID,
+		PaymentMethod:  model.PaymentMethod(request.PaymentMethod),
+		IdempotencyKey: params.IdempotencyKey.Or(""),
 	}
 }
 
@@ -34,11 +47,15 @@ func PayOrderOutputToResponse(output model.PayOrderOutput) *genOrderV1.PayOrderR
 
 func GetOrderOutputToResponse(order model.Order) *genOrderV1.Order {
 	res := genOrderV1.Order{
-		OrderUUID:  order.OrderUUID,
-		UserUUID:   order.UserUUID,
-		PartUuids:  order.PartUUIDs,
-		TotalPrice: order.TotalPrice,
-		Status:     genOrderV1.OrderStatus(order.Status),
+		OrderUUID:       order.OrderUUID,
+		UserUUID:        order.UserUUID,
+		Items:           OrderItemsToResponse(order.Items),
+		TotalPrice:      order.TotalPrice.Float64(),
+		TotalPriceMoney: MoneyToResponse(order.TotalPrice),
+		Status:          genOrderV1.OrderStatus(order.Status),
+		CreatedAt:       order.CreatedAt,
+		PaymentDeadline: order.PaymentDeadline,
+		RefundedMoney:   MoneyToResponse(refundedAmount(order)),
 	}
 	if order.PaymentMethod != nil {
 		res.PaymentMethod = genOrderV1.NewOptNilOrderPaymentMethod(
@@ -50,3 +67,120 @@ func GetOrderOutputToResponse(order model.Order) *genOrderV1.Order {
 	}
 	return &res
 }
+
+func RefundOrderInputFromRequest(request genOrderV1.RefundOrderRequest, params genOrderV1.RefundOrderParams) model.RefundOrderInput {
+	input := model.RefundOrderInput{
+		OrderUUID:      params.OrderUUID,
+		Reason:         request.Reason.Or(""),
+		IdempotencyKey: params.IdempotencyKey.Or(""),
+	}
+	if amount, ok := request.Amount.Get(); ok {
+		m := money.New(amount.Amount, amount.Currency)
+		input.Amount = &m
+	}
+	return input
+}
+
+func RefundOrderOutputToResponse(output model.RefundOrderOutput) *genOrderV1.RefundOrderResponse {
+	return &genOrderV1.RefundOrderResponse{
+		RefundUUID:         output.RefundUUID,
+		RefundedMoney:      MoneyToResponse(output.RefundedAmount),
+		TotalRefundedMoney: MoneyToResponse(output.TotalRefunded),
+		Status:             genOrderV1.OrderStatus(output.Status),
+	}
+}
+
+// refundedAmount возвращает сумму возвратов в валюте заказа,
+// даже если возвратов еще не было
+func refundedAmount(order model.Order) money.Money {
+	if order.RefundedAmount.Currency == "" {
+		return money.Zero(order.TotalPrice.Currency)
+	}
+	return order.RefundedAmount
+}
+
+func MoneyToResponse(m money.Money) genOrderV1.Money {
+	return genOrderV1.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+func OrderItemsToResponse(items []model.OrderItem) []genOrderV1.OrderItem {
+	res := make([]genOrderV1.OrderItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, genOrderV1.OrderItem{
+			PartUUID:       item.PartUUID,
+			Quantity:       item.Quantity,
+			UnitPrice:      item.UnitPrice.Float64(),
+			UnitPriceMoney: MoneyToResponse(item.UnitPrice),
+			Name:           item.Name,
+			Category:       genOrderV1.PartCategory(item.Category),
+		})
+	}
+	return res
+}
+
+func ListOrdersInputFromParams(params genOrderV1.ListOrdersParams) model.ListOrdersInput {
+	input := model.ListOrdersInput{
+		Sort:   model.OrdersSort(params.Sort.Or(genOrderV1.ListOrdersSortCreatedAtDesc)),
+		Limit:  params.Limit.Or(0),
+		Cursor: params.Cursor.Or(""),
+	}
+
+	if v, ok := params.UserUUID.Get(); ok {
+		input.Filter.UserUUID = &v
+	}
+	if v, ok := params.PartUUID.Get(); ok {
+		input.Filter.PartUUID = &v
+	}
+	if v, ok := params.CreatedFrom.Get(); ok {
+		input.Filter.CreatedFrom = &v
+	}
+	if v, ok := params.CreatedTo.Get(); ok {
+		input.Filter.CreatedTo = &v
+	}
+	for _, st := range params.Status {
+		input.Filter.Statuses = append(input.Filter.Statuses, model.OrderStatus(st))
+	}
+	for _, pm := range params.PaymentMethod {
+		input.Filter.PaymentMethods = append(input.Filter.PaymentMethods, model.PaymentMethod(pm))
+	}
+
+	return input
+}
+
+func ListOrdersOutputToResponse(output model.ListOrdersOutput) *genOrderV1.ListOrdersResponse {
+	res := genOrderV1.ListOrdersResponse{
+		Orders: make([]genOrderV1.Order, 0, len(output.Orders)),
+	}
+	for _, order := range output.Orders {
+		res.Orders = append(res.Orders, *GetOrderOutputToResponse(order))
+	}
+	if output.NextCursor != "" {
+		res.NextCursor = genOrderV1.NewOptString(output.NextCursor)
+	}
+	return &res
+}
+
+func OrderHistoryToResponse(history []model.OrderStatusChange) *genOrderV1.OrderHistoryResponse {
+	res := genOrderV1.OrderHistoryResponse{
+		History: make([]genOrderV1.OrderStatusChange, 0, len(history)),
+	}
+	for _, change := range history {
+		res.History = append(res.History, OrderStatusChangeToResponse(change))
+	}
+	return &res
+}
+
+func OrderStatusChangeToResponse(change model.OrderStatusChange) genOrderV1.OrderStatusChange {
+	item := genOrderV1.OrderStatusChange{
+		ToStatus:  genOrderV1.OrderStatus(change.To),
+		Reason:    change.Reason,
+		ChangedAt: change.ChangedAt,
+	}
+	if change.From != "" {
+		item.FromStatus = genOrderV1.NewOptOrderStatus(genOrderV1.OrderStatus(change.From))
+	}
+	return item
+}
@@ -0,0 +1,135 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	genOrderV1 "github.com/xgmsx/rsf/shared/pkg/openapi/order/v1"
+	genOrderEventsV1 "github.com/xgmsx/rsf/shared/pkg/proto/order/v1"
+)
+
+// webhookEventTypes сопоставляет события outbox типам событий вебхуков
+var webhookEventTypes = map[protoreflect.FullName]model.WebhookEventType{
+	(&genOrderEventsV1.OrderCreated{}).ProtoReflect().Descriptor().FullName():   model.WebhookEventOrderCreated,
+	(&genOrderEventsV1.OrderPaid{}).ProtoReflect().Descriptor().FullName():      model.WebhookEventOrderPaid,
+	(&genOrderEventsV1.OrderCancelled{}).ProtoReflect().Descriptor().FullName(): model.WebhookEventOrderCancelled,
+	(&genOrderEventsV1.OrderRefunded{}).ProtoReflect().Descriptor().FullName():  model.WebhookEventOrderRefunded,
+	(&genOrderEventsV1.OrderExpired{}).ProtoReflect().Descriptor().FullName():   model.WebhookEventOrderExpired,
+}
+
+// webhookPayload - тело запроса доставки вебхука
+type webhookPayload struct {
+	EventUUID  string          `json:"event_uuid"`
+	EventType  string          `json:"event_type"`
+	OrderUUID  string          `json:"order_uuid"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// WebhookEventTypeFromOutbox возвращает тип события вебхука для сообщения outbox
+// и false, если такое событие вебхукам не отправляется
+func WebhookEventTypeFromOutbox(msg model.OutboxMessage) (model.WebhookEventType, bool) {
+	eventType, ok := webhookEventTypes[protoreflect.FullName(msg.EventType)]
+	return eventType, ok
+}
+
+// OutboxMessageToWebhookPayload преобразует событие outbox в JSON тело доставки.
+// Данные события сериализуются из protobuf с именами полей как в .proto файле.
+func OutboxMessageToWebhookPayload(msg model.OutboxMessage, eventType model.WebhookEventType) ([]byte, error) {
+	var event genOrderEventsV1.OrderEvent
+	err := proto.Unmarshal(msg.Payload, &event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal order event %s: %w", msg.EventUUID, err)
+	}
+
+	m := event.ProtoReflect()
+	field := m.WhichOneof(m.Descriptor().Oneofs().ByName("payload"))
+	if field == nil {
+		return nil, fmt.Errorf("order event %s has no payload", msg.EventUUID)
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(m.Get(field).Message().Interface())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal order event %s data: %w", msg.EventUUID, err)
+	}
+
+	return json.Marshal(webhookPayload{
+		EventUUID:  event.GetEventUuid(),
+		EventType:  string(eventType),
+		OrderUUID:  event.GetOrderUuid(),
+		OccurredAt: event.GetOccurredAt().AsTime(),
+		Data:       data,
+	})
+}
+
+func WebhookInputFromRequest(request genOrderV1.WebhookRequest) model.WebhookInput {
+	input := model.WebhookInput{
+		URL:        request.URL,
+		EventTypes: make([]model.WebhookEventType, 0, len(request.EventTypes)),
+	}
+	for _, eventType := range request.EventTypes {
+		input.EventTypes = append(input.EventTypes, model.WebhookEventType(eventType))
+	}
+	return input
+}
+
+// WebhookToResponse преобразует подписку в ответ API. Секрет включается,
+// только если withSecret = true: при создании подписки и ротации секрета.
+func WebhookToResponse(subscription model.WebhookSubscription, withSecret bool) *genOrderV1.Webhook {
+	res := genOrderV1.Webhook{
+		WebhookUUID: subscription.WebhookUUID,
+		URL:         subscription.URL,
+		EventTypes:  make([]genOrderV1.WebhookEventType, 0, len(subscription.EventTypes)),
+		CreatedAt:   subscription.CreatedAt,
+		UpdatedAt:   subscription.UpdatedAt,
+	}
+	for _, eventType := range subscription.EventTypes {
+		res.EventTypes = append(res.EventTypes, genOrderV1.WebhookEventType(eventType))
+	}
+	if withSecret {
+		res.Secret = genOrderV1.NewOptString(subscription.Secret)
+	}
+	return &res
+}
+
+func WebhooksToResponse(subscriptions []model.WebhookSubscription) *genOrderV1.ListWebhooksResponse {
+	res := genOrderV1.ListWebhooksResponse{
+		Webhooks: make([]genOrderV1.Webhook, 0, len(subscriptions)),
+	}
+	for _, subscription := range subscriptions {
+		res.Webhooks = append(res.Webhooks, *WebhookToResponse(subscription, false))
+	}
+	return &res
+}
+
+func WebhookDeliveryToResponse(delivery model.WebhookDelivery) *genOrderV1.WebhookDelivery {
+	res := genOrderV1.WebhookDelivery{
+		DeliveryUUID:  delivery.DeliveryUUID,
+		WebhookUUID:   delivery.WebhookUUID,
+		EventUUID:     delivery.EventUUID,
+		EventType:     genOrderV1.WebhookEventType(delivery.EventType),
+		Status:        genOrderV1.WebhookDeliveryStatus(delivery.Status),
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		CreatedAt:     delivery.CreatedAt,
+	}
+	if delivery.LastError != "" {
+		res.LastError = genOrderV1.NewOptString(delivery.LastError)
+	}
+	return &res
+}
+
+func WebhookDeliveriesToResponse(deliveries []model.WebhookDelivery) *genOrderV1.ListWebhookDeliveriesResponse {
+	res := genOrderV1.ListWebhookDeliveriesResponse{
+		Deliveries: make([]genOrderV1.WebhookDelivery, 0, len(deliveries)),
+	}
+	for _, delivery := range deliveries {
+		res.Deliveries = append(res.Deliveries, *WebhookDeliveryToResponse(delivery))
+	}
+	return &res
+}
@@ -3,12 +3,42 @@ package model
 import "errors"
 
 var (
-	ErrOrderNotFound    = errors.New("order not found")
-	ErrOrderAlreadyPaid = errors.New("order already paid")
+	ErrOrderNotFound = errors.New("order not found")
+	ErrOrderConflict = errors.New("order was modified concurrently")
+	ErrOrderExists   = errors.New("order already exists")
+	ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+	ErrPaymentDeadlineExceeded = errors.New("order payment deadline exceeded")
 
 	ErrFailedToFetchInventory = errors.New("error while fetching inventory")
 	ErrPartDoesNotExist       = errors.New("part does not exist")
+	ErrPartsOutOfStock        = errors.New("not enough parts in stock")
+	ErrReservationExpired     = errors.New("parts reservation expired")
+
+	// ErrServiceUnavailable - зависимость (inventory или payment) недоступна
+	// или отключена circuit breaker, запрос можно повторить позже
+	ErrServiceUnavailable = errors.New("dependent service is unavailable")
+
+	ErrSagaNotFound = errors.New("saga not found")
+	ErrSagaConflict = errors.New("saga was modified concurrently")
+
+	ErrIdempotencyKeyReused     = errors.New("idempotency key was already used with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("request with the same idempotency key is in progress")
+
+	ErrWebhookNotFound          = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound  = errors.New("webhook delivery not found")
+	ErrWebhookDeliveryNotDead   = errors.New("webhook delivery is not in the dead-letter list")
+	ErrInvalidWebhookURL        = errors.New("webhook url must be an absolute http or https url")
+	ErrInvalidWebhookEventTypes = errors.New("webhook must subscribe to at least one known event type")
+	ErrWebhookDeliveryRejected  = errors.New("webhook receiver rejected the delivery")
+	ErrWebhookAddrForbidden     = errors.New("webhook receiver address is not public")
+
+	ErrInvalidRefundAmount  = errors.New("refund amount must be positive and in the order currency")
+	ErrRefundExceedsPayment = errors.New("refund amount exceeds the refundable payment amount")
+	ErrRefundInProgress     = errors.New("another refund of the order is in progress")
 
 	ErrPaymentMethodIsNotSupported = errors.New("payment method is not supported")
 	ErrFailedToProcessPayment      = errors.New("failed to process payment")
+	// ErrPaymentDeclined - payment отклонил списание, средства не списаны
+	ErrPaymentDeclined = errors.New("payment was declined")
 )
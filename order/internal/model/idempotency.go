@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// IdempotencyRecord хранит результат операции, выполненной с ключом идемпотентности
+type IdempotencyRecord struct {
+	Operation   string
+	Key         string
+	RequestHash string
+	// Response содержит сериализованный результат операции,
+	// пустой, пока операция не завершена
+	Response  []byte
+	Completed bool
+	CreatedAt time.Time
+	// ExpiresAt - время, после которого ключ можно использовать заново
+	// и запись удаляется фоновым воркером
+	ExpiresAt time.Time
+}
@@ -1,7 +1,12 @@
 package model
 
 import (
+	"slices"
+	"time"
+
 	"github.com/google/uuid"
+
+	"github.com/xgmsx/rsf/shared/pkg/money"
 )
 
 type PaymentMethod string
@@ -19,14 +24,91 @@ const (
 	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
 	OrderStatusPAID           OrderStatus = "PAID"
 	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
+	OrderStatusEXPIRED        OrderStatus = "EXPIRED"
+	// OrderStatusPARTIALLYREFUNDED - часть оплаты возвращена, детали остаются за покупателем
+	OrderStatusPARTIALLYREFUNDED OrderStatus = "PARTIALLY_REFUNDED"
+	// OrderStatusREFUNDED - оплата возвращена полностью, детали возвращены на склад
+	OrderStatusREFUNDED OrderStatus = "REFUNDED"
+)
+
+type PartCategory string
+
+const (
+	PartCategoryUNSPECIFIED PartCategory = "UNSPECIFIED"
+	PartCategoryENGINE      PartCategory = "ENGINE"
+	PartCategoryFUEL        PartCategory = "FUEL"
+	PartCategoryPORTHOLE    PartCategory = "PORTHOLE"
+	PartCategoryWING        PartCategory = "WING"
+	PartCategorySHIELD      PartCategory = "SHIELD"
 )
 
+// OrderItem - позиция заказа. Цена, название и категория детали фиксируются
+// при создании заказа и не меняются вместе с каталогом.
+type OrderItem struct {
+	PartUUID  uuid.UUID
+	Quantity  int64
+	UnitPrice money.Money
+	Name      string
+	Category  PartCategory
+}
+
 type Order struct {
 	OrderUUID       uuid.UUID
 	UserUUID        uuid.UUID
-	PartUUIDs       []uuid.UUID
-	TotalPrice      float64
+	Items           []OrderItem
+	TotalPrice      money.Money
 	TransactionUUID *uuid.UUID
 	PaymentMethod   *PaymentMethod
 	Status          OrderStatus
+	CreatedAt       time.Time
+	// PaymentDeadline - крайний срок оплаты, после которого заказ истекает
+	PaymentDeadline time.Time
+	// RefundedAmount - сумма всех возвратов по заказу в валюте заказа
+	RefundedAmount money.Money
+	// Version увеличивается при каждом сохранении заказа и используется
+	// для оптимистичной блокировки
+	Version int64
+	// NewStatusChanges - изменения статуса, еще не сохраненные в историю.
+	// Хранилище дописывает их в историю при сохранении заказа.
+	NewStatusChanges []OrderStatusChange
+	// NewEvents - события, которые хранилище записывает в outbox
+	// в одной транзакции с заказом
+	NewEvents []OutboxMessage
+}
+
+// OrdersFilter задает условия отбора заказов. Пустые поля не ограничивают выборку.
+type OrdersFilter struct {
+	UserUUID       *uuid.UUID
+	Statuses       []OrderStatus
+	PaymentMethods []PaymentMethod
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time
+	PartUUID       *uuid.UUID
+}
+
+type OrdersSort string
+
+const (
+	OrdersSortCreatedAtAsc  OrdersSort = "created_at_asc"
+	OrdersSortCreatedAtDesc OrdersSort = "created_at_desc"
+)
+
+// OrdersCursor указывает на последний заказ предыдущей страницы
+type OrdersCursor struct {
+	CreatedAt time.Time `json:"t"`
+	OrderUUID uuid.UUID `json:"id"`
+}
+
+// OrdersPage задает страницу выборки заказов
+type OrdersPage struct {
+	Sort  OrdersSort
+	After *OrdersCursor
+	Limit int
+}
+
+// HasPart сообщает, есть ли деталь среди позиций заказа
+func (o Order) HasPart(partUUID uuid.UUID) bool {
+	return slices.ContainsFunc(o.Items, func(item OrderItem) bool {
+		return item.PartUUID == partUUID
+	})
 }
@@ -1,22 +1,60 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/rsf/shared/pkg/money"
+)
 
 type PayOrderInput struct {
-	OrderUUID     uuid.UUID
-	PaymentMethod PaymentMethod
+	OrderUUID      uuid.UUID
+	PaymentMethod  PaymentMethod
+	IdempotencyKey string `json:"-"`
 }
 
 type PayOrderOutput struct {
 	TransactionUUID uuid.UUID
 }
 
+type RefundOrderInput struct {
+	OrderUUID uuid.UUID
+	// Amount не заполнена, если нужно вернуть весь остаток оплаты
+	Amount         *money.Money
+	Reason         string
+	IdempotencyKey string `json:"-"`
+}
+
+type RefundOrderOutput struct {
+	RefundUUID     uuid.UUID
+	RefundedAmount money.Money
+	TotalRefunded  money.Money
+	Status         OrderStatus
+}
+
+type CreateOrderItem struct {
+	PartUUID uuid.UUID
+	Quantity int64
+}
+
 type CreateOrderInput struct {
-	UserUUID  uuid.UUID
-	PartUUIDs []uuid.UUID
+	UserUUID       uuid.UUID
+	Items          []CreateOrderItem
+	IdempotencyKey string `json:"-"`
 }
 
 type CreateOrderOutput struct {
 	OrderUUID  uuid.UUID
-	TotalPrice float64
+	TotalPrice money.Money
+}
+
+type ListOrdersInput struct {
+	Filter OrdersFilter
+	Sort   OrdersSort
+	Limit  int
+	Cursor string
+}
+
+type ListOrdersOutput struct {
+	Orders     []Order
+	NextCursor string
 }
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// orderStatusTransitions - таблица допустимых переходов между статусами заказа.
+// Пустой статус соответствует еще не созданному заказу.
+var orderStatusTransitions = map[OrderStatus][]OrderStatus{
+	"":                        {OrderStatusPENDINGPAYMENT},
+	OrderStatusPENDINGPAYMENT: {OrderStatusPAID, OrderStatusCANCELLED, OrderStatusEXPIRED},
+	OrderStatusPAID:           {OrderStatusPARTIALLYREFUNDED, OrderStatusREFUNDED},
+	OrderStatusCANCELLED:      {},
+	OrderStatusEXPIRED:        {},
+	// Каждый следующий частичный возврат сохраняет статус и попадает в историю
+	OrderStatusPARTIALLYREFUNDED: {OrderStatusPARTIALLYREFUNDED, OrderStatusREFUNDED},
+	OrderStatusREFUNDED:          {},
+}
+
+var ErrInvalidStatusTransition = errors.New("invalid order status transition")
+
+// StatusTransitionError возвращается при попытке недопустимого перехода статуса
+type StatusTransitionError struct {
+	From OrderStatus
+	To   OrderStatus
+}
+
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("cannot change order status from %s to %s", e.From, e.To)
+}
+
+func (e *StatusTransitionError) Is(target error) bool {
+	return target == ErrInvalidStatusTransition
+}
+
+// CanTransitionTo сообщает, разрешен ли переход из статуса s в статус to
+func (s OrderStatus) CanTransitionTo(to OrderStatus) bool {
+	return slices.Contains(orderStatusTransitions[s], to)
+}
+
+// OrderStatusChange - запись истории изменения статуса заказа
+type OrderStatusChange struct {
+	From      OrderStatus
+	To        OrderStatus
+	Reason    string
+	ChangedAt time.Time
+}
+
+// TransitionTo переводит заказ в статус to, если переход разрешен, и добавляет
+// запись в NewStatusChanges. Иначе возвращает *StatusTransitionError.
+func (o *Order) TransitionTo(to OrderStatus, reason string, at time.Time) error {
+	if !o.Status.CanTransitionTo(to) {
+		return &StatusTransitionError{From: o.Status, To: to}
+	}
+
+	o.NewStatusChanges = append(o.NewStatusChanges, OrderStatusChange{
+		From:      o.Status,
+		To:        to,
+		Reason:    reason,
+		ChangedAt: at,
+	})
+	o.Status = to
+	return nil
+}
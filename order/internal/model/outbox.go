@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxMessage - событие заказа, сохраненное в outbox вместе с заказом
+// и ожидающее публикации в брокер сообщений
+type OutboxMessage struct {
+	EventUUID uuid.UUID
+	OrderUUID uuid.UUID
+	// EventType - полное имя protobuf-сообщения события, например order.v1.OrderPaid
+	EventType string
+	// Payload - сериализованный order.v1.OrderEvent
+	Payload   []byte
+	CreatedAt time.Time
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/rsf/shared/pkg/money"
+)
+
+type SagaType string
+
+const (
+	SagaTypeCreateOrder SagaType = "create_order"
+	SagaTypePayOrder    SagaType = "pay_order"
+	SagaTypeRefundOrder SagaType = "refund_order"
+)
+
+type SagaStep string
+
+const (
+	// SagaStepReserveStock - резервирование деталей при создании заказа
+	SagaStepReserveStock SagaStep = "reserve_stock"
+	// SagaStepCharge - списание средств
+	SagaStepCharge SagaStep = "charge"
+	// SagaStepCommitStock - подтверждение резерва деталей оплаченного заказа
+	SagaStepCommitStock SagaStep = "commit_stock"
+	// SagaStepRefund - возврат средств
+	SagaStepRefund SagaStep = "refund"
+	// SagaStepConfirm - сохранение результата в заказе
+	SagaStepConfirm SagaStep = "confirm"
+)
+
+type SagaStatus string
+
+const (
+	SagaStatusRUNNING      SagaStatus = "RUNNING"
+	SagaStatusCOMPENSATING SagaStatus = "COMPENSATING"
+	SagaStatusCOMPLETED    SagaStatus = "COMPLETED"
+	SagaStatusCOMPENSATED  SagaStatus = "COMPENSATED"
+)
+
+// Saga - сохраненное состояние распределенной операции над заказом.
+// Для RUNNING Step - шаг, который выполняется сейчас, для COMPENSATING -
+// последний шаг, который еще нужно компенсировать.
+type Saga struct {
+	SagaUUID  uuid.UUID
+	Type      SagaType
+	OrderUUID uuid.UUID
+	UserUUID  uuid.UUID
+	Status    SagaStatus
+	Step      SagaStep
+	// PaymentMethod, Amount и TransactionUUID заполняются для саги оплаты.
+	// Для саги возврата Amount - сумма возврата, TransactionUUID - транзакция
+	// оплаты заказа, а UUID саги служит идентификатором возврата в payment.
+	PaymentMethod   *PaymentMethod
+	Amount          money.Money
+	TransactionUUID *uuid.UUID
+	// Reason и RefundedBefore заполняются для саги возврата: причина возврата
+	// и сумма возвратов заказа до него
+	Reason         string
+	RefundedBefore money.Money
+	// LastError - ошибка, из-за которой сага компенсируется или повторяется
+	LastError string
+	// Version используется для оптимистичной блокировки, как у заказа
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsFinished сообщает, что сага завершена и больше не восстанавливается
+func (s Saga) IsFinished() bool {
+	return s.Status == SagaStatusCOMPLETED || s.Status == SagaStatusCOMPENSATED
+}
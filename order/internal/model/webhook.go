@@ -0,0 +1,81 @@
+package model
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookEventType string
+
+const (
+	WebhookEventOrderCreated   WebhookEventType = "order.created"
+	WebhookEventOrderPaid      WebhookEventType = "order.paid"
+	WebhookEventOrderCancelled WebhookEventType = "order.cancelled"
+	WebhookEventOrderRefunded  WebhookEventType = "order.refunded"
+	WebhookEventOrderExpired   WebhookEventType = "order.expired"
+)
+
+// WebhookSubscription - подписка внешней системы на события заказов
+type WebhookSubscription struct {
+	WebhookUUID uuid.UUID
+	URL         string
+	EventTypes  []WebhookEventType
+	// Secret - ключ HMAC-SHA256 подписи доставок
+	Secret    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusDELIVERED WebhookDeliveryStatus = "DELIVERED"
+	// WebhookDeliveryStatusDEAD - попытки исчерпаны, доставка ждет
+	// повторного запуска через API
+	WebhookDeliveryStatusDEAD WebhookDeliveryStatus = "DEAD"
+)
+
+// WebhookDelivery - доставка одного события одной подписке
+type WebhookDelivery struct {
+	DeliveryUUID uuid.UUID
+	WebhookUUID  uuid.UUID
+	EventUUID    uuid.UUID
+	EventType    WebhookEventType
+	// Payload - JSON тело запроса
+	Payload       []byte
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	DeliveredAt   *time.Time
+}
+
+// nonPublicPrefixes - диапазоны, не покрытые методами netip.Addr,
+// адреса из которых тоже не должны быть доступны получателям вебхуков
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+// WebhookAddrAllowed сообщает, можно ли отправлять вебхуки на адрес.
+// Запрещены loopback, link-local (включая адрес метаданных облака 169.254.169.254),
+// частные, multicast и неуказанные адреса, чтобы через подписку нельзя было
+// обращаться к сервисам внутренней сети.
+func WebhookAddrAllowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
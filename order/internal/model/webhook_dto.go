@@ -0,0 +1,6 @@
+package model
+
+type WebhookInput struct {
+	URL        string
+	EventTypes []WebhookEventType
+}
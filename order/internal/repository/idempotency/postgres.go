@@ -0,0 +1,100 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	def "github.com/xgmsx/rsf/order/internal/repository"
+)
+
+var _ def.IdempotencyRepository = (*postgresIdempotencyRepository)(nil)
+
+type postgresIdempotencyRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresIdempotencyRepository(pool *pgxpool.Pool) *postgresIdempotencyRepository {
+	return &postgresIdempotencyRepository{pool: pool}
+}
+
+func (r *postgresIdempotencyRepository) Reserve(ctx context.Context, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error) {
+	// Запись с истекшим сроком хранения занимается заново, как если бы ее не было
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO idempotency_keys (operation, key, request_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (operation, key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+		    response = NULL,
+		    created_at = now(),
+		    completed_at = NULL,
+		    expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= now()`,
+		record.Operation, record.Key, record.RequestHash, record.ExpiresAt,
+	)
+	if err != nil {
+		return model.IdempotencyRecord{}, false, fmt.Errorf("failed to insert idempotency key: %w", err)
+	}
+	reserved := tag.RowsAffected() > 0
+
+	existing := model.IdempotencyRecord{
+		Operation: record.Operation,
+		Key:       record.Key,
+	}
+	err = r.pool.QueryRow(ctx, `
+		SELECT request_hash, response, completed_at IS NOT NULL, created_at, expires_at
+		FROM idempotency_keys
+		WHERE operation = $1 AND key = $2`,
+		record.Operation, record.Key,
+	).Scan(&existing.RequestHash, &existing.Response, &existing.Completed, &existing.CreatedAt, &existing.ExpiresAt)
+	if err != nil {
+		return model.IdempotencyRecord{}, false, fmt.Errorf("failed to select idempotency key: %w", err)
+	}
+
+	return existing, reserved, nil
+}
+
+func (r *postgresIdempotencyRepository) Complete(ctx context.Context, operation, key string, response []byte) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE idempotency_keys
+		SET response = $3, completed_at = now()
+		WHERE operation = $1 AND key = $2`,
+		operation, key, response,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresIdempotencyRepository) Release(ctx context.Context, operation, key string) error {
+	_, err := r.pool.Exec(ctx, `
+		DELETE FROM idempotency_keys
+		WHERE operation = $1 AND key = $2 AND completed_at IS NULL`,
+		operation, key,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresIdempotencyRepository) DeleteExpired(ctx context.Context, moment time.Time, limit int) (int, error) {
+	tag, err := r.pool.Exec(ctx, `
+		DELETE FROM idempotency_keys
+		WHERE (operation, key) IN (
+			SELECT operation, key
+			FROM idempotency_keys
+			WHERE expires_at <= $1
+			LIMIT $2
+		)`,
+		moment, limit,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
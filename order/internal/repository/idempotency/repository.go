@@ -0,0 +1,91 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	def "github.com/xgmsx/rsf/order/internal/repository"
+)
+
+var _ def.IdempotencyRepository = (*idempotencyRepository)(nil)
+
+type idempotencyRepository struct {
+	mu      sync.Mutex
+	records map[string]*model.IdempotencyRecord
+}
+
+func NewIdempotencyRepository() *idempotencyRepository {
+	return &idempotencyRepository{
+		records: make(map[string]*model.IdempotencyRecord),
+	}
+}
+
+func (r *idempotencyRepository) Reserve(_ context.Context, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	id := recordID(record.Operation, record.Key)
+	if existing, ok := r.records[id]; ok && !expired(existing, now) {
+		return *existing, false, nil
+	}
+
+	record.Completed = false
+	record.Response = nil
+	record.CreatedAt = now
+	r.records[id] = &record
+	return record, true, nil
+}
+
+func (r *idempotencyRepository) Complete(_ context.Context, operation, key string, response []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record, ok := r.records[recordID(operation, key)]
+	if !ok {
+		return nil
+	}
+	record.Response = response
+	record.Completed = true
+	return nil
+}
+
+func (r *idempotencyRepository) Release(_ context.Context, operation, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := recordID(operation, key)
+	if record, ok := r.records[id]; ok && !record.Completed {
+		delete(r.records, id)
+	}
+	return nil
+}
+
+func (r *idempotencyRepository) DeleteExpired(_ context.Context, moment time.Time, limit int) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int
+	for id, record := range r.records {
+		if deleted >= limit {
+			break
+		}
+		if expired(record, moment) {
+			delete(r.records, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// expired сообщает, истек ли к moment срок хранения записи.
+// Записи без срока хранения не истекают.
+func expired(record *model.IdempotencyRecord, moment time.Time) bool {
+	return !record.ExpiresAt.IsZero() && !moment.Before(record.ExpiresAt)
+}
+
+func recordID(operation, key string) string {
+	return operation + "/" + key
+}
@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 	model "github.com/xgmsx/rsf/order/internal/model"
+
+	time "time"
 )
 
 // OrderRepository is an autogenerated mock type for the OrderRepository type
@@ -22,6 +24,53 @@ func (_m *OrderRepository) EXPECT() *OrderRepository_Expecter {
 	return &OrderRepository_Expecter{mock: &_m.Mock}
 }
 
+// Create provides a mock function with given fields: ctx, order
+func (_m *OrderRepository) Create(ctx context.Context, order model.Order) error {
+	ret := _m.Called(ctx, order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Order) error); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type OrderRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order model.Order
+func (_e *OrderRepository_Expecter) Create(ctx interface{}, order interface{}) *OrderRepository_Create_Call {
+	return &OrderRepository_Create_Call{Call: _e.mock.On("Create", ctx, order)}
+}
+
+func (_c *OrderRepository_Create_Call) Run(run func(ctx context.Context, order model.Order)) *OrderRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Order))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_Create_Call) Return(_a0 error) *OrderRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderRepository_Create_Call) RunAndReturn(run func(context.Context, model.Order) error) *OrderRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, orderUUID
 func (_m *OrderRepository) Get(ctx context.Context, orderUUID string) (model.Order, error) {
 	ret := _m.Called(ctx, orderUUID)
@@ -79,6 +128,185 @@ func (_c *OrderRepository_Get_Call) RunAndReturn(run func(context.Context, strin
 	return _c
 }
 
+// GetStatusHistory provides a mock function with given fields: ctx, orderUUID
+func (_m *OrderRepository) GetStatusHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusChange, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatusHistory")
+	}
+
+	var r0 []model.OrderStatusChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.OrderStatusChange, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.OrderStatusChange); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrderStatusChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_GetStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatusHistory'
+type OrderRepository_GetStatusHistory_Call struct {
+	*mock.Call
+}
+
+// GetStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *OrderRepository_Expecter) GetStatusHistory(ctx interface{}, orderUUID interface{}) *OrderRepository_GetStatusHistory_Call {
+	return &OrderRepository_GetStatusHistory_Call{Call: _e.mock.On("GetStatusHistory", ctx, orderUUID)}
+}
+
+func (_c *OrderRepository_GetStatusHistory_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderRepository_GetStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_GetStatusHistory_Call) Return(_a0 []model.OrderStatusChange, _a1 error) *OrderRepository_GetStatusHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_GetStatusHistory_Call) RunAndReturn(run func(context.Context, string) ([]model.OrderStatusChange, error)) *OrderRepository_GetStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, filter, page
+func (_m *OrderRepository) List(ctx context.Context, filter model.OrdersFilter, page model.OrdersPage) ([]model.Order, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter, model.OrdersPage) ([]model.Order, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrdersFilter, model.OrdersPage) []model.Order); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrdersFilter, model.OrdersPage) error); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type OrderRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrdersFilter
+//   - page model.OrdersPage
+func (_e *OrderRepository_Expecter) List(ctx interface{}, filter interface{}, page interface{}) *OrderRepository_List_Call {
+	return &OrderRepository_List_Call{Call: _e.mock.On("List", ctx, filter, page)}
+}
+
+func (_c *OrderRepository_List_Call) Run(run func(ctx context.Context, filter model.OrdersFilter, page model.OrdersPage)) *OrderRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.OrdersFilter), args[2].(model.OrdersPage))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_List_Call) Return(_a0 []model.Order, _a1 error) *OrderRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_List_Call) RunAndReturn(run func(context.Context, model.OrdersFilter, model.OrdersPage) ([]model.Order, error)) *OrderRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOverdue provides a mock function with given fields: ctx, deadline, limit
+func (_m *OrderRepository) ListOverdue(ctx context.Context, deadline time.Time, limit int) ([]model.Order, error) {
+	ret := _m.Called(ctx, deadline, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOverdue")
+	}
+
+	var r0 []model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]model.Order, error)); ok {
+		return rf(ctx, deadline, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []model.Order); ok {
+		r0 = rf(ctx, deadline, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, deadline, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_ListOverdue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOverdue'
+type OrderRepository_ListOverdue_Call struct {
+	*mock.Call
+}
+
+// ListOverdue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deadline time.Time
+//   - limit int
+func (_e *OrderRepository_Expecter) ListOverdue(ctx interface{}, deadline interface{}, limit interface{}) *OrderRepository_ListOverdue_Call {
+	return &OrderRepository_ListOverdue_Call{Call: _e.mock.On("ListOverdue", ctx, deadline, limit)}
+}
+
+func (_c *OrderRepository_ListOverdue_Call) Run(run func(ctx context.Context, deadline time.Time, limit int)) *OrderRepository_ListOverdue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_ListOverdue_Call) Return(_a0 []model.Order, _a1 error) *OrderRepository_ListOverdue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_ListOverdue_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]model.Order, error)) *OrderRepository_ListOverdue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, order
 func (_m *OrderRepository) Update(ctx context.Context, order model.Order) error {
 	ret := _m.Called(ctx, order)
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	repository "github.com/xgmsx/rsf/order/internal/repository"
+)
+
+// OutboxRepository is an autogenerated mock type for the OutboxRepository type
+type OutboxRepository struct {
+	mock.Mock
+}
+
+type OutboxRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OutboxRepository) EXPECT() *OutboxRepository_Expecter {
+	return &OutboxRepository_Expecter{mock: &_m.Mock}
+}
+
+// ProcessPending provides a mock function with given fields: ctx, limit, publish
+func (_m *OutboxRepository) ProcessPending(ctx context.Context, limit int, publish repository.OutboxPublishFunc) (int, error) {
+	ret := _m.Called(ctx, limit, publish)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessPending")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, repository.OutboxPublishFunc) (int, error)); ok {
+		return rf(ctx, limit, publish)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, repository.OutboxPublishFunc) int); ok {
+		r0 = rf(ctx, limit, publish)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, repository.OutboxPublishFunc) error); ok {
+		r1 = rf(ctx, limit, publish)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxRepository_ProcessPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessPending'
+type OutboxRepository_ProcessPending_Call struct {
+	*mock.Call
+}
+
+// ProcessPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - publish repository.OutboxPublishFunc
+func (_e *OutboxRepository_Expecter) ProcessPending(ctx interface{}, limit interface{}, publish interface{}) *OutboxRepository_ProcessPending_Call {
+	return &OutboxRepository_ProcessPending_Call{Call: _e.mock.On("ProcessPending", ctx, limit, publish)}
+}
+
+func (_c *OutboxRepository_ProcessPending_Call) Run(run func(ctx context.Context, limit int, publish repository.OutboxPublishFunc)) *OutboxRepository_ProcessPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(repository.OutboxPublishFunc))
+	})
+	return _c
+}
+
+func (_c *OutboxRepository_ProcessPending_Call) Return(_a0 int, _a1 error) *OutboxRepository_ProcessPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxRepository_ProcessPending_Call) RunAndReturn(run func(context.Context, int, repository.OutboxPublishFunc) (int, error)) *OutboxRepository_ProcessPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOutboxRepository creates a new instance of OutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxRepository {
+	mock := &OutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
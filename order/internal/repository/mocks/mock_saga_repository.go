@@ -0,0 +1,193 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/xgmsx/rsf/order/internal/model"
+
+	time "time"
+)
+
+// SagaRepository is an autogenerated mock type for the SagaRepository type
+type SagaRepository struct {
+	mock.Mock
+}
+
+type SagaRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SagaRepository) EXPECT() *SagaRepository_Expecter {
+	return &SagaRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, saga
+func (_m *SagaRepository) Create(ctx context.Context, saga model.Saga) error {
+	ret := _m.Called(ctx, saga)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Saga) error); ok {
+		r0 = rf(ctx, saga)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SagaRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SagaRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - saga model.Saga
+func (_e *SagaRepository_Expecter) Create(ctx interface{}, saga interface{}) *SagaRepository_Create_Call {
+	return &SagaRepository_Create_Call{Call: _e.mock.On("Create", ctx, saga)}
+}
+
+func (_c *SagaRepository_Create_Call) Run(run func(ctx context.Context, saga model.Saga)) *SagaRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Saga))
+	})
+	return _c
+}
+
+func (_c *SagaRepository_Create_Call) Return(_a0 error) *SagaRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SagaRepository_Create_Call) RunAndReturn(run func(context.Context, model.Saga) error) *SagaRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUnfinished provides a mock function with given fields: ctx, updatedBefore, limit
+func (_m *SagaRepository) ListUnfinished(ctx context.Context, updatedBefore time.Time, limit int) ([]model.Saga, error) {
+	ret := _m.Called(ctx, updatedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUnfinished")
+	}
+
+	var r0 []model.Saga
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]model.Saga, error)); ok {
+		return rf(ctx, updatedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []model.Saga); ok {
+		r0 = rf(ctx, updatedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Saga)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, updatedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SagaRepository_ListUnfinished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUnfinished'
+type SagaRepository_ListUnfinished_Call struct {
+	*mock.Call
+}
+
+// ListUnfinished is a helper method to define mock.On call
+//   - ctx context.Context
+//   - updatedBefore time.Time
+//   - limit int
+func (_e *SagaRepository_Expecter) ListUnfinished(ctx interface{}, updatedBefore interface{}, limit interface{}) *SagaRepository_ListUnfinished_Call {
+	return &SagaRepository_ListUnfinished_Call{Call: _e.mock.On("ListUnfinished", ctx, updatedBefore, limit)}
+}
+
+func (_c *SagaRepository_ListUnfinished_Call) Run(run func(ctx context.Context, updatedBefore time.Time, limit int)) *SagaRepository_ListUnfinished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *SagaRepository_ListUnfinished_Call) Return(_a0 []model.Saga, _a1 error) *SagaRepository_ListUnfinished_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SagaRepository_ListUnfinished_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]model.Saga, error)) *SagaRepository_ListUnfinished_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, saga
+func (_m *SagaRepository) Update(ctx context.Context, saga model.Saga) error {
+	ret := _m.Called(ctx, saga)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Saga) error); ok {
+		r0 = rf(ctx, saga)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SagaRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type SagaRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - saga model.Saga
+func (_e *SagaRepository_Expecter) Update(ctx interface{}, saga interface{}) *SagaRepository_Update_Call {
+	return &SagaRepository_Update_Call{Call: _e.mock.On("Update", ctx, saga)}
+}
+
+func (_c *SagaRepository_Update_Call) Run(run func(ctx context.Context, saga model.Saga)) *SagaRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Saga))
+	})
+	return _c
+}
+
+func (_c *SagaRepository_Update_Call) Return(_a0 error) *SagaRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SagaRepository_Update_Call) RunAndReturn(run func(context.Context, model.Saga) error) *SagaRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSagaRepository creates a new instance of SagaRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSagaRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SagaRepository {
+	mock := &SagaRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,568 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/xgmsx/rsf/order/internal/model"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// WebhookRepository is an autogenerated mock type for the WebhookRepository type
+type WebhookRepository struct {
+	mock.Mock
+}
+
+type WebhookRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookRepository) EXPECT() *WebhookRepository_Expecter {
+	return &WebhookRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDueDeliveries provides a mock function with given fields: ctx, now, leaseUntil, limit
+func (_m *WebhookRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]model.WebhookDelivery, error) {
+	ret := _m.Called(ctx, now, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueDeliveries")
+	}
+
+	var r0 []model.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]model.WebhookDelivery, error)); ok {
+		return rf(ctx, now, leaseUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []model.WebhookDelivery); ok {
+		r0 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ClaimDueDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueDeliveries'
+type WebhookRepository_ClaimDueDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimDueDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - leaseUntil time.Time
+//   - limit int
+func (_e *WebhookRepository_Expecter) ClaimDueDeliveries(ctx interface{}, now interface{}, leaseUntil interface{}, limit interface{}) *WebhookRepository_ClaimDueDeliveries_Call {
+	return &WebhookRepository_ClaimDueDeliveries_Call{Call: _e.mock.On("ClaimDueDeliveries", ctx, now, leaseUntil, limit)}
+}
+
+func (_c *WebhookRepository_ClaimDueDeliveries_Call) Run(run func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int)) *WebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ClaimDueDeliveries_Call) Return(_a0 []model.WebhookDelivery, _a1 error) *WebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ClaimDueDeliveries_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, int) ([]model.WebhookDelivery, error)) *WebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDeliveries provides a mock function with given fields: ctx, deliveries
+func (_m *WebhookRepository) CreateDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error {
+	ret := _m.Called(ctx, deliveries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDeliveries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.WebhookDelivery) error); ok {
+		r0 = rf(ctx, deliveries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_CreateDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDeliveries'
+type WebhookRepository_CreateDeliveries_Call struct {
+	*mock.Call
+}
+
+// CreateDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveries []model.WebhookDelivery
+func (_e *WebhookRepository_Expecter) CreateDeliveries(ctx interface{}, deliveries interface{}) *WebhookRepository_CreateDeliveries_Call {
+	return &WebhookRepository_CreateDeliveries_Call{Call: _e.mock.On("CreateDeliveries", ctx, deliveries)}
+}
+
+func (_c *WebhookRepository_CreateDeliveries_Call) Run(run func(ctx context.Context, deliveries []model.WebhookDelivery)) *WebhookRepository_CreateDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]model.WebhookDelivery))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_CreateDeliveries_Call) Return(_a0 error) *WebhookRepository_CreateDeliveries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_CreateDeliveries_Call) RunAndReturn(run func(context.Context, []model.WebhookDelivery) error) *WebhookRepository_CreateDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSubscription provides a mock function with given fields: ctx, subscription
+func (_m *WebhookRepository) CreateSubscription(ctx context.Context, subscription model.WebhookSubscription) error {
+	ret := _m.Called(ctx, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.WebhookSubscription) error); ok {
+		r0 = rf(ctx, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_CreateSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSubscription'
+type WebhookRepository_CreateSubscription_Call struct {
+	*mock.Call
+}
+
+// CreateSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscription model.WebhookSubscription
+func (_e *WebhookRepository_Expecter) CreateSubscription(ctx interface{}, subscription interface{}) *WebhookRepository_CreateSubscription_Call {
+	return &WebhookRepository_CreateSubscription_Call{Call: _e.mock.On("CreateSubscription", ctx, subscription)}
+}
+
+func (_c *WebhookRepository_CreateSubscription_Call) Run(run func(ctx context.Context, subscription model.WebhookSubscription)) *WebhookRepository_CreateSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.WebhookSubscription))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_CreateSubscription_Call) Return(_a0 error) *WebhookRepository_CreateSubscription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_CreateSubscription_Call) RunAndReturn(run func(context.Context, model.WebhookSubscription) error) *WebhookRepository_CreateSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSubscription provides a mock function with given fields: ctx, webhookUUID
+func (_m *WebhookRepository) DeleteSubscription(ctx context.Context, webhookUUID uuid.UUID) error {
+	ret := _m.Called(ctx, webhookUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, webhookUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_DeleteSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSubscription'
+type WebhookRepository_DeleteSubscription_Call struct {
+	*mock.Call
+}
+
+// DeleteSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID uuid.UUID
+func (_e *WebhookRepository_Expecter) DeleteSubscription(ctx interface{}, webhookUUID interface{}) *WebhookRepository_DeleteSubscription_Call {
+	return &WebhookRepository_DeleteSubscription_Call{Call: _e.mock.On("DeleteSubscription", ctx, webhookUUID)}
+}
+
+func (_c *WebhookRepository_DeleteSubscription_Call) Run(run func(ctx context.Context, webhookUUID uuid.UUID)) *WebhookRepository_DeleteSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_DeleteSubscription_Call) Return(_a0 error) *WebhookRepository_DeleteSubscription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_DeleteSubscription_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *WebhookRepository_DeleteSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDelivery provides a mock function with given fields: ctx, deliveryUUID
+func (_m *WebhookRepository) GetDelivery(ctx context.Context, deliveryUUID uuid.UUID) (model.WebhookDelivery, error) {
+	ret := _m.Called(ctx, deliveryUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelivery")
+	}
+
+	var r0 model.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.WebhookDelivery, error)); ok {
+		return rf(ctx, deliveryUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.WebhookDelivery); ok {
+		r0 = rf(ctx, deliveryUUID)
+	} else {
+		r0 = ret.Get(0).(model.WebhookDelivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, deliveryUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_GetDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelivery'
+type WebhookRepository_GetDelivery_Call struct {
+	*mock.Call
+}
+
+// GetDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveryUUID uuid.UUID
+func (_e *WebhookRepository_Expecter) GetDelivery(ctx interface{}, deliveryUUID interface{}) *WebhookRepository_GetDelivery_Call {
+	return &WebhookRepository_GetDelivery_Call{Call: _e.mock.On("GetDelivery", ctx, deliveryUUID)}
+}
+
+func (_c *WebhookRepository_GetDelivery_Call) Run(run func(ctx context.Context, deliveryUUID uuid.UUID)) *WebhookRepository_GetDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_GetDelivery_Call) Return(_a0 model.WebhookDelivery, _a1 error) *WebhookRepository_GetDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_GetDelivery_Call) RunAndReturn(run func(context.Context, uuid.UUID) (model.WebhookDelivery, error)) *WebhookRepository_GetDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubscription provides a mock function with given fields: ctx, webhookUUID
+func (_m *WebhookRepository) GetSubscription(ctx context.Context, webhookUUID uuid.UUID) (model.WebhookSubscription, error) {
+	ret := _m.Called(ctx, webhookUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscription")
+	}
+
+	var r0 model.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.WebhookSubscription, error)); ok {
+		return rf(ctx, webhookUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.WebhookSubscription); ok {
+		r0 = rf(ctx, webhookUUID)
+	} else {
+		r0 = ret.Get(0).(model.WebhookSubscription)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, webhookUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_GetSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscription'
+type WebhookRepository_GetSubscription_Call struct {
+	*mock.Call
+}
+
+// GetSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID uuid.UUID
+func (_e *WebhookRepository_Expecter) GetSubscription(ctx interface{}, webhookUUID interface{}) *WebhookRepository_GetSubscription_Call {
+	return &WebhookRepository_GetSubscription_Call{Call: _e.mock.On("GetSubscription", ctx, webhookUUID)}
+}
+
+func (_c *WebhookRepository_GetSubscription_Call) Run(run func(ctx context.Context, webhookUUID uuid.UUID)) *WebhookRepository_GetSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_GetSubscription_Call) Return(_a0 model.WebhookSubscription, _a1 error) *WebhookRepository_GetSubscription_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_GetSubscription_Call) RunAndReturn(run func(context.Context, uuid.UUID) (model.WebhookSubscription, error)) *WebhookRepository_GetSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeadDeliveries provides a mock function with given fields: ctx, webhookUUID
+func (_m *WebhookRepository) ListDeadDeliveries(ctx context.Context, webhookUUID uuid.UUID) ([]model.WebhookDelivery, error) {
+	ret := _m.Called(ctx, webhookUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeadDeliveries")
+	}
+
+	var r0 []model.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.WebhookDelivery, error)); ok {
+		return rf(ctx, webhookUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.WebhookDelivery); ok {
+		r0 = rf(ctx, webhookUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, webhookUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ListDeadDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeadDeliveries'
+type WebhookRepository_ListDeadDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListDeadDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID uuid.UUID
+func (_e *WebhookRepository_Expecter) ListDeadDeliveries(ctx interface{}, webhookUUID interface{}) *WebhookRepository_ListDeadDeliveries_Call {
+	return &WebhookRepository_ListDeadDeliveries_Call{Call: _e.mock.On("ListDeadDeliveries", ctx, webhookUUID)}
+}
+
+func (_c *WebhookRepository_ListDeadDeliveries_Call) Run(run func(ctx context.Context, webhookUUID uuid.UUID)) *WebhookRepository_ListDeadDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ListDeadDeliveries_Call) Return(_a0 []model.WebhookDelivery, _a1 error) *WebhookRepository_ListDeadDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ListDeadDeliveries_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]model.WebhookDelivery, error)) *WebhookRepository_ListDeadDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubscriptions provides a mock function with given fields: ctx
+func (_m *WebhookRepository) ListSubscriptions(ctx context.Context) ([]model.WebhookSubscription, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubscriptions")
+	}
+
+	var r0 []model.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.WebhookSubscription, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.WebhookSubscription); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_ListSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubscriptions'
+type WebhookRepository_ListSubscriptions_Call struct {
+	*mock.Call
+}
+
+// ListSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebhookRepository_Expecter) ListSubscriptions(ctx interface{}) *WebhookRepository_ListSubscriptions_Call {
+	return &WebhookRepository_ListSubscriptions_Call{Call: _e.mock.On("ListSubscriptions", ctx)}
+}
+
+func (_c *WebhookRepository_ListSubscriptions_Call) Run(run func(ctx context.Context)) *WebhookRepository_ListSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_ListSubscriptions_Call) Return(_a0 []model.WebhookSubscription, _a1 error) *WebhookRepository_ListSubscriptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_ListSubscriptions_Call) RunAndReturn(run func(context.Context) ([]model.WebhookSubscription, error)) *WebhookRepository_ListSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDelivery provides a mock function with given fields: ctx, delivery
+func (_m *WebhookRepository) UpdateDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	ret := _m.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.WebhookDelivery) error); ok {
+		r0 = rf(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_UpdateDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDelivery'
+type WebhookRepository_UpdateDelivery_Call struct {
+	*mock.Call
+}
+
+// UpdateDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery model.WebhookDelivery
+func (_e *WebhookRepository_Expecter) UpdateDelivery(ctx interface{}, delivery interface{}) *WebhookRepository_UpdateDelivery_Call {
+	return &WebhookRepository_UpdateDelivery_Call{Call: _e.mock.On("UpdateDelivery", ctx, delivery)}
+}
+
+func (_c *WebhookRepository_UpdateDelivery_Call) Run(run func(ctx context.Context, delivery model.WebhookDelivery)) *WebhookRepository_UpdateDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.WebhookDelivery))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_UpdateDelivery_Call) Return(_a0 error) *WebhookRepository_UpdateDelivery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_UpdateDelivery_Call) RunAndReturn(run func(context.Context, model.WebhookDelivery) error) *WebhookRepository_UpdateDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSubscription provides a mock function with given fields: ctx, subscription
+func (_m *WebhookRepository) UpdateSubscription(ctx context.Context, subscription model.WebhookSubscription) error {
+	ret := _m.Called(ctx, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.WebhookSubscription) error); ok {
+		r0 = rf(ctx, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_UpdateSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubscription'
+type WebhookRepository_UpdateSubscription_Call struct {
+	*mock.Call
+}
+
+// UpdateSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscription model.WebhookSubscription
+func (_e *WebhookRepository_Expecter) UpdateSubscription(ctx interface{}, subscription interface{}) *WebhookRepository_UpdateSubscription_Call {
+	return &WebhookRepository_UpdateSubscription_Call{Call: _e.mock.On("UpdateSubscription", ctx, subscription)}
+}
+
+func (_c *WebhookRepository_UpdateSubscription_Call) Run(run func(ctx context.Context, subscription model.WebhookSubscription)) *WebhookRepository_UpdateSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.WebhookSubscription))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_UpdateSubscription_Call) Return(_a0 error) *WebhookRepository_UpdateSubscription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_UpdateSubscription_Call) RunAndReturn(run func(context.Context, model.WebhookSubscription) error) *WebhookRepository_UpdateSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRepository {
+	mock := &WebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
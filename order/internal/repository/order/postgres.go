@@ -0,0 +1,493 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	def "github.com/xgmsx/rsf/order/internal/repository"
+	"github.com/xgmsx/rsf/order/internal/utils"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+)
+
+var (
+	_ def.OrderRepository  = (*postgresOrderRepository)(nil)
+	_ def.OutboxRepository = (*postgresOrderRepository)(nil)
+)
+
+const orderColumns = `order_uuid, user_uuid, total_price_amount, currency, transaction_uuid, payment_method, status, created_at, payment_deadline, refunded_amount, version`
+
+type postgresOrderRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresOrderRepository(pool *pgxpool.Pool) *postgresOrderRepository {
+	return &postgresOrderRepository{pool: pool}
+}
+
+func (r *postgresOrderRepository) Get(ctx context.Context, orderUUID string) (model.Order, error) {
+	id, err := uuid.Parse(orderUUID)
+	if err != nil {
+		return model.Order{}, model.ErrOrderNotFound
+	}
+
+	order, err := scanOrder(r.pool.QueryRow(ctx, `SELECT `+orderColumns+` FROM orders WHERE order_uuid = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Order{}, model.ErrOrderNotFound
+		}
+		return model.Order{}, fmt.Errorf("failed to select order: %w", err)
+	}
+
+	orders := []model.Order{order}
+	err = r.loadItems(ctx, orders)
+	if err != nil {
+		return model.Order{}, err
+	}
+
+	return orders[0], nil
+}
+
+func (r *postgresOrderRepository) Create(ctx context.Context, order model.Order) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `
+			INSERT INTO orders (order_uuid, user_uuid, total_price_amount, currency, transaction_uuid, payment_method, status, created_at, payment_deadline, refunded_amount, version)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, 1)
+			ON CONFLICT (order_uuid) DO NOTHING`,
+			order.OrderUUID,
+			order.UserUUID,
+			order.TotalPrice.Amount,
+			order.TotalPrice.Currency,
+			order.TransactionUUID,
+			paymentMethodToNullString(order.PaymentMethod),
+			string(order.Status),
+			order.CreatedAt,
+			order.PaymentDeadline,
+			order.RefundedAmount.Amount,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert order: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return model.ErrOrderExists
+		}
+
+		var (
+			partUUIDs  = make([]uuid.UUID, len(order.Items))
+			quantities = make([]int64, len(order.Items))
+			unitPrices = make([]int64, len(order.Items))
+			names      = make([]string, len(order.Items))
+			categories = make([]string, len(order.Items))
+		)
+		for i, item := range order.Items {
+			if item.UnitPrice.Currency != order.TotalPrice.Currency {
+				return fmt.Errorf("order item %s: %w", item.PartUUID, money.ErrCurrencyMismatch)
+			}
+			partUUIDs[i] = item.PartUUID
+			quantities[i] = item.Quantity
+			unitPrices[i] = item.UnitPrice.Amount
+			names[i] = item.Name
+			categories[i] = string(item.Category)
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO order_items (order_uuid, position, part_uuid, quantity, unit_price_amount, name, category)
+			SELECT $1, t.ord - 1, t.part_uuid, t.quantity, t.unit_price_amount, t.name, t.category
+			FROM unnest($2::uuid[], $3::bigint[], $4::bigint[], $5::text[], $6::text[])
+				WITH ORDINALITY AS t(part_uuid, quantity, unit_price_amount, name, category, ord)`,
+			order.OrderUUID, partUUIDs, quantities, unitPrices, names, categories,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert order items: %w", err)
+		}
+
+		err = insertStatusChanges(ctx, tx, order)
+		if err != nil {
+			return err
+		}
+		return insertOutboxMessages(ctx, tx, order)
+	})
+}
+
+// Update изменяет изменяемые поля заказа. Позиции после создания заказа
+// не меняются, поэтому таблица order_items не затрагивается.
+func (r *postgresOrderRepository) Update(ctx context.Context, order model.Order) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `
+			UPDATE orders SET
+				total_price_amount = $3,
+				currency           = $4,
+				transaction_uuid   = $5,
+				payment_method     = $6,
+				status             = $7,
+				refunded_amount    = $8,
+				version            = version + 1,
+				updated_at         = now()
+			WHERE order_uuid = $1 AND version = $2`,
+			order.OrderUUID,
+			order.Version,
+			order.TotalPrice.Amount,
+			order.TotalPrice.Currency,
+			order.TransactionUUID,
+			paymentMethodToNullString(order.PaymentMethod),
+			string(order.Status),
+			order.RefundedAmount.Amount,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			var exists bool
+			err = tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM orders WHERE order_uuid = $1)`, order.OrderUUID).Scan(&exists)
+			if err != nil {
+				return fmt.Errorf("failed to check order existence: %w", err)
+			}
+			if !exists {
+				return model.ErrOrderNotFound
+			}
+			return model.ErrOrderConflict
+		}
+
+		err = insertStatusChanges(ctx, tx, order)
+		if err != nil {
+			return err
+		}
+		return insertOutboxMessages(ctx, tx, order)
+	})
+}
+
+func (r *postgresOrderRepository) GetStatusHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusChange, error) {
+	id, err := uuid.Parse(orderUUID)
+	if err != nil {
+		return nil, model.ErrOrderNotFound
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT coalesce(from_status, ''), to_status, reason, changed_at
+		FROM order_status_history
+		WHERE order_uuid = $1
+		ORDER BY id`, id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select order status history: %w", err)
+	}
+	history, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.OrderStatusChange, error) {
+		var change model.OrderStatusChange
+		err := row.Scan(&change.From, &change.To, &change.Reason, &change.ChangedAt)
+		change.ChangedAt = change.ChangedAt.UTC()
+		return change, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan order status history: %w", err)
+	}
+
+	if len(history) == 0 {
+		var exists bool
+		err = r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM orders WHERE order_uuid = $1)`, id).Scan(&exists)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check order existence: %w", err)
+		}
+		if !exists {
+			return nil, model.ErrOrderNotFound
+		}
+	}
+
+	return history, nil
+}
+
+func (r *postgresOrderRepository) List(ctx context.Context, filter model.OrdersFilter, page model.OrdersPage) ([]model.Order, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.UserUUID != nil {
+		conds = append(conds, "user_uuid = "+arg(*filter.UserUUID))
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, st := range filter.Statuses {
+			statuses[i] = string(st)
+		}
+		conds = append(conds, "status = ANY("+arg(statuses)+")")
+	}
+	if len(filter.PaymentMethods) > 0 {
+		methods := make([]string, len(filter.PaymentMethods))
+		for i, pm := range filter.PaymentMethods {
+			methods[i] = string(pm)
+		}
+		conds = append(conds, "payment_method = ANY("+arg(methods)+")")
+	}
+	if filter.CreatedFrom != nil {
+		conds = append(conds, "created_at >= "+arg(*filter.CreatedFrom))
+	}
+	if filter.CreatedTo != nil {
+		conds = append(conds, "created_at < "+arg(*filter.CreatedTo))
+	}
+	if filter.PartUUID != nil {
+		conds = append(conds, `EXISTS (
+			SELECT 1 FROM order_items i
+			WHERE i.order_uuid = orders.order_uuid AND i.part_uuid = `+arg(*filter.PartUUID)+`)`)
+	}
+
+	direction, cmp := "DESC", "<"
+	if page.Sort == model.OrdersSortCreatedAtAsc {
+		direction, cmp = "ASC", ">"
+	}
+	if page.After != nil {
+		conds = append(conds, fmt.Sprintf("(created_at, order_uuid) %s (%s, %s)",
+			cmp, arg(page.After.CreatedAt), arg(page.After.OrderUUID)))
+	}
+
+	query := `SELECT ` + orderColumns + ` FROM orders`
+	if len(conds) > 0 {
+		query += ` WHERE ` + strings.Join(conds, " AND ")
+	}
+	query += fmt.Sprintf(` ORDER BY created_at %[1]s, order_uuid %[1]s LIMIT %s`, direction, arg(page.Limit))
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select orders: %w", err)
+	}
+	orders, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Order, error) {
+		return scanOrder(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan orders: %w", err)
+	}
+
+	err = r.loadItems(ctx, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (r *postgresOrderRepository) ListOverdue(ctx context.Context, deadline time.Time, limit int) ([]model.Order, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+orderColumns+` FROM orders
+		WHERE status = $1 AND payment_deadline <= $2
+		ORDER BY payment_deadline
+		LIMIT $3`,
+		string(model.OrderStatusPENDINGPAYMENT), deadline, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select overdue orders: %w", err)
+	}
+	orders, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Order, error) {
+		return scanOrder(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan overdue orders: %w", err)
+	}
+
+	err = r.loadItems(ctx, orders)
+	if err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// loadItems заполняет Items переданных заказов одним запросом
+func (r *postgresOrderRepository) loadItems(ctx context.Context, orders []model.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(orders))
+	index := make(map[uuid.UUID]int, len(orders))
+	for i, order := range orders {
+		ids[i] = order.OrderUUID
+		index[order.OrderUUID] = i
+		orders[i].Items = []model.OrderItem{}
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT order_uuid, part_uuid, quantity, unit_price_amount, name, category
+		FROM order_items
+		WHERE order_uuid = ANY($1)
+		ORDER BY order_uuid, position`, ids,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to select order items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			orderUUID uuid.UUID
+			item      model.OrderItem
+		)
+		err = rows.Scan(&orderUUID, &item.PartUUID, &item.Quantity, &item.UnitPrice.Amount, &item.Name, &item.Category)
+		if err != nil {
+			return fmt.Errorf("failed to scan order items: %w", err)
+		}
+		i := index[orderUUID]
+		item.UnitPrice.Currency = orders[i].TotalPrice.Currency
+		orders[i].Items = append(orders[i].Items, item)
+	}
+
+	return rows.Err()
+}
+
+// insertStatusChanges дописывает order.NewStatusChanges в историю статусов
+func insertStatusChanges(ctx context.Context, tx pgx.Tx, order model.Order) error {
+	if len(order.NewStatusChanges) == 0 {
+		return nil
+	}
+
+	var (
+		from      = make([]*string, len(order.NewStatusChanges))
+		to        = make([]string, len(order.NewStatusChanges))
+		reasons   = make([]string, len(order.NewStatusChanges))
+		changedAt = make([]time.Time, len(order.NewStatusChanges))
+	)
+	for i, change := range order.NewStatusChanges {
+		if change.From != "" {
+			from[i] = utils.ToPtr(string(change.From))
+		}
+		to[i] = string(change.To)
+		reasons[i] = change.Reason
+		changedAt[i] = change.ChangedAt
+	}
+
+	_, err := tx.Exec(ctx, `
+		INSERT INTO order_status_history (order_uuid, from_status, to_status, reason, changed_at)
+		SELECT $1, t.from_status, t.to_status, t.reason, t.changed_at
+		FROM unnest($2::text[], $3::text[], $4::text[], $5::timestamptz[])
+			WITH ORDINALITY AS t(from_status, to_status, reason, changed_at, ord)
+		ORDER BY t.ord`,
+		order.OrderUUID, from, to, reasons, changedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert order status history: %w", err)
+	}
+	return nil
+}
+
+// insertOutboxMessages записывает order.NewEvents в outbox
+func insertOutboxMessages(ctx context.Context, tx pgx.Tx, order model.Order) error {
+	if len(order.NewEvents) == 0 {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, msg := range order.NewEvents {
+		batch.Queue(`
+			INSERT INTO order_outbox (event_uuid, order_uuid, event_type, payload, created_at)
+			VALUES ($1, $2, $3, $4, $5)`,
+			msg.EventUUID, msg.OrderUUID, msg.EventType, msg.Payload, msg.CreatedAt,
+		)
+	}
+	err := tx.SendBatch(ctx, batch).Close()
+	if err != nil {
+		return fmt.Errorf("failed to insert outbox messages: %w", err)
+	}
+	return nil
+}
+
+// ProcessPending блокирует выбранные строки outbox до конца транзакции,
+// поэтому несколько реплик могут публиковать сообщения параллельно.
+func (r *postgresOrderRepository) ProcessPending(ctx context.Context, limit int, publish def.OutboxPublishFunc) (int, error) {
+	var published int
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT id, event_uuid, order_uuid, event_type, payload, created_at
+			FROM order_outbox
+			ORDER BY id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED`, limit,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to select outbox messages: %w", err)
+		}
+
+		var ids []int64
+		messages, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.OutboxMessage, error) {
+			var (
+				id  int64
+				msg model.OutboxMessage
+			)
+			err := row.Scan(&id, &msg.EventUUID, &msg.OrderUUID, &msg.EventType, &msg.Payload, &msg.CreatedAt)
+			ids = append(ids, id)
+			msg.CreatedAt = msg.CreatedAt.UTC()
+			return msg, err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to scan outbox messages: %w", err)
+		}
+		if len(messages) == 0 {
+			return nil
+		}
+
+		err = publish(ctx, messages)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `DELETE FROM order_outbox WHERE id = ANY($1)`, ids)
+		if err != nil {
+			return fmt.Errorf("failed to delete outbox messages: %w", err)
+		}
+		published = len(messages)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return published, nil
+}
+
+func scanOrder(row pgx.Row) (model.Order, error) {
+	var (
+		order         model.Order
+		paymentMethod *string
+	)
+	err := row.Scan(
+		&order.OrderUUID,
+		&order.UserUUID,
+		&order.TotalPrice.Amount,
+		&order.TotalPrice.Currency,
+		&order.TransactionUUID,
+		&paymentMethod,
+		&order.Status,
+		&order.CreatedAt,
+		&order.PaymentDeadline,
+		&order.RefundedAmount.Amount,
+		&order.Version,
+	)
+	if err != nil {
+		return model.Order{}, err
+	}
+
+	if paymentMethod != nil {
+		pm := model.PaymentMethod(*paymentMethod)
+		order.PaymentMethod = &pm
+	}
+	order.CreatedAt = order.CreatedAt.UTC()
+	order.PaymentDeadline = order.PaymentDeadline.UTC()
+	order.RefundedAmount.Currency = order.TotalPrice.Currency
+
+	return order, nil
+}
+
+func paymentMethodToNullString(pm *model.PaymentMethod) *string {
+	if pm == nil {
+		return nil
+	}
+	s := string(*pm)
+	return &s
+}
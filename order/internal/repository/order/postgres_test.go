@@ -0,0 +1,132 @@
+package order
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgmsx/rsf/order/internal/migrator"
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/utils"
+	"github.com/xgmsx/rsf/order/migrations"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+)
+
+// newTestPostgresRepository подключается к PostgreSQL из ORDER_TEST_POSTGRES_DSN
+// (например, к контейнеру postgres-order из docker-compose) и применяет миграции.
+func newTestPostgresRepository(t *testing.T) *postgresOrderRepository {
+	t.Helper()
+
+	dsn := os.Getenv("ORDER_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("ORDER_TEST_POSTGRES_DSN is not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	db := stdlib.OpenDBFromPool(pool)
+	t.Cleanup(func() { _ = db.Close() })
+
+	m, err := migrator.NewMigrator(db, migrations.FS)
+	require.NoError(t, err)
+	require.NoError(t, m.Up(ctx))
+
+	return NewPostgresOrderRepository(pool)
+}
+
+func TestPostgresOrderRepository(t *testing.T) {
+	repo := newTestPostgresRepository(t)
+	ctx := context.Background()
+
+	order := model.Order{
+		OrderUUID: uuid.New(),
+		UserUUID:  uuid.New(),
+		Items: []model.OrderItem{
+			{PartUUID: uuid.New(), Quantity: 1, UnitPrice: money.New(10000, "RUB"), Name: "Hyperdrive Engine", Category: model.PartCategoryENGINE},
+			{PartUUID: uuid.New(), Quantity: 2, UnitPrice: money.New(1173, "RUB"), Name: "Quantum Shield Generator", Category: model.PartCategorySHIELD},
+		},
+		TotalPrice:     money.New(12346, "RUB"),
+		RefundedAmount: money.Zero("RUB"),
+		Status:         model.OrderStatusPENDINGPAYMENT,
+		CreatedAt:      time.Now().UTC().Truncate(time.Microsecond),
+	}
+
+	t.Run("Create and get", func(t *testing.T) {
+		require.NoError(t, repo.Create(ctx, order))
+		order.Version = 1
+
+		got, err := repo.Get(ctx, order.OrderUUID.String())
+		require.NoError(t, err)
+		require.Equal(t, order, got)
+	})
+
+	t.Run("Create duplicate order", func(t *testing.T) {
+		require.ErrorIs(t, repo.Create(ctx, order), model.ErrOrderExists)
+	})
+
+	t.Run("Update existing order", func(t *testing.T) {
+		order.Status = model.OrderStatusPAID
+		order.PaymentMethod = utils.ToPtr(model.PaymentMethodCARD)
+		order.TransactionUUID = utils.ToPtr(uuid.New())
+		require.NoError(t, repo.Update(ctx, order))
+		order.Version = 2
+
+		got, err := repo.Get(ctx, order.OrderUUID.String())
+		require.NoError(t, err)
+		require.Equal(t, order, got)
+	})
+
+	t.Run("Update refunded amount", func(t *testing.T) {
+		order.Status = model.OrderStatusPARTIALLYREFUNDED
+		order.RefundedAmount = money.New(5000, "RUB")
+		require.NoError(t, repo.Update(ctx, order))
+		order.Version = 3
+
+		got, err := repo.Get(ctx, order.OrderUUID.String())
+		require.NoError(t, err)
+		require.Equal(t, order, got)
+	})
+
+	t.Run("Update with stale version", func(t *testing.T) {
+		stale := order
+		stale.Version = 1
+		stale.Status = model.OrderStatusCANCELLED
+		require.ErrorIs(t, repo.Update(ctx, stale), model.ErrOrderConflict)
+	})
+
+	t.Run("Update missing order", func(t *testing.T) {
+		missing := order
+		missing.OrderUUID = uuid.New()
+		require.ErrorIs(t, repo.Update(ctx, missing), model.ErrOrderNotFound)
+	})
+
+	t.Run("Order not found", func(t *testing.T) {
+		_, err := repo.Get(ctx, uuid.NewString())
+		require.ErrorIs(t, err, model.ErrOrderNotFound)
+	})
+}
+
+func TestPostgresOrderRepositoryList(t *testing.T) {
+	testListOrders(t, newTestPostgresRepository(t))
+}
+
+func TestPostgresOrderRepositoryStatusHistory(t *testing.T) {
+	testStatusHistory(t, newTestPostgresRepository(t))
+}
+
+func TestPostgresOrderRepositoryListOverdue(t *testing.T) {
+	testListOverdue(t, newTestPostgresRepository(t))
+}
+
+func TestPostgresOrderRepositoryOutbox(t *testing.T) {
+	testOutbox(t, newTestPostgresRepository(t))
+}
@@ -2,22 +2,33 @@ package order
 
 import (
 	"context"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/xgmsx/rsf/order/internal/model"
 	def "github.com/xgmsx/rsf/order/internal/repository"
 )
 
-var _ def.OrderRepository = (*orderRepository)(nil)
+var (
+	_ def.OrderRepository  = (*orderRepository)(nil)
+	_ def.OutboxRepository = (*orderRepository)(nil)
+)
 
 type orderRepository struct {
-	mu     sync.RWMutex
-	orders map[string]*model.Order
+	mu      sync.RWMutex
+	orders  map[string]*model.Order
+	history map[string][]model.OrderStatusChange
+	outbox  []model.OutboxMessage
+
+	// relayMu не дает двум вызовам ProcessPending опубликовать одни и те же сообщения
+	relayMu sync.Mutex
 }
 
 func NewOrderRepository() *orderRepository {
 	return &orderRepository{
-		orders: make(map[string]*model.Order),
+		orders:  make(map[string]*model.Order),
+		history: make(map[string][]model.OrderStatusChange),
 	}
 }
 
@@ -33,10 +44,168 @@ func (r *orderRepository) Get(ctx context.Context, orderUUID string) (model.Orde
 	return *order, nil
 }
 
+func (r *orderRepository) Create(ctx context.Context, order model.Order) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.orders[order.OrderUUID.String()]; ok {
+		return model.ErrOrderExists
+	}
+
+	order.Version = 1
+	r.save(order)
+	return nil
+}
+
 func (r *orderRepository) Update(ctx context.Context, order model.Order) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	r.orders[order.OrderUUID.String()] = &order
+	stored, ok := r.orders[order.OrderUUID.String()]
+	if !ok {
+		return model.ErrOrderNotFound
+	}
+	if stored.Version != order.Version {
+		return model.ErrOrderConflict
+	}
+
+	order.Version++
+	r.save(order)
 	return nil
 }
+
+func (r *orderRepository) GetStatusHistory(_ context.Context, orderUUID string) ([]model.OrderStatusChange, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.orders[orderUUID]; !ok {
+		return nil, model.ErrOrderNotFound
+	}
+
+	return slices.Clone(r.history[orderUUID]), nil
+}
+
+// save сохраняет заказ, переносит его новые изменения статуса в историю,
+// а новые события - в outbox
+func (r *orderRepository) save(order model.Order) {
+	id := order.OrderUUID.String()
+	r.history[id] = append(r.history[id], order.NewStatusChanges...)
+	r.outbox = append(r.outbox, order.NewEvents...)
+	order.NewStatusChanges = nil
+	order.NewEvents = nil
+	r.orders[id] = &order
+}
+
+func (r *orderRepository) ProcessPending(ctx context.Context, limit int, publish def.OutboxPublishFunc) (int, error) {
+	r.relayMu.Lock()
+	defer r.relayMu.Unlock()
+
+	r.mu.RLock()
+	messages := slices.Clone(r.outbox[:min(limit, len(r.outbox))])
+	r.mu.RUnlock()
+
+	if len(messages) == 0 {
+		return 0, nil
+	}
+	err := publish(ctx, messages)
+	if err != nil {
+		return 0, err
+	}
+
+	// Пока шла публикация, в outbox могли дописать новые сообщения,
+	// но только в конец, поэтому опубликованные по-прежнему в начале
+	r.mu.Lock()
+	r.outbox = slices.Delete(r.outbox, 0, len(messages))
+	r.mu.Unlock()
+
+	return len(messages), nil
+}
+
+func (r *orderRepository) List(_ context.Context, filter model.OrdersFilter, page model.OrdersPage) ([]model.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	desc := page.Sort != model.OrdersSortCreatedAtAsc
+	result := make([]model.Order, 0, page.Limit)
+	for _, order := range r.orders {
+		if !matchesFilter(order, filter) {
+			continue
+		}
+		if page.After != nil && !isAfter(order, page.After, desc) {
+			continue
+		}
+		result = append(result, *order)
+	}
+
+	slices.SortFunc(result, func(a, b model.Order) int {
+		c := compareByCreatedAt(a, b)
+		if desc {
+			return -c
+		}
+		return c
+	})
+
+	if page.Limit > 0 && len(result) > page.Limit {
+		result = result[:page.Limit]
+	}
+	return result, nil
+}
+
+func (r *orderRepository) ListOverdue(_ context.Context, deadline time.Time, limit int) ([]model.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []model.Order
+	for _, order := range r.orders {
+		if order.Status == model.OrderStatusPENDINGPAYMENT && !order.PaymentDeadline.After(deadline) {
+			result = append(result, *order)
+		}
+	}
+
+	slices.SortFunc(result, func(a, b model.Order) int {
+		return a.PaymentDeadline.Compare(b.PaymentDeadline)
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func matchesFilter(order *model.Order, filter model.OrdersFilter) bool {
+	if filter.UserUUID != nil && order.UserUUID != *filter.UserUUID {
+		return false
+	}
+	if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, order.Status) {
+		return false
+	}
+	if len(filter.PaymentMethods) > 0 &&
+		(order.PaymentMethod == nil || !slices.Contains(filter.PaymentMethods, *order.PaymentMethod)) {
+		return false
+	}
+	if filter.CreatedFrom != nil && order.CreatedAt.Before(*filter.CreatedFrom) {
+		return false
+	}
+	if filter.CreatedTo != nil && !order.CreatedAt.Before(*filter.CreatedTo) {
+		return false
+	}
+	if filter.PartUUID != nil && !order.HasPart(*filter.PartUUID) {
+		return false
+	}
+	return true
+}
+
+// isAfter сообщает, находится ли заказ после курсора в заданном порядке сортировки
+func isAfter(order *model.Order, cursor *model.OrdersCursor, desc bool) bool {
+	c := compareByCreatedAt(*order, model.Order{CreatedAt: cursor.CreatedAt, OrderUUID: cursor.OrderUUID})
+	if desc {
+		return c < 0
+	}
+	return c > 0
+}
+
+func compareByCreatedAt(a, b model.Order) int {
+	if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+		return c
+	}
+	return slices.Compare(a.OrderUUID[:], b.OrderUUID[:])
+}
@@ -0,0 +1,267 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/repository"
+	"github.com/xgmsx/rsf/order/internal/utils"
+)
+
+// seedOrders создает заказы с возрастающей датой создания
+func seedOrders(t *testing.T, repo repository.OrderRepository, userUUID, partUUID uuid.UUID) []model.Order {
+	t.Helper()
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	orders := []model.Order{
+		{UserUUID: userUUID, Status: model.OrderStatusPENDINGPAYMENT, Items: []model.OrderItem{{PartUUID: partUUID, Quantity: 1}}},
+		{UserUUID: userUUID, Status: model.OrderStatusPAID, PaymentMethod: utils.ToPtr(model.PaymentMethodCARD)},
+		{UserUUID: uuid.New(), Status: model.OrderStatusPAID, PaymentMethod: utils.ToPtr(model.PaymentMethodSBP)},
+		{UserUUID: userUUID, Status: model.OrderStatusCANCELLED, Items: []model.OrderItem{{PartUUID: partUUID, Quantity: 2}}},
+		{UserUUID: userUUID, Status: model.OrderStatusPENDINGPAYMENT},
+	}
+	for i := range orders {
+		orders[i].OrderUUID = uuid.New()
+		orders[i].CreatedAt = base.Add(time.Duration(i) * time.Hour)
+		if orders[i].Items == nil {
+			orders[i].Items = []model.OrderItem{{PartUUID: uuid.New(), Quantity: 1}}
+		}
+		require.NoError(t, repo.Create(context.Background(), orders[i]))
+		orders[i].Version = 1
+	}
+	return orders
+}
+
+func orderUUIDs(orders []model.Order) []uuid.UUID {
+	ids := make([]uuid.UUID, len(orders))
+	for i, order := range orders {
+		ids[i] = order.OrderUUID
+	}
+	return ids
+}
+
+func testListOrders(t *testing.T, repo repository.OrderRepository) {
+	ctx := context.Background()
+	userUUID, partUUID := uuid.New(), uuid.New()
+	orders := seedOrders(t, repo, userUUID, partUUID)
+
+	testCases := []struct {
+		name     string
+		filter   model.OrdersFilter
+		page     model.OrdersPage
+		expected []model.Order
+	}{
+		{
+			name:     "Filter by user, newest first",
+			filter:   model.OrdersFilter{UserUUID: &userUUID},
+			page:     model.OrdersPage{Limit: 10},
+			expected: []model.Order{orders[4], orders[3], orders[1], orders[0]},
+		},
+		{
+			name:     "Filter by status, oldest first",
+			filter:   model.OrdersFilter{UserUUID: &userUUID, Statuses: []model.OrderStatus{model.OrderStatusPENDINGPAYMENT}},
+			page:     model.OrdersPage{Sort: model.OrdersSortCreatedAtAsc, Limit: 10},
+			expected: []model.Order{orders[0], orders[4]},
+		},
+		{
+			name:     "Filter by payment method",
+			filter:   model.OrdersFilter{UserUUID: &userUUID, PaymentMethods: []model.PaymentMethod{model.PaymentMethodCARD}},
+			page:     model.OrdersPage{Limit: 10},
+			expected: []model.Order{orders[1]},
+		},
+		{
+			name: "Filter by created_at range",
+			filter: model.OrdersFilter{
+				UserUUID:    &userUUID,
+				CreatedFrom: &orders[1].CreatedAt,
+				CreatedTo:   &orders[4].CreatedAt,
+			},
+			page:     model.OrdersPage{Sort: model.OrdersSortCreatedAtAsc, Limit: 10},
+			expected: []model.Order{orders[1], orders[3]},
+		},
+		{
+			name:     "Filter by part",
+			filter:   model.OrdersFilter{PartUUID: &partUUID},
+			page:     model.OrdersPage{Limit: 10},
+			expected: []model.Order{orders[3], orders[0]},
+		},
+		{
+			name:   "Page after cursor",
+			filter: model.OrdersFilter{UserUUID: &userUUID},
+			page: model.OrdersPage{
+				After: &model.OrdersCursor{CreatedAt: orders[3].CreatedAt, OrderUUID: orders[3].OrderUUID},
+				Limit: 1,
+			},
+			expected: []model.Order{orders[1]},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := repo.List(ctx, tc.filter, tc.page)
+			require.NoError(t, err)
+			require.Equal(t, orderUUIDs(tc.expected), orderUUIDs(got))
+		})
+	}
+}
+
+func TestOrderRepositoryList(t *testing.T) {
+	testListOrders(t, NewOrderRepository())
+}
+
+func testStatusHistory(t *testing.T, repo repository.OrderRepository) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	order := model.Order{
+		OrderUUID: uuid.New(),
+		UserUUID:  uuid.New(),
+		Items:     []model.OrderItem{{PartUUID: uuid.New(), Quantity: 1}},
+		CreatedAt: createdAt,
+	}
+	require.NoError(t, order.TransitionTo(model.OrderStatusPENDINGPAYMENT, "order created", createdAt))
+	require.NoError(t, repo.Create(ctx, order))
+
+	order, err := repo.Get(ctx, order.OrderUUID.String())
+	require.NoError(t, err)
+	require.Empty(t, order.NewStatusChanges)
+
+	require.NoError(t, order.TransitionTo(model.OrderStatusCANCELLED, "cancelled by user", createdAt.Add(time.Minute)))
+	require.NoError(t, repo.Update(ctx, order))
+
+	history, err := repo.GetStatusHistory(ctx, order.OrderUUID.String())
+	require.NoError(t, err)
+	require.Equal(t, []model.OrderStatusChange{
+		{To: model.OrderStatusPENDINGPAYMENT, Reason: "order created", ChangedAt: createdAt},
+		{From: model.OrderStatusPENDINGPAYMENT, To: model.OrderStatusCANCELLED, Reason: "cancelled by user", ChangedAt: createdAt.Add(time.Minute)},
+	}, history)
+
+	_, err = repo.GetStatusHistory(ctx, uuid.NewString())
+	require.ErrorIs(t, err, model.ErrOrderNotFound)
+}
+
+func TestOrderRepositoryStatusHistory(t *testing.T) {
+	testStatusHistory(t, NewOrderRepository())
+}
+
+func testListOverdue(t *testing.T, repo repository.OrderRepository) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newOrder := func(status model.OrderStatus, deadline time.Time) model.Order {
+		order := model.Order{
+			OrderUUID:       uuid.New(),
+			UserUUID:        uuid.New(),
+			Items:           []model.OrderItem{{PartUUID: uuid.New(), Quantity: 1}},
+			Status:          status,
+			CreatedAt:       deadline.Add(-15 * time.Minute),
+			PaymentDeadline: deadline,
+		}
+		require.NoError(t, repo.Create(ctx, order))
+		return order
+	}
+
+	overdueLater := newOrder(model.OrderStatusPENDINGPAYMENT, now.Add(-time.Minute))
+	overdueEarlier := newOrder(model.OrderStatusPENDINGPAYMENT, now.Add(-time.Hour))
+	newOrder(model.OrderStatusPENDINGPAYMENT, now.Add(time.Minute))
+	newOrder(model.OrderStatusPAID, now.Add(-time.Hour))
+
+	// Оставляем только заказы этого теста, в postgres могут быть чужие заказы
+	ids := func(orders []model.Order) []uuid.UUID {
+		var result []uuid.UUID
+		for _, order := range orders {
+			if order.OrderUUID == overdueLater.OrderUUID || order.OrderUUID == overdueEarlier.OrderUUID {
+				result = append(result, order.OrderUUID)
+			}
+		}
+		return result
+	}
+
+	got, err := repo.ListOverdue(ctx, now, 100)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{overdueEarlier.OrderUUID, overdueLater.OrderUUID}, ids(got))
+	for _, order := range got {
+		require.Equal(t, model.OrderStatusPENDINGPAYMENT, order.Status)
+		require.False(t, order.PaymentDeadline.After(now))
+	}
+}
+
+func TestOrderRepositoryListOverdue(t *testing.T) {
+	testListOverdue(t, NewOrderRepository())
+}
+
+// outboxRepository - хранилище заказов с outbox
+type outboxRepository interface {
+	repository.OrderRepository
+	repository.OutboxRepository
+}
+
+func testOutbox(t *testing.T, repo outboxRepository) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	order := model.Order{
+		OrderUUID: uuid.New(),
+		UserUUID:  uuid.New(),
+		Items:     []model.OrderItem{{PartUUID: uuid.New(), Quantity: 1}},
+		Status:    model.OrderStatusPENDINGPAYMENT,
+		CreatedAt: createdAt,
+	}
+	newEvent := func(eventType string) model.OutboxMessage {
+		return model.OutboxMessage{
+			EventUUID: uuid.New(),
+			OrderUUID: order.OrderUUID,
+			EventType: eventType,
+			Payload:   []byte(eventType),
+			CreatedAt: createdAt,
+		}
+	}
+	created, cancelled := newEvent("order.v1.OrderCreated"), newEvent("order.v1.OrderCancelled")
+
+	order.NewEvents = []model.OutboxMessage{created}
+	require.NoError(t, repo.Create(ctx, order))
+	order.Version = 1
+	order.NewEvents = []model.OutboxMessage{cancelled}
+	require.NoError(t, repo.Update(ctx, order))
+
+	// Изменение, отклоненное по конфликту версий, не попадает в outbox
+	order.NewEvents = []model.OutboxMessage{newEvent("order.v1.OrderPaid")}
+	require.ErrorIs(t, repo.Update(ctx, order), model.ErrOrderConflict)
+
+	// Оставляем только события этого теста, в postgres могут быть чужие сообщения
+	drain := func() []model.OutboxMessage {
+		var got []model.OutboxMessage
+		for {
+			published, err := repo.ProcessPending(ctx, 100, func(_ context.Context, messages []model.OutboxMessage) error {
+				for _, msg := range messages {
+					if msg.OrderUUID == order.OrderUUID {
+						got = append(got, msg)
+					}
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			if published == 0 {
+				return got
+			}
+		}
+	}
+
+	_, err := repo.ProcessPending(ctx, 100, func(context.Context, []model.OutboxMessage) error {
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+
+	require.Equal(t, []model.OutboxMessage{created, cancelled}, drain())
+	require.Empty(t, drain())
+}
+
+func TestOrderRepositoryOutbox(t *testing.T) {
+	testOutbox(t, NewOrderRepository())
+}
@@ -2,11 +2,90 @@ package repository
 
 import (
 	"context"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/xgmsx/rsf/order/internal/model"
 )
 
 type OrderRepository interface {
 	Get(ctx context.Context, orderUUID string) (model.Order, error)
+	// Create сохраняет новый заказ с версией 1, его NewStatusChanges и NewEvents
+	Create(ctx context.Context, order model.Order) error
+	// Update сохраняет заказ, только если его версия в хранилище совпадает
+	// с order.Version, и увеличивает версию на единицу. Иначе возвращает
+	// model.ErrOrderConflict. NewStatusChanges дописываются в историю статусов,
+	// NewEvents - в outbox, в той же транзакции, что и сам заказ.
 	Update(ctx context.Context, order model.Order) error
+	// List возвращает до page.Limit заказов, подходящих под фильтр,
+	// в порядке page.Sort, начиная после page.After
+	List(ctx context.Context, filter model.OrdersFilter, page model.OrdersPage) ([]model.Order, error)
+	// ListOverdue возвращает до limit заказов в статусе PENDING_PAYMENT,
+	// крайний срок оплаты которых наступил не позже deadline
+	ListOverdue(ctx context.Context, deadline time.Time, limit int) ([]model.Order, error)
+	// GetStatusHistory возвращает историю статусов заказа в хронологическом порядке
+	GetStatusHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusChange, error)
+}
+
+// OutboxPublishFunc публикует сообщения outbox в брокер
+type OutboxPublishFunc func(ctx context.Context, messages []model.OutboxMessage) error
+
+type OutboxRepository interface {
+	// ProcessPending передает в publish до limit сообщений outbox в порядке записи
+	// и удаляет их, только если publish завершился без ошибки. Параллельные вызовы
+	// не получают одни и те же сообщения. Возвращает число опубликованных сообщений.
+	ProcessPending(ctx context.Context, limit int, publish OutboxPublishFunc) (int, error)
+}
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, subscription model.WebhookSubscription) error
+	// GetSubscription возвращает model.ErrWebhookNotFound, если подписки нет
+	GetSubscription(ctx context.Context, webhookUUID uuid.UUID) (model.WebhookSubscription, error)
+	// ListSubscriptions возвращает подписки в порядке создания
+	ListSubscriptions(ctx context.Context) ([]model.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, subscription model.WebhookSubscription) error
+	// DeleteSubscription удаляет подписку вместе с ее доставками
+	DeleteSubscription(ctx context.Context, webhookUUID uuid.UUID) error
+
+	// CreateDeliveries сохраняет доставки, пропуская уже созданные
+	// для той же пары подписки и события
+	CreateDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error
+	// ClaimDueDeliveries возвращает до limit доставок в статусе PENDING, время попытки
+	// которых наступило к now, и откладывает их следующую попытку до leaseUntil,
+	// чтобы другие реплики не отправили их одновременно
+	ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]model.WebhookDelivery, error)
+	// GetDelivery возвращает model.ErrWebhookDeliveryNotFound, если доставки нет
+	GetDelivery(ctx context.Context, deliveryUUID uuid.UUID) (model.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery model.WebhookDelivery) error
+	// ListDeadDeliveries возвращает доставки подписки в статусе DEAD в порядке создания
+	ListDeadDeliveries(ctx context.Context, webhookUUID uuid.UUID) ([]model.WebhookDelivery, error)
+}
+
+type SagaRepository interface {
+	// Create сохраняет новую сагу с версией 1. Если у заказа уже есть
+	// незавершенная сага возврата, новая сага возврата не сохраняется
+	// и возвращается model.ErrRefundInProgress.
+	Create(ctx context.Context, saga model.Saga) error
+	// Update сохраняет сагу, только если ее версия в хранилище совпадает
+	// с saga.Version, и увеличивает версию на единицу. Иначе возвращает
+	// model.ErrSagaConflict.
+	Update(ctx context.Context, saga model.Saga) error
+	// ListUnfinished возвращает до limit саг в статусах RUNNING и COMPENSATING,
+	// которые не изменялись позже updatedBefore, начиная с самых старых
+	ListUnfinished(ctx context.Context, updatedBefore time.Time, limit int) ([]model.Saga, error)
+}
+
+type IdempotencyRepository interface {
+	// Reserve сохраняет незавершенную запись, если записи с такими операцией и ключом
+	// еще нет или ее срок хранения истек. Иначе возвращает существующую запись
+	// и reserved = false.
+	Reserve(ctx context.Context, record model.IdempotencyRecord) (existing model.IdempotencyRecord, reserved bool, err error)
+	// Complete сохраняет результат операции
+	Complete(ctx context.Context, operation, key string, response []byte) error
+	// Release удаляет незавершенную запись, чтобы запрос можно было повторить
+	Release(ctx context.Context, operation, key string) error
+	// DeleteExpired удаляет до limit записей, срок хранения которых истек к moment,
+	// и возвращает число удаленных
+	DeleteExpired(ctx context.Context, moment time.Time, limit int) (int, error)
 }
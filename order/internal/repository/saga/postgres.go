@@ -0,0 +1,163 @@
+package saga
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	def "github.com/xgmsx/rsf/order/internal/repository"
+)
+
+var _ def.SagaRepository = (*postgresSagaRepository)(nil)
+
+const sagaColumns = `saga_uuid, type, order_uuid, user_uuid, status, step, payment_method, amount, currency, transaction_uuid, last_error, reason, refunded_before, version, created_at, updated_at`
+
+type postgresSagaRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresSagaRepository(pool *pgxpool.Pool) *postgresSagaRepository {
+	return &postgresSagaRepository{pool: pool}
+}
+
+func (r *postgresSagaRepository) Create(ctx context.Context, saga model.Saga) error {
+	// Конфликт возможен только по уникальному индексу незавершенных возвратов:
+	// UUID саги генерируется заново для каждой саги
+	tag, err := r.pool.Exec(ctx, `
+		INSERT INTO sagas (`+sagaColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, 1, $14, $15)
+		ON CONFLICT DO NOTHING`,
+		saga.SagaUUID,
+		string(saga.Type),
+		saga.OrderUUID,
+		saga.UserUUID,
+		string(saga.Status),
+		string(saga.Step),
+		paymentMethodToNullString(saga.PaymentMethod),
+		saga.Amount.Amount,
+		saga.Amount.Currency,
+		saga.TransactionUUID,
+		saga.LastError,
+		saga.Reason,
+		saga.RefundedBefore.Amount,
+		saga.CreatedAt,
+		saga.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert saga: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrRefundInProgress
+	}
+	return nil
+}
+
+func (r *postgresSagaRepository) Update(ctx context.Context, saga model.Saga) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `
+			UPDATE sagas SET
+				status           = $3,
+				step             = $4,
+				transaction_uuid = $5,
+				last_error       = $6,
+				updated_at       = $7,
+				version          = version + 1
+			WHERE saga_uuid = $1 AND version = $2`,
+			saga.SagaUUID,
+			saga.Version,
+			string(saga.Status),
+			string(saga.Step),
+			saga.TransactionUUID,
+			saga.LastError,
+			saga.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update saga: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			var exists bool
+			err = tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM sagas WHERE saga_uuid = $1)`, saga.SagaUUID).Scan(&exists)
+			if err != nil {
+				return fmt.Errorf("failed to check saga existence: %w", err)
+			}
+			if !exists {
+				return model.ErrSagaNotFound
+			}
+			return model.ErrSagaConflict
+		}
+		return nil
+	})
+}
+
+func (r *postgresSagaRepository) ListUnfinished(ctx context.Context, updatedBefore time.Time, limit int) ([]model.Saga, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+sagaColumns+` FROM sagas
+		WHERE status IN ($1, $2) AND updated_at <= $3
+		ORDER BY updated_at
+		LIMIT $4`,
+		string(model.SagaStatusRUNNING), string(model.SagaStatusCOMPENSATING), updatedBefore, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select unfinished sagas: %w", err)
+	}
+	sagas, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Saga, error) {
+		return scanSaga(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan unfinished sagas: %w", err)
+	}
+	return sagas, nil
+}
+
+func scanSaga(row pgx.Row) (model.Saga, error) {
+	var (
+		saga          model.Saga
+		paymentMethod *string
+	)
+	err := row.Scan(
+		&saga.SagaUUID,
+		&saga.Type,
+		&saga.OrderUUID,
+		&saga.UserUUID,
+		&saga.Status,
+		&saga.Step,
+		&paymentMethod,
+		&saga.Amount.Amount,
+		&saga.Amount.Currency,
+		&saga.TransactionUUID,
+		&saga.LastError,
+		&saga.Reason,
+		&saga.RefundedBefore.Amount,
+		&saga.Version,
+		&saga.CreatedAt,
+		&saga.UpdatedAt,
+	)
+	if err != nil {
+		return model.Saga{}, err
+	}
+
+	if paymentMethod != nil {
+		pm := model.PaymentMethod(*paymentMethod)
+		saga.PaymentMethod = &pm
+	}
+	if saga.Type == model.SagaTypeRefundOrder {
+		// Сумма возвратов хранится в валюте саги
+		saga.RefundedBefore.Currency = saga.Amount.Currency
+	}
+	saga.CreatedAt = saga.CreatedAt.UTC()
+	saga.UpdatedAt = saga.UpdatedAt.UTC()
+
+	return saga, nil
+}
+
+func paymentMethodToNullString(pm *model.PaymentMethod) *string {
+	if pm == nil {
+		return nil
+	}
+	s := string(*pm)
+	return &s
+}
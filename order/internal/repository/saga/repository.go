@@ -0,0 +1,86 @@
+package saga
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	def "github.com/xgmsx/rsf/order/internal/repository"
+)
+
+var _ def.SagaRepository = (*sagaRepository)(nil)
+
+type sagaRepository struct {
+	mu    sync.RWMutex
+	sagas map[string]*model.Saga
+}
+
+func NewSagaRepository() *sagaRepository {
+	return &sagaRepository{
+		sagas: make(map[string]*model.Saga),
+	}
+}
+
+func (r *sagaRepository) Create(_ context.Context, saga model.Saga) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sagas[saga.SagaUUID.String()]; ok {
+		return fmt.Errorf("saga %s already exists", saga.SagaUUID)
+	}
+	if saga.Type == model.SagaTypeRefundOrder {
+		for _, stored := range r.sagas {
+			if stored.Type == model.SagaTypeRefundOrder && stored.OrderUUID == saga.OrderUUID && !stored.IsFinished() {
+				return model.ErrRefundInProgress
+			}
+		}
+	}
+
+	saga.Version = 1
+	r.save(saga)
+	return nil
+}
+
+func (r *sagaRepository) Update(_ context.Context, saga model.Saga) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.sagas[saga.SagaUUID.String()]
+	if !ok {
+		return model.ErrSagaNotFound
+	}
+	if stored.Version != saga.Version {
+		return model.ErrSagaConflict
+	}
+
+	saga.Version++
+	r.save(saga)
+	return nil
+}
+
+func (r *sagaRepository) ListUnfinished(_ context.Context, updatedBefore time.Time, limit int) ([]model.Saga, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []model.Saga
+	for _, saga := range r.sagas {
+		if !saga.IsFinished() && !saga.UpdatedAt.After(updatedBefore) {
+			result = append(result, *saga)
+		}
+	}
+
+	slices.SortFunc(result, func(a, b model.Saga) int {
+		return a.UpdatedAt.Compare(b.UpdatedAt)
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (r *sagaRepository) save(saga model.Saga) {
+	r.sagas[saga.SagaUUID.String()] = &saga
+}
@@ -0,0 +1,111 @@
+package saga
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/repository"
+	"github.com/xgmsx/rsf/order/internal/utils"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+)
+
+func testSagas(t *testing.T, repo repository.SagaRepository) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newSaga := func(status model.SagaStatus, updatedAt time.Time) model.Saga {
+		saga := model.Saga{
+			SagaUUID:      uuid.New(),
+			Type:          model.SagaTypePayOrder,
+			OrderUUID:     uuid.New(),
+			UserUUID:      uuid.New(),
+			Status:        status,
+			Step:          model.SagaStepCharge,
+			PaymentMethod: utils.ToPtr(model.PaymentMethodCARD),
+			Amount:        money.New(12346, "RUB"),
+			CreatedAt:     updatedAt,
+			UpdatedAt:     updatedAt,
+		}
+		require.NoError(t, repo.Create(ctx, saga))
+		saga.Version = 1
+		return saga
+	}
+
+	runningLater := newSaga(model.SagaStatusRUNNING, now.Add(-time.Minute))
+	compensating := newSaga(model.SagaStatusCOMPENSATING, now.Add(-time.Hour))
+	newSaga(model.SagaStatusRUNNING, now.Add(time.Minute))
+	completed := newSaga(model.SagaStatusRUNNING, now.Add(-2*time.Hour))
+
+	t.Run("Update with current version", func(t *testing.T) {
+		completed.Status = model.SagaStatusCOMPLETED
+		completed.Step = model.SagaStepConfirm
+		completed.TransactionUUID = utils.ToPtr(uuid.New())
+		require.NoError(t, repo.Update(ctx, completed))
+		completed.Version = 2
+	})
+
+	t.Run("Update with stale version", func(t *testing.T) {
+		stale := runningLater
+		stale.Version = 0
+		require.ErrorIs(t, repo.Update(ctx, stale), model.ErrSagaConflict)
+	})
+
+	t.Run("Update missing saga", func(t *testing.T) {
+		missing := runningLater
+		missing.SagaUUID = uuid.New()
+		require.ErrorIs(t, repo.Update(ctx, missing), model.ErrSagaNotFound)
+	})
+
+	t.Run("List unfinished sagas, oldest first", func(t *testing.T) {
+		got, err := repo.ListUnfinished(ctx, now, 100)
+		require.NoError(t, err)
+
+		// Оставляем только саги этого теста, в postgres могут быть чужие саги
+		var result []model.Saga
+		for _, saga := range got {
+			switch saga.SagaUUID {
+			case runningLater.SagaUUID, compensating.SagaUUID, completed.SagaUUID:
+				result = append(result, saga)
+			}
+		}
+		require.Equal(t, []model.Saga{compensating, runningLater}, result)
+	})
+
+	t.Run("Only one unfinished refund per order", func(t *testing.T) {
+		newRefund := func(orderUUID uuid.UUID) model.Saga {
+			return model.Saga{
+				SagaUUID:        uuid.New(),
+				Type:            model.SagaTypeRefundOrder,
+				OrderUUID:       orderUUID,
+				UserUUID:        uuid.New(),
+				Status:          model.SagaStatusRUNNING,
+				Step:            model.SagaStepRefund,
+				Amount:          money.New(2500, "RUB"),
+				TransactionUUID: utils.ToPtr(uuid.New()),
+				Reason:          "damaged",
+				RefundedBefore:  money.New(1000, "RUB"),
+				CreatedAt:       now,
+				UpdatedAt:       now,
+			}
+		}
+
+		first := newRefund(uuid.New())
+		require.NoError(t, repo.Create(ctx, first))
+		require.ErrorIs(t, repo.Create(ctx, newRefund(first.OrderUUID)), model.ErrRefundInProgress)
+		require.NoError(t, repo.Create(ctx, newRefund(uuid.New())))
+
+		first.Status = model.SagaStatusCOMPLETED
+		first.Version = 1
+		require.NoError(t, repo.Update(ctx, first))
+		require.NoError(t, repo.Create(ctx, newRefund(first.OrderUUID)))
+	})
+}
+
+func TestSagaRepository(t *testing.T) {
+	testSagas(t, NewSagaRepository())
+}
@@ -0,0 +1,275 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	def "github.com/xgmsx/rsf/order/internal/repository"
+)
+
+var _ def.WebhookRepository = (*postgresWebhookRepository)(nil)
+
+const (
+	subscriptionColumns = `webhook_uuid, url, event_types, secret, created_at, updated_at`
+	deliveryColumns     = `delivery_uuid, webhook_uuid, event_uuid, event_type, payload, status, attempts, next_attempt_at, last_error, created_at, delivered_at`
+)
+
+type postgresWebhookRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresWebhookRepository(pool *pgxpool.Pool) *postgresWebhookRepository {
+	return &postgresWebhookRepository{pool: pool}
+}
+
+func (r *postgresWebhookRepository) CreateSubscription(ctx context.Context, subscription model.WebhookSubscription) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO webhook_subscriptions (`+subscriptionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		subscription.WebhookUUID,
+		subscription.URL,
+		eventTypesToStrings(subscription.EventTypes),
+		subscription.Secret,
+		subscription.CreatedAt,
+		subscription.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert webhook subscription: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresWebhookRepository) GetSubscription(ctx context.Context, webhookUUID uuid.UUID) (model.WebhookSubscription, error) {
+	subscription, err := scanSubscription(r.pool.QueryRow(ctx,
+		`SELECT `+subscriptionColumns+` FROM webhook_subscriptions WHERE webhook_uuid = $1`, webhookUUID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.WebhookSubscription{}, model.ErrWebhookNotFound
+		}
+		return model.WebhookSubscription{}, fmt.Errorf("failed to select webhook subscription: %w", err)
+	}
+	return subscription, nil
+}
+
+func (r *postgresWebhookRepository) ListSubscriptions(ctx context.Context) ([]model.WebhookSubscription, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT `+subscriptionColumns+` FROM webhook_subscriptions ORDER BY created_at, webhook_uuid`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select webhook subscriptions: %w", err)
+	}
+	subscriptions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.WebhookSubscription, error) {
+		return scanSubscription(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan webhook subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+func (r *postgresWebhookRepository) UpdateSubscription(ctx context.Context, subscription model.WebhookSubscription) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE webhook_subscriptions SET
+			url         = $2,
+			event_types = $3,
+			secret      = $4,
+			updated_at  = $5
+		WHERE webhook_uuid = $1`,
+		subscription.WebhookUUID,
+		subscription.URL,
+		eventTypesToStrings(subscription.EventTypes),
+		subscription.Secret,
+		subscription.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *postgresWebhookRepository) DeleteSubscription(ctx context.Context, webhookUUID uuid.UUID) error {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM webhook_subscriptions WHERE webhook_uuid = $1`, webhookUUID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *postgresWebhookRepository) CreateDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, d := range deliveries {
+		batch.Queue(`
+			INSERT INTO webhook_deliveries (`+deliveryColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			ON CONFLICT (webhook_uuid, event_uuid) DO NOTHING`,
+			d.DeliveryUUID, d.WebhookUUID, d.EventUUID, string(d.EventType), d.Payload, string(d.Status),
+			d.Attempts, d.NextAttemptAt, d.LastError, d.CreatedAt, d.DeliveredAt,
+		)
+	}
+	err := r.pool.SendBatch(ctx, batch).Close()
+	if err != nil {
+		return fmt.Errorf("failed to insert webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresWebhookRepository) ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]model.WebhookDelivery, error) {
+	rows, err := r.pool.Query(ctx, `
+		UPDATE webhook_deliveries SET next_attempt_at = $3
+		WHERE delivery_uuid IN (
+			SELECT delivery_uuid FROM webhook_deliveries
+			WHERE status = $1 AND next_attempt_at <= $2
+			ORDER BY next_attempt_at
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+deliveryColumns,
+		string(model.WebhookDeliveryStatusPENDING), now, leaseUntil, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	deliveries, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.WebhookDelivery, error) {
+		return scanDelivery(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+func (r *postgresWebhookRepository) GetDelivery(ctx context.Context, deliveryUUID uuid.UUID) (model.WebhookDelivery, error) {
+	delivery, err := scanDelivery(r.pool.QueryRow(ctx,
+		`SELECT `+deliveryColumns+` FROM webhook_deliveries WHERE delivery_uuid = $1`, deliveryUUID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.WebhookDelivery{}, model.ErrWebhookDeliveryNotFound
+		}
+		return model.WebhookDelivery{}, fmt.Errorf("failed to select webhook delivery: %w", err)
+	}
+	return delivery, nil
+}
+
+func (r *postgresWebhookRepository) UpdateDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE webhook_deliveries SET
+			status          = $2,
+			attempts        = $3,
+			next_attempt_at = $4,
+			last_error      = $5,
+			delivered_at    = $6
+		WHERE delivery_uuid = $1`,
+		delivery.DeliveryUUID,
+		string(delivery.Status),
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.LastError,
+		delivery.DeliveredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrWebhookDeliveryNotFound
+	}
+	return nil
+}
+
+func (r *postgresWebhookRepository) ListDeadDeliveries(ctx context.Context, webhookUUID uuid.UUID) ([]model.WebhookDelivery, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+deliveryColumns+` FROM webhook_deliveries
+		WHERE webhook_uuid = $1 AND status = $2
+		ORDER BY created_at, delivery_uuid`,
+		webhookUUID, string(model.WebhookDeliveryStatusDEAD),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select dead webhook deliveries: %w", err)
+	}
+	deliveries, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.WebhookDelivery, error) {
+		return scanDelivery(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan dead webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+func scanSubscription(row pgx.Row) (model.WebhookSubscription, error) {
+	var (
+		subscription model.WebhookSubscription
+		eventTypes   []string
+	)
+	err := row.Scan(
+		&subscription.WebhookUUID,
+		&subscription.URL,
+		&eventTypes,
+		&subscription.Secret,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+	)
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	subscription.EventTypes = make([]model.WebhookEventType, len(eventTypes))
+	for i, eventType := range eventTypes {
+		subscription.EventTypes[i] = model.WebhookEventType(eventType)
+	}
+	subscription.CreatedAt = subscription.CreatedAt.UTC()
+	subscription.UpdatedAt = subscription.UpdatedAt.UTC()
+
+	return subscription, nil
+}
+
+func scanDelivery(row pgx.Row) (model.WebhookDelivery, error) {
+	var delivery model.WebhookDelivery
+	err := row.Scan(
+		&delivery.DeliveryUUID,
+		&delivery.WebhookUUID,
+		&delivery.EventUUID,
+		&delivery.EventType,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&delivery.LastError,
+		&delivery.CreatedAt,
+		&delivery.DeliveredAt,
+	)
+	if err != nil {
+		return model.WebhookDelivery{}, err
+	}
+
+	delivery.NextAttemptAt = delivery.NextAttemptAt.UTC()
+	delivery.CreatedAt = delivery.CreatedAt.UTC()
+	if delivery.DeliveredAt != nil {
+		deliveredAt := delivery.DeliveredAt.UTC()
+		delivery.DeliveredAt = &deliveredAt
+	}
+
+	return delivery, nil
+}
+
+func eventTypesToStrings(eventTypes []model.WebhookEventType) []string {
+	result := make([]string, len(eventTypes))
+	for i, eventType := range eventTypes {
+		result[i] = string(eventType)
+	}
+	return result
+}
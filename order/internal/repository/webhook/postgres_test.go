@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgmsx/rsf/order/internal/migrator"
+	"github.com/xgmsx/rsf/order/migrations"
+)
+
+// newTestPostgresRepository подключается к PostgreSQL из ORDER_TEST_POSTGRES_DSN
+// (например, к контейнеру postgres-order из docker-compose) и применяет миграции.
+func newTestPostgresRepository(t *testing.T) *postgresWebhookRepository {
+	t.Helper()
+
+	dsn := os.Getenv("ORDER_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("ORDER_TEST_POSTGRES_DSN is not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	db := stdlib.OpenDBFromPool(pool)
+	t.Cleanup(func() { _ = db.Close() })
+
+	m, err := migrator.NewMigrator(db, migrations.FS)
+	require.NoError(t, err)
+	require.NoError(t, m.Up(ctx))
+
+	return NewPostgresWebhookRepository(pool)
+}
+
+func TestPostgresWebhookRepositorySubscriptions(t *testing.T) {
+	testSubscriptions(t, newTestPostgresRepository(t))
+}
+
+func TestPostgresWebhookRepositoryDeliveries(t *testing.T) {
+	testDeliveries(t, newTestPostgresRepository(t))
+}
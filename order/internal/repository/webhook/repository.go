@@ -0,0 +1,184 @@
+package webhook
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	def "github.com/xgmsx/rsf/order/internal/repository"
+)
+
+var _ def.WebhookRepository = (*webhookRepository)(nil)
+
+type webhookRepository struct {
+	mu            sync.RWMutex
+	subscriptions map[uuid.UUID]model.WebhookSubscription
+	deliveries    map[uuid.UUID]model.WebhookDelivery
+}
+
+func NewWebhookRepository() *webhookRepository {
+	return &webhookRepository{
+		subscriptions: make(map[uuid.UUID]model.WebhookSubscription),
+		deliveries:    make(map[uuid.UUID]model.WebhookDelivery),
+	}
+}
+
+func (r *webhookRepository) CreateSubscription(_ context.Context, subscription model.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	subscription.EventTypes = slices.Clone(subscription.EventTypes)
+	r.subscriptions[subscription.WebhookUUID] = subscription
+	return nil
+}
+
+func (r *webhookRepository) GetSubscription(_ context.Context, webhookUUID uuid.UUID) (model.WebhookSubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	subscription, ok := r.subscriptions[webhookUUID]
+	if !ok {
+		return model.WebhookSubscription{}, model.ErrWebhookNotFound
+	}
+	subscription.EventTypes = slices.Clone(subscription.EventTypes)
+	return subscription, nil
+}
+
+func (r *webhookRepository) ListSubscriptions(_ context.Context) ([]model.WebhookSubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]model.WebhookSubscription, 0, len(r.subscriptions))
+	for _, subscription := range r.subscriptions {
+		subscription.EventTypes = slices.Clone(subscription.EventTypes)
+		result = append(result, subscription)
+	}
+	slices.SortFunc(result, func(a, b model.WebhookSubscription) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return slices.Compare(a.WebhookUUID[:], b.WebhookUUID[:])
+	})
+	return result, nil
+}
+
+func (r *webhookRepository) UpdateSubscription(_ context.Context, subscription model.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.subscriptions[subscription.WebhookUUID]; !ok {
+		return model.ErrWebhookNotFound
+	}
+	subscription.EventTypes = slices.Clone(subscription.EventTypes)
+	r.subscriptions[subscription.WebhookUUID] = subscription
+	return nil
+}
+
+func (r *webhookRepository) DeleteSubscription(_ context.Context, webhookUUID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.subscriptions[webhookUUID]; !ok {
+		return model.ErrWebhookNotFound
+	}
+	delete(r.subscriptions, webhookUUID)
+	for id, delivery := range r.deliveries {
+		if delivery.WebhookUUID == webhookUUID {
+			delete(r.deliveries, id)
+		}
+	}
+	return nil
+}
+
+func (r *webhookRepository) CreateDeliveries(_ context.Context, deliveries []model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, delivery := range deliveries {
+		if r.hasDeliveryLocked(delivery.WebhookUUID, delivery.EventUUID) {
+			continue
+		}
+		r.deliveries[delivery.DeliveryUUID] = delivery
+	}
+	return nil
+}
+
+func (r *webhookRepository) hasDeliveryLocked(webhookUUID, eventUUID uuid.UUID) bool {
+	for _, delivery := range r.deliveries {
+		if delivery.WebhookUUID == webhookUUID && delivery.EventUUID == eventUUID {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *webhookRepository) ClaimDueDeliveries(_ context.Context, now, leaseUntil time.Time, limit int) ([]model.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var due []model.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.Status == model.WebhookDeliveryStatusPENDING && !delivery.NextAttemptAt.After(now) {
+			due = append(due, delivery)
+		}
+	}
+	slices.SortFunc(due, func(a, b model.WebhookDelivery) int {
+		return a.NextAttemptAt.Compare(b.NextAttemptAt)
+	})
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+
+	for i := range due {
+		due[i].NextAttemptAt = leaseUntil
+		r.deliveries[due[i].DeliveryUUID] = due[i]
+	}
+	return due, nil
+}
+
+func (r *webhookRepository) GetDelivery(_ context.Context, deliveryUUID uuid.UUID) (model.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	delivery, ok := r.deliveries[deliveryUUID]
+	if !ok {
+		return model.WebhookDelivery{}, model.ErrWebhookDeliveryNotFound
+	}
+	return delivery, nil
+}
+
+func (r *webhookRepository) UpdateDelivery(_ context.Context, delivery model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.deliveries[delivery.DeliveryUUID]; !ok {
+		return model.ErrWebhookDeliveryNotFound
+	}
+	r.deliveries[delivery.DeliveryUUID] = delivery
+	return nil
+}
+
+func (r *webhookRepository) ListDeadDeliveries(_ context.Context, webhookUUID uuid.UUID) ([]model.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := []model.WebhookDelivery{}
+	for _, delivery := range r.deliveries {
+		if delivery.WebhookUUID == webhookUUID && delivery.Status == model.WebhookDeliveryStatusDEAD {
+			result = append(result, delivery)
+		}
+	}
+	slices.SortFunc(result, compareDeliveries)
+	return result, nil
+}
+
+func compareDeliveries(a, b model.WebhookDelivery) int {
+	if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+		return c
+	}
+	return slices.Compare(a.DeliveryUUID[:], b.DeliveryUUID[:])
+}
@@ -0,0 +1,131 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/repository"
+)
+
+func newSubscription(createdAt time.Time) model.WebhookSubscription {
+	return model.WebhookSubscription{
+		WebhookUUID: uuid.New(),
+		URL:         "https://erp.example.com/hooks/orders",
+		EventTypes:  []model.WebhookEventType{model.WebhookEventOrderPaid},
+		Secret:      "whsec_test",
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+}
+
+func newDelivery(webhookUUID uuid.UUID, createdAt time.Time) model.WebhookDelivery {
+	return model.WebhookDelivery{
+		DeliveryUUID:  uuid.New(),
+		WebhookUUID:   webhookUUID,
+		EventUUID:     uuid.New(),
+		EventType:     model.WebhookEventOrderPaid,
+		Payload:       []byte(`{"type":"order.paid"}`),
+		Status:        model.WebhookDeliveryStatusPENDING,
+		NextAttemptAt: createdAt,
+		CreatedAt:     createdAt,
+	}
+}
+
+func testSubscriptions(t *testing.T, repo repository.WebhookRepository) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	subscription := newSubscription(createdAt)
+	require.NoError(t, repo.CreateSubscription(ctx, subscription))
+
+	got, err := repo.GetSubscription(ctx, subscription.WebhookUUID)
+	require.NoError(t, err)
+	require.Equal(t, subscription, got)
+
+	subscription.URL = "https://erp.example.com/hooks/v2"
+	subscription.EventTypes = []model.WebhookEventType{model.WebhookEventOrderCreated, model.WebhookEventOrderCancelled}
+	subscription.Secret = "whsec_rotated"
+	subscription.UpdatedAt = createdAt.Add(time.Hour)
+	require.NoError(t, repo.UpdateSubscription(ctx, subscription))
+
+	got, err = repo.GetSubscription(ctx, subscription.WebhookUUID)
+	require.NoError(t, err)
+	require.Equal(t, subscription, got)
+
+	list, err := repo.ListSubscriptions(ctx)
+	require.NoError(t, err)
+	require.Contains(t, list, subscription)
+
+	require.NoError(t, repo.DeleteSubscription(ctx, subscription.WebhookUUID))
+	_, err = repo.GetSubscription(ctx, subscription.WebhookUUID)
+	require.ErrorIs(t, err, model.ErrWebhookNotFound)
+	require.ErrorIs(t, repo.DeleteSubscription(ctx, subscription.WebhookUUID), model.ErrWebhookNotFound)
+	require.ErrorIs(t, repo.UpdateSubscription(ctx, subscription), model.ErrWebhookNotFound)
+}
+
+func TestWebhookRepositorySubscriptions(t *testing.T) {
+	testSubscriptions(t, NewWebhookRepository())
+}
+
+func testDeliveries(t *testing.T, repo repository.WebhookRepository) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	subscription := newSubscription(now)
+	require.NoError(t, repo.CreateSubscription(ctx, subscription))
+
+	due := newDelivery(subscription.WebhookUUID, now.Add(-time.Minute))
+	later := newDelivery(subscription.WebhookUUID, now)
+	later.NextAttemptAt = now.Add(time.Hour)
+
+	// Повторная доставка того же события той же подписке пропускается
+	duplicate := newDelivery(subscription.WebhookUUID, now)
+	duplicate.EventUUID = due.EventUUID
+	require.NoError(t, repo.CreateDeliveries(ctx, []model.WebhookDelivery{due, later}))
+	require.NoError(t, repo.CreateDeliveries(ctx, []model.WebhookDelivery{duplicate}))
+	_, err := repo.GetDelivery(ctx, duplicate.DeliveryUUID)
+	require.ErrorIs(t, err, model.ErrWebhookDeliveryNotFound)
+
+	// Оставляем только доставки этого теста, в postgres могут быть чужие
+	claim := func() []uuid.UUID {
+		claimed, err := repo.ClaimDueDeliveries(ctx, now, now.Add(time.Minute), 1000)
+		require.NoError(t, err)
+		var ids []uuid.UUID
+		for _, delivery := range claimed {
+			if delivery.WebhookUUID == subscription.WebhookUUID {
+				require.Equal(t, now.Add(time.Minute), delivery.NextAttemptAt)
+				ids = append(ids, delivery.DeliveryUUID)
+			}
+		}
+		return ids
+	}
+	require.Equal(t, []uuid.UUID{due.DeliveryUUID}, claim())
+	require.Empty(t, claim(), "claimed delivery is leased")
+
+	deliveredAt := now.Add(time.Second)
+	delivered, err := repo.GetDelivery(ctx, due.DeliveryUUID)
+	require.NoError(t, err)
+	delivered.Status = model.WebhookDeliveryStatusDEAD
+	delivered.Attempts = 8
+	delivered.LastError = "receiver returned 500"
+	delivered.DeliveredAt = &deliveredAt
+	require.NoError(t, repo.UpdateDelivery(ctx, delivered))
+
+	dead, err := repo.ListDeadDeliveries(ctx, subscription.WebhookUUID)
+	require.NoError(t, err)
+	require.Equal(t, []model.WebhookDelivery{delivered}, dead)
+
+	require.NoError(t, repo.DeleteSubscription(ctx, subscription.WebhookUUID))
+	_, err = repo.GetDelivery(ctx, later.DeliveryUUID)
+	require.ErrorIs(t, err, model.ErrWebhookDeliveryNotFound)
+	require.ErrorIs(t, repo.UpdateDelivery(ctx, delivered), model.ErrWebhookDeliveryNotFound)
+}
+
+func TestWebhookRepositoryDeliveries(t *testing.T) {
+	testDeliveries(t, NewWebhookRepository())
+}
@@ -136,6 +136,118 @@ func (_c *OrderService_CreateOrder_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// DeleteExpiredIdempotencyKeys provides a mock function with given fields: ctx
+func (_m *OrderService) DeleteExpiredIdempotencyKeys(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredIdempotencyKeys")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_DeleteExpiredIdempotencyKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredIdempotencyKeys'
+type OrderService_DeleteExpiredIdempotencyKeys_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredIdempotencyKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrderService_Expecter) DeleteExpiredIdempotencyKeys(ctx interface{}) *OrderService_DeleteExpiredIdempotencyKeys_Call {
+	return &OrderService_DeleteExpiredIdempotencyKeys_Call{Call: _e.mock.On("DeleteExpiredIdempotencyKeys", ctx)}
+}
+
+func (_c *OrderService_DeleteExpiredIdempotencyKeys_Call) Run(run func(ctx context.Context)) *OrderService_DeleteExpiredIdempotencyKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrderService_DeleteExpiredIdempotencyKeys_Call) Return(_a0 int, _a1 error) *OrderService_DeleteExpiredIdempotencyKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_DeleteExpiredIdempotencyKeys_Call) RunAndReturn(run func(context.Context) (int, error)) *OrderService_DeleteExpiredIdempotencyKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireOverdueOrders provides a mock function with given fields: ctx
+func (_m *OrderService) ExpireOverdueOrders(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireOverdueOrders")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_ExpireOverdueOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireOverdueOrders'
+type OrderService_ExpireOverdueOrders_Call struct {
+	*mock.Call
+}
+
+// ExpireOverdueOrders is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrderService_Expecter) ExpireOverdueOrders(ctx interface{}) *OrderService_ExpireOverdueOrders_Call {
+	return &OrderService_ExpireOverdueOrders_Call{Call: _e.mock.On("ExpireOverdueOrders", ctx)}
+}
+
+func (_c *OrderService_ExpireOverdueOrders_Call) Run(run func(ctx context.Context)) *OrderService_ExpireOverdueOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrderService_ExpireOverdueOrders_Call) Return(_a0 int, _a1 error) *OrderService_ExpireOverdueOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_ExpireOverdueOrders_Call) RunAndReturn(run func(context.Context) (int, error)) *OrderService_ExpireOverdueOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOrder provides a mock function with given fields: ctx, orderUUID
 func (_m *OrderService) GetOrder(ctx context.Context, orderUUID string) (model.Order, error) {
 	ret := _m.Called(ctx, orderUUID)
@@ -193,6 +305,122 @@ func (_c *OrderService_GetOrder_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// GetOrderStatusHistory provides a mock function with given fields: ctx, orderUUID
+func (_m *OrderService) GetOrderStatusHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusChange, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderStatusHistory")
+	}
+
+	var r0 []model.OrderStatusChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.OrderStatusChange, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.OrderStatusChange); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrderStatusChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_GetOrderStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderStatusHistory'
+type OrderService_GetOrderStatusHistory_Call struct {
+	*mock.Call
+}
+
+// GetOrderStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *OrderService_Expecter) GetOrderStatusHistory(ctx interface{}, orderUUID interface{}) *OrderService_GetOrderStatusHistory_Call {
+	return &OrderService_GetOrderStatusHistory_Call{Call: _e.mock.On("GetOrderStatusHistory", ctx, orderUUID)}
+}
+
+func (_c *OrderService_GetOrderStatusHistory_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderService_GetOrderStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderService_GetOrderStatusHistory_Call) Return(_a0 []model.OrderStatusChange, _a1 error) *OrderService_GetOrderStatusHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_GetOrderStatusHistory_Call) RunAndReturn(run func(context.Context, string) ([]model.OrderStatusChange, error)) *OrderService_GetOrderStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function with given fields: ctx, input
+func (_m *OrderService) ListOrders(ctx context.Context, input model.ListOrdersInput) (model.ListOrdersOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 model.ListOrdersOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListOrdersInput) (model.ListOrdersOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListOrdersInput) model.ListOrdersOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(model.ListOrdersOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListOrdersInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type OrderService_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input model.ListOrdersInput
+func (_e *OrderService_Expecter) ListOrders(ctx interface{}, input interface{}) *OrderService_ListOrders_Call {
+	return &OrderService_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, input)}
+}
+
+func (_c *OrderService_ListOrders_Call) Run(run func(ctx context.Context, input model.ListOrdersInput)) *OrderService_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.ListOrdersInput))
+	})
+	return _c
+}
+
+func (_c *OrderService_ListOrders_Call) Return(_a0 model.ListOrdersOutput, _a1 error) *OrderService_ListOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_ListOrders_Call) RunAndReturn(run func(context.Context, model.ListOrdersInput) (model.ListOrdersOutput, error)) *OrderService_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PayOrder provides a mock function with given fields: ctx, request
 func (_m *OrderService) PayOrder(ctx context.Context, request model.PayOrderInput) (model.PayOrderOutput, error) {
 	ret := _m.Called(ctx, request)
@@ -250,6 +478,119 @@ func (_c *OrderService_PayOrder_Call) RunAndReturn(run func(context.Context, mod
 	return _c
 }
 
+// RecoverSagas provides a mock function with given fields: ctx
+func (_m *OrderService) RecoverSagas(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecoverSagas")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_RecoverSagas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecoverSagas'
+type OrderService_RecoverSagas_Call struct {
+	*mock.Call
+}
+
+// RecoverSagas is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OrderService_Expecter) RecoverSagas(ctx interface{}) *OrderService_RecoverSagas_Call {
+	return &OrderService_RecoverSagas_Call{Call: _e.mock.On("RecoverSagas", ctx)}
+}
+
+func (_c *OrderService_RecoverSagas_Call) Run(run func(ctx context.Context)) *OrderService_RecoverSagas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OrderService_RecoverSagas_Call) Return(_a0 int, _a1 error) *OrderService_RecoverSagas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_RecoverSagas_Call) RunAndReturn(run func(context.Context) (int, error)) *OrderService_RecoverSagas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundOrder provides a mock function with given fields: ctx, input
+func (_m *OrderService) RefundOrder(ctx context.Context, input model.RefundOrderInput) (model.RefundOrderOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundOrder")
+	}
+
+	var r0 model.RefundOrderOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefundOrderInput) (model.RefundOrderOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefundOrderInput) model.RefundOrderOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(model.RefundOrderOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.RefundOrderInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_RefundOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundOrder'
+type OrderService_RefundOrder_Call struct {
+	*mock.Call
+}
+
+// RefundOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input model.RefundOrderInput
+func (_e *OrderService_Expecter) RefundOrder(ctx interface{}, input interface{}) *OrderService_RefundOrder_Call {
+	return &OrderService_RefundOrder_Call{Call: _e.mock.On("RefundOrder", ctx, input)}
+}
+
+func (_c *OrderService_RefundOrder_Call) Run(run func(ctx context.Context, input model.RefundOrderInput)) *OrderService_RefundOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.RefundOrderInput))
+	})
+	return _c
+}
+
+func (_c *OrderService_RefundOrder_Call) Return(_a0 model.RefundOrderOutput, _a1 error) *OrderService_RefundOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_RefundOrder_Call) RunAndReturn(run func(context.Context, model.RefundOrderInput) (model.RefundOrderOutput, error)) *OrderService_RefundOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOrderService creates a new instance of OrderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOrderService(t interface {
@@ -0,0 +1,593 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/xgmsx/rsf/order/internal/model"
+
+	uuid "github.com/google/uuid"
+)
+
+// WebhookService is an autogenerated mock type for the WebhookService type
+type WebhookService struct {
+	mock.Mock
+}
+
+type WebhookService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookService) EXPECT() *WebhookService_Expecter {
+	return &WebhookService_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function with given fields: ctx, input
+func (_m *WebhookService) CreateWebhook(ctx context.Context, input model.WebhookInput) (model.WebhookSubscription, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 model.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.WebhookInput) (model.WebhookSubscription, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.WebhookInput) model.WebhookSubscription); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(model.WebhookSubscription)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.WebhookInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type WebhookService_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input model.WebhookInput
+func (_e *WebhookService_Expecter) CreateWebhook(ctx interface{}, input interface{}) *WebhookService_CreateWebhook_Call {
+	return &WebhookService_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, input)}
+}
+
+func (_c *WebhookService_CreateWebhook_Call) Run(run func(ctx context.Context, input model.WebhookInput)) *WebhookService_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.WebhookInput))
+	})
+	return _c
+}
+
+func (_c *WebhookService_CreateWebhook_Call) Return(_a0 model.WebhookSubscription, _a1 error) *WebhookService_CreateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_CreateWebhook_Call) RunAndReturn(run func(context.Context, model.WebhookInput) (model.WebhookSubscription, error)) *WebhookService_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: ctx, webhookUUID
+func (_m *WebhookService) DeleteWebhook(ctx context.Context, webhookUUID uuid.UUID) error {
+	ret := _m.Called(ctx, webhookUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, webhookUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookService_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type WebhookService_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID uuid.UUID
+func (_e *WebhookService_Expecter) DeleteWebhook(ctx interface{}, webhookUUID interface{}) *WebhookService_DeleteWebhook_Call {
+	return &WebhookService_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, webhookUUID)}
+}
+
+func (_c *WebhookService_DeleteWebhook_Call) Run(run func(ctx context.Context, webhookUUID uuid.UUID)) *WebhookService_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookService_DeleteWebhook_Call) Return(_a0 error) *WebhookService_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookService_DeleteWebhook_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *WebhookService_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeliverDue provides a mock function with given fields: ctx
+func (_m *WebhookService) DeliverDue(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeliverDue")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_DeliverDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeliverDue'
+type WebhookService_DeliverDue_Call struct {
+	*mock.Call
+}
+
+// DeliverDue is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebhookService_Expecter) DeliverDue(ctx interface{}) *WebhookService_DeliverDue_Call {
+	return &WebhookService_DeliverDue_Call{Call: _e.mock.On("DeliverDue", ctx)}
+}
+
+func (_c *WebhookService_DeliverDue_Call) Run(run func(ctx context.Context)) *WebhookService_DeliverDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookService_DeliverDue_Call) Return(_a0 int, _a1 error) *WebhookService_DeliverDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_DeliverDue_Call) RunAndReturn(run func(context.Context) (int, error)) *WebhookService_DeliverDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueDeliveries provides a mock function with given fields: ctx, events
+func (_m *WebhookService) EnqueueDeliveries(ctx context.Context, events []model.OutboxMessage) error {
+	ret := _m.Called(ctx, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueDeliveries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.OutboxMessage) error); ok {
+		r0 = rf(ctx, events)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookService_EnqueueDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueDeliveries'
+type WebhookService_EnqueueDeliveries_Call struct {
+	*mock.Call
+}
+
+// EnqueueDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []model.OutboxMessage
+func (_e *WebhookService_Expecter) EnqueueDeliveries(ctx interface{}, events interface{}) *WebhookService_EnqueueDeliveries_Call {
+	return &WebhookService_EnqueueDeliveries_Call{Call: _e.mock.On("EnqueueDeliveries", ctx, events)}
+}
+
+func (_c *WebhookService_EnqueueDeliveries_Call) Run(run func(ctx context.Context, events []model.OutboxMessage)) *WebhookService_EnqueueDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]model.OutboxMessage))
+	})
+	return _c
+}
+
+func (_c *WebhookService_EnqueueDeliveries_Call) Return(_a0 error) *WebhookService_EnqueueDeliveries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookService_EnqueueDeliveries_Call) RunAndReturn(run func(context.Context, []model.OutboxMessage) error) *WebhookService_EnqueueDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhook provides a mock function with given fields: ctx, webhookUUID
+func (_m *WebhookService) GetWebhook(ctx context.Context, webhookUUID uuid.UUID) (model.WebhookSubscription, error) {
+	ret := _m.Called(ctx, webhookUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhook")
+	}
+
+	var r0 model.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.WebhookSubscription, error)); ok {
+		return rf(ctx, webhookUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.WebhookSubscription); ok {
+		r0 = rf(ctx, webhookUUID)
+	} else {
+		r0 = ret.Get(0).(model.WebhookSubscription)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, webhookUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_GetWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhook'
+type WebhookService_GetWebhook_Call struct {
+	*mock.Call
+}
+
+// GetWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID uuid.UUID
+func (_e *WebhookService_Expecter) GetWebhook(ctx interface{}, webhookUUID interface{}) *WebhookService_GetWebhook_Call {
+	return &WebhookService_GetWebhook_Call{Call: _e.mock.On("GetWebhook", ctx, webhookUUID)}
+}
+
+func (_c *WebhookService_GetWebhook_Call) Run(run func(ctx context.Context, webhookUUID uuid.UUID)) *WebhookService_GetWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookService_GetWebhook_Call) Return(_a0 model.WebhookSubscription, _a1 error) *WebhookService_GetWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_GetWebhook_Call) RunAndReturn(run func(context.Context, uuid.UUID) (model.WebhookSubscription, error)) *WebhookService_GetWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeadLetters provides a mock function with given fields: ctx, webhookUUID
+func (_m *WebhookService) ListDeadLetters(ctx context.Context, webhookUUID uuid.UUID) ([]model.WebhookDelivery, error) {
+	ret := _m.Called(ctx, webhookUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeadLetters")
+	}
+
+	var r0 []model.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.WebhookDelivery, error)); ok {
+		return rf(ctx, webhookUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.WebhookDelivery); ok {
+		r0 = rf(ctx, webhookUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, webhookUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_ListDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeadLetters'
+type WebhookService_ListDeadLetters_Call struct {
+	*mock.Call
+}
+
+// ListDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID uuid.UUID
+func (_e *WebhookService_Expecter) ListDeadLetters(ctx interface{}, webhookUUID interface{}) *WebhookService_ListDeadLetters_Call {
+	return &WebhookService_ListDeadLetters_Call{Call: _e.mock.On("ListDeadLetters", ctx, webhookUUID)}
+}
+
+func (_c *WebhookService_ListDeadLetters_Call) Run(run func(ctx context.Context, webhookUUID uuid.UUID)) *WebhookService_ListDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookService_ListDeadLetters_Call) Return(_a0 []model.WebhookDelivery, _a1 error) *WebhookService_ListDeadLetters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_ListDeadLetters_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]model.WebhookDelivery, error)) *WebhookService_ListDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhooks provides a mock function with given fields: ctx
+func (_m *WebhookService) ListWebhooks(ctx context.Context) ([]model.WebhookSubscription, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhooks")
+	}
+
+	var r0 []model.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.WebhookSubscription, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.WebhookSubscription); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_ListWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhooks'
+type WebhookService_ListWebhooks_Call struct {
+	*mock.Call
+}
+
+// ListWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebhookService_Expecter) ListWebhooks(ctx interface{}) *WebhookService_ListWebhooks_Call {
+	return &WebhookService_ListWebhooks_Call{Call: _e.mock.On("ListWebhooks", ctx)}
+}
+
+func (_c *WebhookService_ListWebhooks_Call) Run(run func(ctx context.Context)) *WebhookService_ListWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookService_ListWebhooks_Call) Return(_a0 []model.WebhookSubscription, _a1 error) *WebhookService_ListWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_ListWebhooks_Call) RunAndReturn(run func(context.Context) ([]model.WebhookSubscription, error)) *WebhookService_ListWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplayDeadLetter provides a mock function with given fields: ctx, webhookUUID, deliveryUUID
+func (_m *WebhookService) ReplayDeadLetter(ctx context.Context, webhookUUID uuid.UUID, deliveryUUID uuid.UUID) (model.WebhookDelivery, error) {
+	ret := _m.Called(ctx, webhookUUID, deliveryUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayDeadLetter")
+	}
+
+	var r0 model.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (model.WebhookDelivery, error)); ok {
+		return rf(ctx, webhookUUID, deliveryUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) model.WebhookDelivery); ok {
+		r0 = rf(ctx, webhookUUID, deliveryUUID)
+	} else {
+		r0 = ret.Get(0).(model.WebhookDelivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, webhookUUID, deliveryUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_ReplayDeadLetter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayDeadLetter'
+type WebhookService_ReplayDeadLetter_Call struct {
+	*mock.Call
+}
+
+// ReplayDeadLetter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID uuid.UUID
+//   - deliveryUUID uuid.UUID
+func (_e *WebhookService_Expecter) ReplayDeadLetter(ctx interface{}, webhookUUID interface{}, deliveryUUID interface{}) *WebhookService_ReplayDeadLetter_Call {
+	return &WebhookService_ReplayDeadLetter_Call{Call: _e.mock.On("ReplayDeadLetter", ctx, webhookUUID, deliveryUUID)}
+}
+
+func (_c *WebhookService_ReplayDeadLetter_Call) Run(run func(ctx context.Context, webhookUUID uuid.UUID, deliveryUUID uuid.UUID)) *WebhookService_ReplayDeadLetter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookService_ReplayDeadLetter_Call) Return(_a0 model.WebhookDelivery, _a1 error) *WebhookService_ReplayDeadLetter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_ReplayDeadLetter_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (model.WebhookDelivery, error)) *WebhookService_ReplayDeadLetter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateWebhookSecret provides a mock function with given fields: ctx, webhookUUID
+func (_m *WebhookService) RotateWebhookSecret(ctx context.Context, webhookUUID uuid.UUID) (model.WebhookSubscription, error) {
+	ret := _m.Called(ctx, webhookUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateWebhookSecret")
+	}
+
+	var r0 model.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.WebhookSubscription, error)); ok {
+		return rf(ctx, webhookUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.WebhookSubscription); ok {
+		r0 = rf(ctx, webhookUUID)
+	} else {
+		r0 = ret.Get(0).(model.WebhookSubscription)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, webhookUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_RotateWebhookSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateWebhookSecret'
+type WebhookService_RotateWebhookSecret_Call struct {
+	*mock.Call
+}
+
+// RotateWebhookSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID uuid.UUID
+func (_e *WebhookService_Expecter) RotateWebhookSecret(ctx interface{}, webhookUUID interface{}) *WebhookService_RotateWebhookSecret_Call {
+	return &WebhookService_RotateWebhookSecret_Call{Call: _e.mock.On("RotateWebhookSecret", ctx, webhookUUID)}
+}
+
+func (_c *WebhookService_RotateWebhookSecret_Call) Run(run func(ctx context.Context, webhookUUID uuid.UUID)) *WebhookService_RotateWebhookSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookService_RotateWebhookSecret_Call) Return(_a0 model.WebhookSubscription, _a1 error) *WebhookService_RotateWebhookSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_RotateWebhookSecret_Call) RunAndReturn(run func(context.Context, uuid.UUID) (model.WebhookSubscription, error)) *WebhookService_RotateWebhookSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhook provides a mock function with given fields: ctx, webhookUUID, input
+func (_m *WebhookService) UpdateWebhook(ctx context.Context, webhookUUID uuid.UUID, input model.WebhookInput) (model.WebhookSubscription, error) {
+	ret := _m.Called(ctx, webhookUUID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhook")
+	}
+
+	var r0 model.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.WebhookInput) (model.WebhookSubscription, error)); ok {
+		return rf(ctx, webhookUUID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.WebhookInput) model.WebhookSubscription); ok {
+		r0 = rf(ctx, webhookUUID, input)
+	} else {
+		r0 = ret.Get(0).(model.WebhookSubscription)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, model.WebhookInput) error); ok {
+		r1 = rf(ctx, webhookUUID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_UpdateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhook'
+type WebhookService_UpdateWebhook_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookUUID uuid.UUID
+//   - input model.WebhookInput
+func (_e *WebhookService_Expecter) UpdateWebhook(ctx interface{}, webhookUUID interface{}, input interface{}) *WebhookService_UpdateWebhook_Call {
+	return &WebhookService_UpdateWebhook_Call{Call: _e.mock.On("UpdateWebhook", ctx, webhookUUID, input)}
+}
+
+func (_c *WebhookService_UpdateWebhook_Call) Run(run func(ctx context.Context, webhookUUID uuid.UUID, input model.WebhookInput)) *WebhookService_UpdateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(model.WebhookInput))
+	})
+	return _c
+}
+
+func (_c *WebhookService_UpdateWebhook_Call) Return(_a0 model.WebhookSubscription, _a1 error) *WebhookService_UpdateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_UpdateWebhook_Call) RunAndReturn(run func(context.Context, uuid.UUID, model.WebhookInput) (model.WebhookSubscription, error)) *WebhookService_UpdateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookService creates a new instance of WebhookService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookService {
+	mock := &WebhookService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
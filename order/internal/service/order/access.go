@@ -0,0 +1,50 @@
+package order
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/shared/pkg/auth"
+)
+
+// getOrder возвращает заказ, если он доступен вызывающему: пользователю - свой,
+// администратору - любой. Чужой заказ возвращается как model.ErrOrderNotFound,
+// чтобы по ответу нельзя было узнать, существует ли заказ с таким UUID.
+func (s *orderService) getOrder(ctx context.Context, orderUUID string) (model.Order, error) {
+	order, err := s.repo.Get(ctx, orderUUID)
+	if err != nil {
+		return model.Order{}, err
+	}
+	if !canAccess(ctx, order.UserUUID) {
+		return model.Order{}, model.ErrOrderNotFound
+	}
+	return order, nil
+}
+
+// canAccess сообщает, доступны ли вызывающему заказы пользователя userUUID
+func canAccess(ctx context.Context, userUUID uuid.UUID) bool {
+	identity, ok := auth.IdentityFromContext(ctx)
+	if !ok {
+		return false
+	}
+	return identity.HasRole(auth.RoleAdmin) || identity.UserUUID == userUUID
+}
+
+// restrictFilter ограничивает список заказами вызывающего, если он не администратор.
+// Возвращает false, если запрошены заказы, недоступные вызывающему.
+func restrictFilter(ctx context.Context, filter *model.OrdersFilter) bool {
+	identity, ok := auth.IdentityFromContext(ctx)
+	if !ok {
+		return false
+	}
+	if identity.HasRole(auth.RoleAdmin) {
+		return true
+	}
+	if filter.UserUUID != nil && *filter.UserUUID != identity.UserUUID {
+		return false
+	}
+	filter.UserUUID = &identity.UserUUID
+	return true
+}
@@ -0,0 +1,195 @@
+package order
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/shared/pkg/auth"
+)
+
+func userContext(userUUID uuid.UUID, roles ...string) context.Context {
+	return auth.ContextWithIdentity(context.Background(), auth.Identity{UserUUID: userUUID, Roles: roles})
+}
+
+func (s *ServiceSuite) TestOrderAccess() {
+	testCases := []struct {
+		name    string
+		ctx     func(order model.Order) context.Context
+		allowed bool
+	}{
+		{
+			name:    "Owner",
+			ctx:     func(order model.Order) context.Context { return userContext(order.UserUUID) },
+			allowed: true,
+		},
+		{
+			name:    "Admin",
+			ctx:     func(model.Order) context.Context { return userContext(uuid.New(), auth.RoleAdmin) },
+			allowed: true,
+		},
+		{
+			name: "Other user",
+			ctx:  func(model.Order) context.Context { return userContext(uuid.New()) },
+		},
+		{
+			name: "Other user with unrelated role",
+			ctx:  func(model.Order) context.Context { return userContext(uuid.New(), "support") },
+		},
+		{
+			name: "Anonymous",
+			ctx:  func(model.Order) context.Context { return context.Background() },
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run("GetOrder/"+tc.name, func() {
+			// arrange
+			order := newPendingOrder()
+			s.orderRepo.On("Get", mock.Anything, order.OrderUUID.String()).Return(order, nil).Once()
+
+			// act
+			got, err := s.service.GetOrder(tc.ctx(order), order.OrderUUID.String())
+
+			// assert
+			if tc.allowed {
+				s.Require().NoError(err)
+				s.Require().Equal(order, got)
+			} else {
+				s.Require().ErrorIs(err, model.ErrOrderNotFound)
+				s.Require().Empty(got)
+			}
+		})
+
+		s.Run("GetOrderStatusHistory/"+tc.name, func() {
+			// arrange
+			order := newPendingOrder()
+			history := []model.OrderStatusChange{{To: model.OrderStatusPENDINGPAYMENT, Reason: "order created"}}
+			s.orderRepo.On("Get", mock.Anything, order.OrderUUID.String()).Return(order, nil).Once()
+			if tc.allowed {
+				s.orderRepo.On("GetStatusHistory", mock.Anything, order.OrderUUID.String()).Return(history, nil).Once()
+			}
+
+			// act
+			got, err := s.service.GetOrderStatusHistory(tc.ctx(order), order.OrderUUID.String())
+
+			// assert
+			if tc.allowed {
+				s.Require().NoError(err)
+				s.Require().Equal(history, got)
+			} else {
+				s.Require().ErrorIs(err, model.ErrOrderNotFound)
+				s.Require().Empty(got)
+			}
+		})
+	}
+}
+
+// TestOrderAccessDenied проверяет, что чужой заказ нельзя изменить:
+// после чтения заказа не выполняется ни одного изменения и вызова зависимостей
+func (s *ServiceSuite) TestOrderAccessDenied() {
+	order := newPaidOrder()
+	ctx := userContext(uuid.New())
+
+	testCases := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "CancelOrder",
+			call: func() error {
+				_, err := s.service.CancelOrder(ctx, order.OrderUUID.String())
+				return err
+			},
+		},
+		{
+			name: "PayOrder",
+			call: func() error {
+				_, err := s.service.PayOrder(ctx, model.PayOrderInput{
+					OrderUUID:     order.OrderUUID,
+					PaymentMethod: model.PaymentMethodCARD,
+				})
+				return err
+			},
+		},
+		{
+			name: "RefundOrder",
+			call: func() error {
+				_, err := s.service.RefundOrder(ctx, model.RefundOrderInput{OrderUUID: order.OrderUUID})
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			s.orderRepo.On("Get", mock.Anything, order.OrderUUID.String()).Return(order, nil).Once()
+
+			// act
+			err := tc.call()
+
+			// assert
+			s.Require().ErrorIs(err, model.ErrOrderNotFound)
+		})
+	}
+
+	s.Run("Owner cancels own order", func() {
+		// arrange
+		pending := newPendingOrder()
+		ownerCtx := userContext(pending.UserUUID)
+		s.orderRepo.On("Get", ownerCtx, pending.OrderUUID.String()).Return(pending, nil).Once()
+		s.orderRepo.On("Update", ownerCtx, mock.Anything).Return(nil).Once()
+		s.inventoryClient.On("ReleaseReservation", ownerCtx, pending.OrderUUID).Return(nil).Once()
+
+		// act
+		cancelled, err := s.service.CancelOrder(ownerCtx, pending.OrderUUID.String())
+
+		// assert
+		s.Require().NoError(err)
+		s.Require().Equal(model.OrderStatusCANCELLED, cancelled.Status)
+	})
+}
+
+func (s *ServiceSuite) TestListOrdersAccess() {
+	userUUID := uuid.New()
+	ctx := userContext(userUUID)
+
+	s.Run("User sees only own orders", func() {
+		// arrange
+		orders := []model.Order{newPendingOrder()}
+		s.orderRepo.On("List", ctx, model.OrdersFilter{UserUUID: &userUUID}, mock.Anything).Return(orders, nil).Once()
+
+		// act
+		output, err := s.service.ListOrders(ctx, model.ListOrdersInput{})
+
+		// assert
+		s.Require().NoError(err)
+		s.Require().Equal(orders, output.Orders)
+	})
+
+	s.Run("User filters by other user", func() {
+		// arrange
+		otherUUID := uuid.New()
+
+		// act
+		output, err := s.service.ListOrders(ctx, model.ListOrdersInput{
+			Filter: model.OrdersFilter{UserUUID: &otherUUID},
+		})
+
+		// assert
+		s.Require().NoError(err)
+		s.Require().Empty(output.Orders)
+	})
+
+	s.Run("Anonymous caller", func() {
+		// act
+		output, err := s.service.ListOrders(context.Background(), model.ListOrdersInput{})
+
+		// assert
+		s.Require().NoError(err)
+		s.Require().Empty(output.Orders)
+	})
+}
@@ -0,0 +1,36 @@
+package order
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+)
+
+// encodeCursor кодирует позицию последнего заказа страницы в непрозрачную строку
+func encodeCursor(order model.Order) string {
+	data, _ := json.Marshal(model.OrdersCursor{
+		CreatedAt: order.CreatedAt,
+		OrderUUID: order.OrderUUID,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(cursor string) (*model.OrdersCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, model.ErrInvalidCursor
+	}
+
+	var c model.OrdersCursor
+	err = json.Unmarshal(data, &c)
+	if err != nil || c.CreatedAt.IsZero() {
+		return nil, model.ErrInvalidCursor
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,113 @@
+package order
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/repository"
+	"github.com/xgmsx/rsf/shared/pkg/auth"
+)
+
+const (
+	operationCreateOrder = "create_order"
+	operationPayOrder    = "pay_order"
+	operationRefundOrder = "refund_order"
+)
+
+// withIdempotency выполняет fn не более одного раза для пары (operation, key).
+// Ключи разных пользователей не пересекаются, поэтому чужой ключ не вернет
+// сохраненный результат другого пользователя.
+// Повторный вызов с тем же ключом и запросом возвращает сохраненный результат,
+// с тем же ключом и другим запросом - model.ErrIdempotencyKeyReused.
+// Ключ хранится ttl, после чего его можно использовать для нового запроса.
+// Если ключ не передан, fn выполняется без проверок.
+func withIdempotency[T any](
+	ctx context.Context,
+	repo repository.IdempotencyRepository,
+	ttl time.Duration,
+	operation, key string,
+	request any,
+	fn func() (T, error),
+) (T, error) {
+	var zero T
+	if key == "" {
+		return fn()
+	}
+
+	key = scopedKey(ctx, key)
+	hash, err := requestHash(request)
+	if err != nil {
+		return zero, err
+	}
+
+	existing, reserved, err := repo.Reserve(ctx, model.IdempotencyRecord{
+		Operation:   operation,
+		Key:         key,
+		RequestHash: hash,
+		ExpiresAt:   time.Now().UTC().Add(ttl),
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	if !reserved {
+		if existing.RequestHash != hash {
+			return zero, model.ErrIdempotencyKeyReused
+		}
+		if !existing.Completed {
+			return zero, model.ErrIdempotencyKeyInProgress
+		}
+
+		var output T
+		err = json.Unmarshal(existing.Response, &output)
+		if err != nil {
+			return zero, fmt.Errorf("failed to decode stored response: %w", err)
+		}
+		return output, nil
+	}
+
+	output, err := fn()
+	if err != nil {
+		// Неуспешный запрос можно повторить с тем же ключом
+		if rerr := repo.Release(context.WithoutCancel(ctx), operation, key); rerr != nil {
+			slog.ErrorContext(ctx, "failed to release idempotency key", slog.String("key", key), slog.Any("error", rerr))
+		}
+		return zero, err
+	}
+
+	response, err := json.Marshal(output)
+	if err != nil {
+		return zero, fmt.Errorf("failed to encode response: %w", err)
+	}
+
+	// Операция уже выполнена, поэтому ошибка сохранения ответа только логируется
+	if err = repo.Complete(context.WithoutCancel(ctx), operation, key, response); err != nil {
+		slog.ErrorContext(ctx, "failed to store idempotent response", slog.String("key", key), slog.Any("error", err))
+	}
+
+	return output, nil
+}
+
+func requestHash(request any) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// scopedKey добавляет к ключу UUID вызывающего пользователя
+func scopedKey(ctx context.Context, key string) string {
+	identity, ok := auth.IdentityFromContext(ctx)
+	if !ok {
+		return key
+	}
+	return identity.UserUUID.String() + "/" + key
+}
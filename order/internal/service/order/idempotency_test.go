@@ -0,0 +1,163 @@
+package order
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/repository/idempotency"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+)
+
+func (s *ServiceSuite) TestWithIdempotency() {
+	repo := idempotency.NewIdempotencyRepository()
+	request := model.CreateOrderInput{
+		UserUUID: uuid.New(),
+		Items:    []model.CreateOrderItem{{PartUUID: uuid.New(), Quantity: 1}},
+	}
+	expected := model.CreateOrderOutput{OrderUUID: uuid.New(), TotalPrice: money.New(4200, money.DefaultCurrency)}
+
+	calls := 0
+	fn := func() (model.CreateOrderOutput, error) {
+		calls++
+		return expected, nil
+	}
+
+	s.Run("First request executes operation", func() {
+		output, err := withIdempotency(s.ctx, repo, testIdempotencyKeyTTL, operationCreateOrder, "key-1", request, fn)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, output)
+		s.Require().Equal(1, calls)
+	})
+
+	s.Run("Replay returns stored response", func() {
+		output, err := withIdempotency(s.ctx, repo, testIdempotencyKeyTTL, operationCreateOrder, "key-1", request, fn)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, output)
+		s.Require().Equal(1, calls)
+	})
+
+	s.Run("Same key with different request is rejected", func() {
+		other := request
+		other.Items = []model.CreateOrderItem{{PartUUID: uuid.New(), Quantity: 2}}
+		_, err := withIdempotency(s.ctx, repo, testIdempotencyKeyTTL, operationCreateOrder, "key-1", other, fn)
+		s.Require().ErrorIs(err, model.ErrIdempotencyKeyReused)
+		s.Require().Equal(1, calls)
+	})
+
+	s.Run("Failed request can be retried", func() {
+		failErr := errors.New("inventory is down")
+		_, err := withIdempotency(s.ctx, repo, testIdempotencyKeyTTL, operationCreateOrder, "key-2", request,
+			func() (model.CreateOrderOutput, error) { return model.CreateOrderOutput{}, failErr })
+		s.Require().ErrorIs(err, failErr)
+
+		output, err := withIdempotency(s.ctx, repo, testIdempotencyKeyTTL, operationCreateOrder, "key-2", request, fn)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, output)
+		s.Require().Equal(2, calls)
+	})
+
+	s.Run("Concurrent request with the same key", func() {
+		hash, err := requestHash(request)
+		s.Require().NoError(err)
+		_, reserved, err := repo.Reserve(s.ctx, model.IdempotencyRecord{
+			Operation: operationCreateOrder, Key: scopedKey(s.ctx, "key-3"), RequestHash: hash,
+		})
+		s.Require().NoError(err)
+		s.Require().True(reserved)
+
+		_, err = withIdempotency(s.ctx, repo, testIdempotencyKeyTTL, operationCreateOrder, "key-3", request, fn)
+		s.Require().ErrorIs(err, model.ErrIdempotencyKeyInProgress)
+		s.Require().Equal(2, calls)
+	})
+}
+
+func (s *ServiceSuite) TestIdempotencyKeyExpiry() {
+	repo := idempotency.NewIdempotencyRepository()
+	request := model.CreateOrderInput{
+		UserUUID: uuid.New(),
+		Items:    []model.CreateOrderItem{{PartUUID: uuid.New(), Quantity: 1}},
+	}
+	other := request
+	other.Items = []model.CreateOrderItem{{PartUUID: uuid.New(), Quantity: 2}}
+
+	calls := 0
+	fn := func() (model.CreateOrderOutput, error) {
+		calls++
+		return model.CreateOrderOutput{OrderUUID: uuid.New()}, nil
+	}
+
+	s.Run("Expired key can be reused for another request", func() {
+		// arrange
+		_, err := withIdempotency(s.ctx, repo, -time.Second, operationCreateOrder, "key-1", request, fn)
+		s.Require().NoError(err)
+
+		// act
+		_, err = withIdempotency(s.ctx, repo, testIdempotencyKeyTTL, operationCreateOrder, "key-1", other, fn)
+
+		// assert
+		s.Require().NoError(err)
+		s.Require().Equal(2, calls)
+	})
+
+	s.Run("Expired keys are deleted", func() {
+		// arrange
+		_, err := withIdempotency(s.ctx, repo, -time.Second, operationCreateOrder, "key-2", request, fn)
+		s.Require().NoError(err)
+
+		// act
+		deleted, err := repo.DeleteExpired(s.ctx, time.Now().UTC(), idempotencyKeysBatchSize)
+
+		// assert
+		s.Require().NoError(err)
+		s.Require().Equal(1, deleted)
+		_, err = withIdempotency(s.ctx, repo, testIdempotencyKeyTTL, operationCreateOrder, "key-1", request, fn)
+		s.Require().ErrorIs(err, model.ErrIdempotencyKeyReused)
+	})
+}
+
+func (s *ServiceSuite) TestDeleteExpiredIdempotencyKeys() {
+	testCases := []struct {
+		name            string
+		expectedDeleted int
+		expectedErr     error
+		setupMock       func()
+	}{
+		{
+			name:            "Expired keys deleted",
+			expectedDeleted: 3,
+			setupMock: func() {
+				s.idempotencyRepo.On("DeleteExpired", s.ctx, mock.Anything, idempotencyKeysBatchSize).Return(3, nil).Once()
+			},
+		},
+		{
+			name:        "Repository error",
+			expectedErr: assert.AnError,
+			setupMock: func() {
+				s.idempotencyRepo.On("DeleteExpired", s.ctx, mock.Anything, idempotencyKeysBatchSize).Return(0, assert.AnError).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			tc.setupMock()
+
+			// act
+			deleted, err := s.service.DeleteExpiredIdempotencyKeys(s.ctx)
+
+			// assert
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				s.Require().NoError(err)
+			}
+			s.Require().Equal(tc.expectedDeleted, deleted)
+		})
+	}
+}
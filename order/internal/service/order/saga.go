@@ -0,0 +1,480 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/rsf/order/internal/metrics"
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/model/converter"
+)
+
+const (
+	// sagaRecoveryDelay - сколько сага должна не изменяться, чтобы считаться
+	// прерванной. Должно быть больше времени обработки запроса, иначе
+	// восстановление начнет выполнять сагу, которую еще ведет запрос.
+	sagaRecoveryDelay = time.Minute
+	// sagaRecoveryBatchSize - максимальное число саг, восстанавливаемых за один проход
+	sagaRecoveryBatchSize = 100
+	// confirmAttempts - число попыток сохранить оплату в заказе при конфликте версий
+	confirmAttempts = 3
+)
+
+// sagaStep - шаг саги и его компенсирующее действие. И действие, и компенсация
+// должны быть идемпотентны: после перезапуска сервиса они могут выполниться повторно.
+type sagaStep struct {
+	name       model.SagaStep
+	action     func(ctx context.Context, saga *model.Saga) error
+	compensate func(ctx context.Context, saga *model.Saga) error
+}
+
+// retryableError - временная ошибка шага. Сага с такой ошибкой не компенсируется,
+// а остается RUNNING и продолжается при восстановлении.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+
+func (e *retryableError) Unwrap() error { return e.err }
+
+// startSaga сохраняет новую сагу перед первым шагом, чтобы прерванную
+// операцию можно было довести до конца или откатить после перезапуска
+func (s *orderService) startSaga(ctx context.Context, saga *model.Saga, steps []sagaStep) error {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	saga.SagaUUID = uuid.New()
+	saga.Status = model.SagaStatusRUNNING
+	saga.Step = steps[0].name
+	saga.CreatedAt = now
+	saga.UpdatedAt = now
+
+	err := s.sagaRepo.Create(ctx, *saga)
+	if err != nil {
+		return err
+	}
+	saga.Version = 1
+	return nil
+}
+
+// runSaga выполняет шаги саги, начиная с saga.Step. Текущий шаг сохраняется
+// до его выполнения. Если шаг завершился ошибкой, выполненные до него шаги
+// компенсируются в обратном порядке и возвращается ошибка шага.
+func (s *orderService) runSaga(ctx context.Context, saga *model.Saga, steps []sagaStep) error {
+	for i := stepIndex(steps, saga.Step); i < len(steps); i++ {
+		step := steps[i]
+		if saga.Step != step.name {
+			saga.Step = step.name
+			err := s.saveSaga(ctx, saga)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := step.action(ctx, saga)
+		if err == nil {
+			continue
+		}
+		saga.LastError = err.Error()
+
+		var retryable *retryableError
+		if errors.As(err, &retryable) {
+			slog.WarnContext(ctx, "saga step failed, will be retried",
+				slog.String("saga_uuid", saga.SagaUUID.String()),
+				slog.String("step", string(step.name)),
+				slog.Any("error", err),
+			)
+			if saveErr := s.saveSaga(ctx, saga); saveErr != nil {
+				slog.ErrorContext(ctx, "failed to save saga", slog.String("saga_uuid", saga.SagaUUID.String()), slog.Any("error", saveErr))
+			}
+			return retryable.err
+		}
+
+		compErr := s.compensateSaga(ctx, saga, steps[:i])
+		if compErr != nil {
+			slog.ErrorContext(ctx, "failed to compensate saga", slog.String("saga_uuid", saga.SagaUUID.String()), slog.Any("error", compErr))
+		}
+		return err
+	}
+
+	// Все шаги выполнены. Если сохранить итог не удалось, восстановление
+	// повторит последний шаг, поэтому ошибка только логируется.
+	saga.Status = model.SagaStatusCOMPLETED
+	saga.LastError = ""
+	err := s.saveSaga(ctx, saga)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to complete saga", slog.String("saga_uuid", saga.SagaUUID.String()), slog.Any("error", err))
+	}
+	return nil
+}
+
+// compensateSaga откатывает выполненные шаги done в обратном порядке.
+// Если компенсация не удалась, сага остается COMPENSATING и откат
+// продолжается при восстановлении.
+func (s *orderService) compensateSaga(ctx context.Context, saga *model.Saga, done []sagaStep) error {
+	saga.Status = model.SagaStatusCOMPENSATING
+	for i := len(done) - 1; i >= 0; i-- {
+		step := done[i]
+		saga.Step = step.name
+		err := s.saveSaga(ctx, saga)
+		if err != nil {
+			return err
+		}
+		if step.compensate == nil {
+			continue
+		}
+
+		err = step.compensate(ctx, saga)
+		if err != nil {
+			saga.LastError = err.Error()
+			if saveErr := s.saveSaga(ctx, saga); saveErr != nil {
+				slog.ErrorContext(ctx, "failed to save saga", slog.String("saga_uuid", saga.SagaUUID.String()), slog.Any("error", saveErr))
+			}
+			return fmt.Errorf("compensate step %s: %w", step.name, err)
+		}
+	}
+
+	saga.Status = model.SagaStatusCOMPENSATED
+	return s.saveSaga(ctx, saga)
+}
+
+func (s *orderService) saveSaga(ctx context.Context, saga *model.Saga) error {
+	saga.UpdatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	err := s.sagaRepo.Update(ctx, *saga)
+	if err != nil {
+		return err
+	}
+	saga.Version++
+	return nil
+}
+
+func stepIndex(steps []sagaStep, name model.SagaStep) int {
+	for i, step := range steps {
+		if step.name == name {
+			return i
+		}
+	}
+	return 0
+}
+
+// createOrderSteps - шаги создания заказа: резерв деталей и сохранение заказа.
+// При восстановлении order равен nil: данных заказа в саге нет, поэтому
+// прерванное создание только откатывается и действия не вызываются.
+func (s *orderService) createOrderSteps(order *model.Order) []sagaStep {
+	return []sagaStep{
+		{
+			name: model.SagaStepReserveStock,
+			action: func(ctx context.Context, _ *model.Saga) error {
+				return s.inventoryClient.ReserveParts(ctx, order.OrderUUID, order.Items, s.paymentTimeout+paymentDeadlineGrace)
+			},
+			compensate: func(ctx context.Context, saga *model.Saga) error {
+				return s.inventoryClient.ReleaseReservation(ctx, saga.OrderUUID)
+			},
+		},
+		{
+			name: model.SagaStepConfirm,
+			action: func(ctx context.Context, _ *model.Saga) error {
+				return s.repo.Create(ctx, *order)
+			},
+		},
+	}
+}
+
+// payOrderSteps - шаги оплаты заказа: списание средств, подтверждение резерва
+// и сохранение оплаты в заказе. Резерв подтверждается после списания: если оплата
+// не прошла, неподтвержденный резерв истекает вместе с заказом. Истекший к этому
+// времени резерв или несохраненная оплата компенсируются снятием резерва
+// и возвратом средств. order - прочитанный перед оплатой заказ,
+// при восстановлении равен nil и заказ читается заново.
+func (s *orderService) payOrderSteps(order *model.Order) []sagaStep {
+	return []sagaStep{
+		{
+			// Payment возвращает уже проведенную транзакцию заказа,
+			// поэтому повтор шага не списывает средства дважды. Компенсируется
+			// только отказ в оплате: при сбое транспорта списание могло пройти,
+			// а номера транзакции еще нет, поэтому шаг повторяется при восстановлении.
+			name: model.SagaStepCharge,
+			action: func(ctx context.Context, saga *model.Saga) error {
+				txUUID, err := s.paymentClient.PayOrder(ctx, saga.UserUUID, saga.OrderUUID, *saga.PaymentMethod, saga.Amount)
+				if err != nil {
+					slog.ErrorContext(ctx, "failed to process payment", slog.String("order_uuid", saga.OrderUUID.String()), slog.Any("error", err))
+					if errors.Is(err, model.ErrPaymentDeclined) || errors.Is(err, model.ErrPaymentMethodIsNotSupported) {
+						return err
+					}
+					return &retryableError{err: err}
+				}
+				saga.TransactionUUID = txUUID
+				return nil
+			},
+			compensate: func(ctx context.Context, saga *model.Saga) error {
+				if saga.TransactionUUID == nil {
+					return nil
+				}
+				// UUID саги служит идентификатором возврата, поэтому
+				// повторная компенсация не возвращает средства дважды
+				err := s.paymentClient.RefundPayment(ctx, *saga.TransactionUUID, saga.SagaUUID, saga.Amount, "order payment was not confirmed")
+				if errors.Is(err, model.ErrRefundExceedsPayment) {
+					// Средства уже возвращены при предыдущей попытке
+					return nil
+				}
+				return err
+			},
+		},
+		{
+			// Повторное подтверждение и снятие резерва безопасны.
+			// Снятие подтвержденного резерва возвращает детали на склад.
+			name: model.SagaStepCommitStock,
+			action: func(ctx context.Context, saga *model.Saga) error {
+				return s.inventoryClient.CommitReservation(ctx, saga.OrderUUID)
+			},
+			compensate: func(ctx context.Context, saga *model.Saga) error {
+				return s.inventoryClient.ReleaseReservation(ctx, saga.OrderUUID)
+			},
+		},
+		{
+			name: model.SagaStepConfirm,
+			action: func(ctx context.Context, saga *model.Saga) error {
+				return s.confirmPayment(ctx, saga, order)
+			},
+		},
+	}
+}
+
+// confirmPayment переводит заказ в статус PAID. При конфликте версий заказ
+// перечитывается: если за время оплаты его отменили или он истек, переход
+// невозможен и списание компенсируется возвратом средств.
+func (s *orderService) confirmPayment(ctx context.Context, saga *model.Saga, order *model.Order) error {
+	for attempt := 1; ; attempt++ {
+		if order == nil {
+			got, err := s.repo.Get(ctx, saga.OrderUUID.String())
+			if err != nil {
+				return &retryableError{err: err}
+			}
+			order = &got
+		}
+		if order.Status == model.OrderStatusPAID && order.TransactionUUID != nil && *order.TransactionUUID == *saga.TransactionUUID {
+			return nil
+		}
+
+		paidAt := time.Now().UTC()
+		err := order.TransitionTo(model.OrderStatusPAID, "payment succeeded", paidAt)
+		if err != nil {
+			return err
+		}
+		order.PaymentMethod = saga.PaymentMethod
+		order.TransactionUUID = saga.TransactionUUID
+		err = recordEvent(order, converter.OrderPaidToEvent(*order), paidAt)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.Update(ctx, *order)
+		if err == nil {
+			if order.PaymentMethod != nil {
+				metrics.OrderPaid(*order.PaymentMethod, order.TotalPrice)
+			}
+			return nil
+		}
+		if !errors.Is(err, model.ErrOrderConflict) || attempt >= confirmAttempts {
+			return &retryableError{err: err}
+		}
+		slog.WarnContext(ctx, "order was modified while payment was processed",
+			slog.String("order_uuid", saga.OrderUUID.String()),
+			slog.Any("transaction_uuid", saga.TransactionUUID),
+		)
+		order = nil
+	}
+}
+
+// refundOrderSteps - шаги возврата: возврат средств и сохранение возврата
+// в заказе. У заказа выполняется только одна сага возврата, поэтому сумму
+// возвратов заказа меняет только она. Возврат средств не компенсируется:
+// после него сага только доводится до конца. order - прочитанный перед
+// возвратом заказ, при восстановлении равен nil и заказ читается заново.
+func (s *orderService) refundOrderSteps(order *model.Order) []sagaStep {
+	return []sagaStep{
+		{
+			// UUID саги служит идентификатором возврата, поэтому повтор
+			// шага не возвращает средства дважды
+			name: model.SagaStepRefund,
+			action: func(ctx context.Context, saga *model.Saga) error {
+				err := s.captureRefundedOrder(ctx, saga, order)
+				if err != nil {
+					return err
+				}
+				err = s.paymentClient.RefundPayment(ctx, *saga.TransactionUUID, saga.SagaUUID, saga.Amount, saga.Reason)
+				if err != nil {
+					slog.ErrorContext(ctx, "failed to process refund", slog.String("order_uuid", saga.OrderUUID.String()), slog.Any("error", err))
+					if errors.Is(err, model.ErrRefundExceedsPayment) || errors.Is(err, model.ErrInvalidRefundAmount) {
+						return err
+					}
+					return &retryableError{err: err}
+				}
+				return nil
+			},
+		},
+		{
+			name: model.SagaStepConfirm,
+			action: func(ctx context.Context, saga *model.Saga) error {
+				return s.confirmRefund(ctx, saga, order)
+			},
+		},
+	}
+}
+
+// captureRefundedOrder проверяет перед возвратом средств, что сумма возвратов
+// заказа не изменилась с момента его чтения. Прочитанный заказ сохраняется
+// с проверкой версии: после этого сумму возвратов меняет только текущая сага.
+// При восстановлении заказ перечитывается: если сумма изменилась, заказ
+// не был захвачен и средства еще не возвращались.
+func (s *orderService) captureRefundedOrder(ctx context.Context, saga *model.Saga, order *model.Order) error {
+	if order == nil {
+		got, err := s.repo.Get(ctx, saga.OrderUUID.String())
+		if err != nil {
+			return &retryableError{err: err}
+		}
+		if got.RefundedAmount.Amount != saga.RefundedBefore.Amount {
+			return model.ErrOrderConflict
+		}
+		return nil
+	}
+	err := s.repo.Update(ctx, *order)
+	if err != nil {
+		return err
+	}
+	order.Version++
+	return nil
+}
+
+// confirmRefund сохраняет возврат в заказе. Средства уже возвращены, поэтому
+// все ошибки временные: сага остается RUNNING, пока возврат не будет сохранен.
+// Если возврат уже сохранен, шаг только снимает резерв полностью возвращенного заказа.
+func (s *orderService) confirmRefund(ctx context.Context, saga *model.Saga, order *model.Order) error {
+	total, err := saga.RefundedBefore.Add(saga.Amount)
+	if err != nil {
+		return &retryableError{err: err}
+	}
+
+	for attempt := 1; ; attempt++ {
+		if order == nil {
+			got, err := s.repo.Get(ctx, saga.OrderUUID.String())
+			if err != nil {
+				return &retryableError{err: err}
+			}
+			order = &got
+		}
+
+		status := model.OrderStatusPARTIALLYREFUNDED
+		if total.Amount == order.TotalPrice.Amount {
+			status = model.OrderStatusREFUNDED
+		}
+
+		switch order.RefundedAmount.Amount {
+		case total.Amount:
+			s.releaseRefundedStock(ctx, status, saga.OrderUUID)
+			return nil
+		case saga.RefundedBefore.Amount:
+		default:
+			return &retryableError{err: fmt.Errorf("order %s refunded amount changed during refund %s", saga.OrderUUID, saga.SagaUUID)}
+		}
+
+		refundedAt := time.Now().UTC()
+		err = order.TransitionTo(status, saga.Reason, refundedAt)
+		if err != nil {
+			return &retryableError{err: err}
+		}
+		order.RefundedAmount = total
+		err = recordEvent(order, converter.OrderRefundedToEvent(*order, saga.SagaUUID, saga.Amount, saga.Reason), refundedAt)
+		if err != nil {
+			return &retryableError{err: err}
+		}
+
+		err = s.repo.Update(ctx, *order)
+		if err == nil {
+			metrics.OrderRefunded(order.Status, saga.Amount)
+			s.releaseRefundedStock(ctx, status, saga.OrderUUID)
+			return nil
+		}
+		if !errors.Is(err, model.ErrOrderConflict) || attempt >= confirmAttempts {
+			return &retryableError{err: err}
+		}
+		slog.WarnContext(ctx, "order was modified while refund was processed",
+			slog.String("order_uuid", saga.OrderUUID.String()),
+			slog.String("refund_uuid", saga.SagaUUID.String()),
+		)
+		order = nil
+	}
+}
+
+// releaseRefundedStock снимает подтвержденный при оплате резерв полностью
+// возвращенного заказа, возвращая детали на склад
+func (s *orderService) releaseRefundedStock(ctx context.Context, status model.OrderStatus, orderUUID uuid.UUID) {
+	if status == model.OrderStatusREFUNDED {
+		s.releaseReservation(ctx, orderUUID)
+	}
+}
+
+// RecoverSagas продолжает саги, прерванные перезапуском или сбоем: оплата
+// и возврат доводятся до конца, а незавершенные создание заказа и компенсации
+// откатываются. Сага, которую одновременно изменила другая реплика, пропускается.
+func (s *orderService) RecoverSagas(ctx context.Context) (int, error) {
+	sagas, err := s.sagaRepo.ListUnfinished(ctx, time.Now().UTC().Add(-sagaRecoveryDelay), sagaRecoveryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var recovered int
+	for _, saga := range sagas {
+		err = s.recoverSaga(ctx, &saga)
+		if errors.Is(err, model.ErrSagaConflict) {
+			continue
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to recover saga", slog.String("saga_uuid", saga.SagaUUID.String()), slog.Any("error", err))
+			continue
+		}
+		recovered++
+	}
+
+	return recovered, nil
+}
+
+func (s *orderService) recoverSaga(ctx context.Context, saga *model.Saga) error {
+	var steps []sagaStep
+	switch saga.Type {
+	case model.SagaTypeCreateOrder:
+		steps = s.createOrderSteps(nil)
+		if saga.Status == model.SagaStatusRUNNING {
+			_, err := s.repo.Get(ctx, saga.OrderUUID.String())
+			if err == nil {
+				saga.Status = model.SagaStatusCOMPLETED
+				saga.LastError = ""
+				return s.saveSaga(ctx, saga)
+			}
+			if !errors.Is(err, model.ErrOrderNotFound) {
+				return err
+			}
+			// Заказ не сохранен, а текущий шаг мог успеть выполниться,
+			// поэтому откатывается и он
+			saga.LastError = "order was not saved before restart"
+		}
+	case model.SagaTypePayOrder:
+		steps = s.payOrderSteps(nil)
+		if saga.Status == model.SagaStatusRUNNING {
+			return s.runSaga(ctx, saga, steps)
+		}
+	case model.SagaTypeRefundOrder:
+		steps = s.refundOrderSteps(nil)
+		if saga.Status == model.SagaStatusRUNNING {
+			return s.runSaga(ctx, saga, steps)
+		}
+	default:
+		return fmt.Errorf("unknown saga type %q", saga.Type)
+	}
+
+	return s.compensateSaga(ctx, saga, steps[:stepIndex(steps, saga.Step)+1])
+}
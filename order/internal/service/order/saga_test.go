@@ -0,0 +1,239 @@
+package order
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/utils"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+)
+
+func newSaga(sagaType model.SagaType, status model.SagaStatus, step model.SagaStep, order model.Order) model.Saga {
+	updatedAt := time.Now().UTC().Add(-2 * sagaRecoveryDelay)
+	saga := model.Saga{
+		SagaUUID:  uuid.New(),
+		Type:      sagaType,
+		OrderUUID: order.OrderUUID,
+		UserUUID:  order.UserUUID,
+		Status:    status,
+		Step:      step,
+		Amount:    order.TotalPrice,
+		Version:   3,
+		CreatedAt: updatedAt,
+		UpdatedAt: updatedAt,
+	}
+	if sagaType == model.SagaTypePayOrder {
+		saga.PaymentMethod = utils.ToPtr(model.PaymentMethodCARD)
+	}
+	return saga
+}
+
+// newRefundSaga возвращает сагу полного возврата оплаченного заказа
+func newRefundSaga(step model.SagaStep, order model.Order, txUUID uuid.UUID) model.Saga {
+	saga := newSaga(model.SagaTypeRefundOrder, model.SagaStatusRUNNING, step, order)
+	saga.TransactionUUID = &txUUID
+	saga.Reason = "refund requested"
+	saga.RefundedBefore = money.Zero(order.TotalPrice.Currency)
+	return saga
+}
+
+// paidOrder возвращает заказ, оплаченный транзакцией txUUID
+func paidOrder(order model.Order, txUUID uuid.UUID) model.Order {
+	order.Status = model.OrderStatusPAID
+	order.PaymentMethod = utils.ToPtr(model.PaymentMethodCARD)
+	order.TransactionUUID = &txUUID
+	order.RefundedAmount = money.Zero(order.TotalPrice.Currency)
+	return order
+}
+
+func (s *ServiceSuite) TestRecoverSagas() {
+	txUUID := uuid.New()
+
+	testCases := []struct {
+		name               string
+		saga               func(model.Order) model.Saga
+		expectedRecovered  int
+		expectedSagaStatus model.SagaStatus
+		setupMock          func(model.Order)
+	}{
+		{
+			name: "Interrupted payment completed",
+			saga: func(order model.Order) model.Saga {
+				return newSaga(model.SagaTypePayOrder, model.SagaStatusRUNNING, model.SagaStepCharge, order)
+			},
+			expectedRecovered:  1,
+			expectedSagaStatus: model.SagaStatusCOMPLETED,
+			setupMock: func(order model.Order) {
+				s.paymentClient.On("PayOrder", s.ctx, order.UserUUID, order.OrderUUID, model.PaymentMethodCARD, order.TotalPrice).
+					Return(&txUUID, nil).Once()
+				s.inventoryClient.On("CommitReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.MatchedBy(func(o model.Order) bool {
+					return o.Status == model.OrderStatusPAID && *o.TransactionUUID == txUUID &&
+						len(o.NewEvents) == 1 && o.NewEvents[0].EventType == "order.v1.OrderPaid"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "Already confirmed payment completed",
+			saga: func(order model.Order) model.Saga {
+				saga := newSaga(model.SagaTypePayOrder, model.SagaStatusRUNNING, model.SagaStepConfirm, order)
+				saga.TransactionUUID = &txUUID
+				return saga
+			},
+			expectedRecovered:  1,
+			expectedSagaStatus: model.SagaStatusCOMPLETED,
+			setupMock: func(order model.Order) {
+				order.Status = model.OrderStatusPAID
+				order.TransactionUUID = &txUUID
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name: "Unconfirmed payment refunded",
+			saga: func(order model.Order) model.Saga {
+				saga := newSaga(model.SagaTypePayOrder, model.SagaStatusCOMPENSATING, model.SagaStepCharge, order)
+				saga.TransactionUUID = &txUUID
+				return saga
+			},
+			expectedRecovered:  1,
+			expectedSagaStatus: model.SagaStatusCOMPENSATED,
+			setupMock: func(order model.Order) {
+				s.paymentClient.On("RefundPayment", s.ctx, txUUID, mock.Anything, order.TotalPrice, mock.Anything).
+					Return(model.ErrRefundExceedsPayment).Once()
+			},
+		},
+		{
+			name: "Committed stock released and payment refunded",
+			saga: func(order model.Order) model.Saga {
+				saga := newSaga(model.SagaTypePayOrder, model.SagaStatusCOMPENSATING, model.SagaStepCommitStock, order)
+				saga.TransactionUUID = &txUUID
+				return saga
+			},
+			expectedRecovered:  1,
+			expectedSagaStatus: model.SagaStatusCOMPENSATED,
+			setupMock: func(order model.Order) {
+				s.inventoryClient.On("ReleaseReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+				s.paymentClient.On("RefundPayment", s.ctx, txUUID, mock.Anything, order.TotalPrice, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "Failed refund retried later",
+			saga: func(order model.Order) model.Saga {
+				saga := newSaga(model.SagaTypePayOrder, model.SagaStatusCOMPENSATING, model.SagaStepCharge, order)
+				saga.TransactionUUID = &txUUID
+				return saga
+			},
+			expectedSagaStatus: model.SagaStatusCOMPENSATING,
+			setupMock: func(order model.Order) {
+				s.paymentClient.On("RefundPayment", s.ctx, txUUID, mock.Anything, order.TotalPrice, mock.Anything).
+					Return(assert.AnError).Once()
+			},
+		},
+		{
+			name: "Interrupted refund completed",
+			saga: func(order model.Order) model.Saga {
+				return newRefundSaga(model.SagaStepRefund, order, txUUID)
+			},
+			expectedRecovered:  1,
+			expectedSagaStatus: model.SagaStatusCOMPLETED,
+			setupMock: func(order model.Order) {
+				order = paidOrder(order, txUUID)
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Twice()
+				s.paymentClient.On("RefundPayment", s.ctx, txUUID, mock.Anything, order.TotalPrice, "refund requested").Return(nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.MatchedBy(func(o model.Order) bool {
+					return o.Status == model.OrderStatusREFUNDED && o.RefundedAmount == order.TotalPrice &&
+						len(o.NewEvents) == 1 && o.NewEvents[0].EventType == "order.v1.OrderRefunded"
+				})).Return(nil).Once()
+				s.inventoryClient.On("ReleaseReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+			},
+		},
+		{
+			name: "Saved refund completed",
+			saga: func(order model.Order) model.Saga {
+				return newRefundSaga(model.SagaStepConfirm, order, txUUID)
+			},
+			expectedRecovered:  1,
+			expectedSagaStatus: model.SagaStatusCOMPLETED,
+			setupMock: func(order model.Order) {
+				order = paidOrder(order, txUUID)
+				order.Status = model.OrderStatusREFUNDED
+				order.RefundedAmount = order.TotalPrice
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.inventoryClient.On("ReleaseReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+			},
+		},
+		{
+			name: "Refund of changed order abandoned",
+			saga: func(order model.Order) model.Saga {
+				return newRefundSaga(model.SagaStepRefund, order, txUUID)
+			},
+			expectedSagaStatus: model.SagaStatusCOMPENSATED,
+			setupMock: func(order model.Order) {
+				order = paidOrder(order, txUUID)
+				order.Status = model.OrderStatusPARTIALLYREFUNDED
+				order.RefundedAmount = money.New(100, order.TotalPrice.Currency)
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name: "Saved order completes creation",
+			saga: func(order model.Order) model.Saga {
+				return newSaga(model.SagaTypeCreateOrder, model.SagaStatusRUNNING, model.SagaStepConfirm, order)
+			},
+			expectedRecovered:  1,
+			expectedSagaStatus: model.SagaStatusCOMPLETED,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name: "Unsaved order releases reservation",
+			saga: func(order model.Order) model.Saga {
+				return newSaga(model.SagaTypeCreateOrder, model.SagaStatusRUNNING, model.SagaStepConfirm, order)
+			},
+			expectedRecovered:  1,
+			expectedSagaStatus: model.SagaStatusCOMPENSATED,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(model.Order{}, model.ErrOrderNotFound).Once()
+				s.inventoryClient.On("ReleaseReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			s.savedSagas = nil
+			order := newPendingOrder()
+			saga := tc.saga(order)
+			s.sagaRepo.On("ListUnfinished", s.ctx, mock.Anything, sagaRecoveryBatchSize).Return([]model.Saga{saga}, nil).Once()
+			tc.setupMock(order)
+
+			// act
+			recovered, err := s.service.RecoverSagas(s.ctx)
+
+			// assert
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedRecovered, recovered)
+			s.Require().Equal(saga.SagaUUID, s.lastSaga().SagaUUID)
+			s.Require().Equal(tc.expectedSagaStatus, s.lastSaga().Status)
+		})
+	}
+
+	s.Run("Repository error", func() {
+		// arrange
+		s.sagaRepo.On("ListUnfinished", s.ctx, mock.Anything, sagaRecoveryBatchSize).Return(nil, assert.AnError).Once()
+
+		// act
+		recovered, err := s.service.RecoverSagas(s.ctx)
+
+		// assert
+		s.Require().ErrorIs(err, assert.AnError)
+		s.Require().Zero(recovered)
+	})
+}
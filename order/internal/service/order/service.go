@@ -2,72 +2,179 @@ package order
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/xgmsx/rsf/order/internal/client"
+	"github.com/xgmsx/rsf/order/internal/metrics"
 	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/model/converter"
 	"github.com/xgmsx/rsf/order/internal/repository"
 	def "github.com/xgmsx/rsf/order/internal/service"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+	genInventoryV1 "github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1"
+	genOrderEventsV1 "github.com/xgmsx/rsf/shared/pkg/proto/order/v1"
 )
 
 var _ def.OrderService = (*orderService)(nil)
 
+const (
+	defaultListOrdersLimit = 20
+
+	// paymentDeadlineGrace - запас после крайнего срока оплаты, в течение которого
+	// заказ не истекает, чтобы начатая до срока оплата успела завершиться
+	paymentDeadlineGrace = time.Minute
+	// expireBatchSize - максимальное число заказов, истекающих за один проход
+	expireBatchSize = 100
+	// idempotencyKeysBatchSize - максимальное число ключей идемпотентности,
+	// удаляемых за один проход
+	idempotencyKeysBatchSize = 1000
+)
+
 type orderService struct {
 	repo            repository.OrderRepository
+	sagaRepo        repository.SagaRepository
+	idempotencyRepo repository.IdempotencyRepository
 	inventoryClient client.InventoryClient
 	paymentClient   client.PaymentClient
+	paymentTimeout  time.Duration
+	// idempotencyKeyTTL - срок хранения ключей идемпотентности
+	idempotencyKeyTTL time.Duration
 }
 
 func NewOrderService(
 	repo repository.OrderRepository,
+	sagaRepo repository.SagaRepository,
+	idempotencyRepo repository.IdempotencyRepository,
 	inventoryClient client.InventoryClient,
 	paymentClient client.PaymentClient,
+	paymentTimeout time.Duration,
+	idempotencyKeyTTL time.Duration,
 ) *orderService {
-	return &orderService{repo: repo, inventoryClient: inventoryClient, paymentClient: paymentClient}
+	return &orderService{
+		repo:              repo,
+		sagaRepo:          sagaRepo,
+		idempotencyRepo:   idempotencyRepo,
+		inventoryClient:   inventoryClient,
+		paymentClient:     paymentClient,
+		paymentTimeout:    paymentTimeout,
+		idempotencyKeyTTL: idempotencyKeyTTL,
+	}
 }
 
 func (s *orderService) CreateOrder(ctx context.Context, input model.CreateOrderInput) (model.CreateOrderOutput, error) {
-	parts, err := s.inventoryClient.GetParts(ctx, input.PartUUIDs)
+	return withIdempotency(ctx, s.idempotencyRepo, s.idempotencyKeyTTL, operationCreateOrder, input.IdempotencyKey, input,
+		func() (model.CreateOrderOutput, error) {
+			return s.createOrder(ctx, input)
+		})
+}
+
+func (s *orderService) createOrder(ctx context.Context, input model.CreateOrderInput) (model.CreateOrderOutput, error) {
+	// Объединяем повторяющиеся детали в одну позицию, сохраняя порядок
+	partUUIDs := make([]uuid.UUID, 0, len(input.Items))
+	quantities := make(map[uuid.UUID]int64, len(input.Items))
+	for _, item := range input.Items {
+		if _, ok := quantities[item.PartUUID]; !ok {
+			partUUIDs = append(partUUIDs, item.PartUUID)
+		}
+		quantities[item.PartUUID] += item.Quantity
+	}
+
+	parts, err := s.inventoryClient.GetParts(ctx, partUUIDs)
 	if err != nil {
-		log.Printf("error while fetching inventory: %v\n", err)
-		return model.CreateOrderOutput{}, model.ErrFailedToFetchInventory
+		slog.ErrorContext(ctx, "failed to fetch inventory", slog.Any("error", err))
+		return model.CreateOrderOutput{}, fmt.Errorf("%w: %w", model.ErrFailedToFetchInventory, err)
+	}
+
+	returned := make(map[string]*genInventoryV1.Part, len(parts))
+	for _, part := range parts {
+		returned[part.GetUuid()] = part
 	}
 
-	if len(parts) != len(input.PartUUIDs) {
-		returned := make(map[string]struct{}, len(parts))
-		for _, part := range parts {
-			returned[part.GetUuid()] = struct{}{}
+	var (
+		items      = make([]model.OrderItem, 0, len(partUUIDs))
+		missing    []string
+		totalPrice money.Money
+	)
+	for _, partUUID := range partUUIDs {
+		part, ok := returned[partUUID.String()]
+		if !ok {
+			missing = append(missing, partUUID.String())
+			continue
 		}
+		unitPrice, err := partPrice(part)
+		if err != nil {
+			return model.CreateOrderOutput{}, err
+		}
+		item := model.OrderItem{
+			PartUUID:  partUUID,
+			Quantity:  quantities[partUUID],
+			UnitPrice: unitPrice,
+			Name:      part.GetName(),
+			Category:  partCategoryFromProto(part.GetCategory()),
+		}
+		items = append(items, item)
 
-		var missing []string
-		for _, reqUUID := range input.PartUUIDs {
-			if _, ok := returned[reqUUID.String()]; !ok {
-				missing = append(missing, reqUUID.String())
-			}
+		lineTotal, err := item.UnitPrice.Mul(item.Quantity)
+		if err != nil {
+			return model.CreateOrderOutput{}, err
 		}
+		totalPrice, err = totalPrice.Add(lineTotal)
+		if err != nil {
+			return model.CreateOrderOutput{}, fmt.Errorf("part %s: %w", partUUID, err)
+		}
+	}
+	if len(missing) > 0 {
 		err = fmt.Errorf("the following partUuid(s) do not exist: %v: %w", missing, model.ErrPartDoesNotExist)
 		return model.CreateOrderOutput{}, err
 	}
 
-	var totalPrice float64
-	for _, part := range parts {
-		totalPrice += part.GetPrice()
-	}
-
 	order := model.Order{
 		UserUUID:   input.UserUUID,
 		OrderUUID:  uuid.New(),
-		PartUUIDs:  input.PartUUIDs,
-		Status:     model.OrderStatusPENDINGPAYMENT,
+		Items:      items,
 		TotalPrice: totalPrice,
+		// Точность PostgreSQL - микросекунды, округляем заранее,
+		// чтобы курсоры пагинации совпадали во всех хранилищах
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
 	}
-	err = s.repo.Update(ctx, order)
+	order.PaymentDeadline = order.CreatedAt.Add(s.paymentTimeout)
+	order.RefundedAmount = money.Zero(totalPrice.Currency)
+	err = order.TransitionTo(model.OrderStatusPENDINGPAYMENT, "order created", order.CreatedAt)
+	if err != nil {
+		return model.CreateOrderOutput{}, err
+	}
+	err = recordEvent(&order, converter.OrderCreatedToEvent(order), order.CreatedAt)
+	if err != nil {
+		return model.CreateOrderOutput{}, err
+	}
+
+	// Резервируем детали до сохранения заказа, чтобы два заказа
+	// не могли купить одну и ту же последнюю деталь. Если заказ
+	// не сохранится, сага снимет резерв.
+	saga := model.Saga{
+		Type:      model.SagaTypeCreateOrder,
+		OrderUUID: order.OrderUUID,
+		UserUUID:  order.UserUUID,
+		Amount:    order.TotalPrice,
+	}
+	steps := s.createOrderSteps(&order)
+	err = s.startSaga(ctx, &saga, steps)
 	if err != nil {
 		return model.CreateOrderOutput{}, err
 	}
+	err = s.runSaga(ctx, &saga, steps)
+	if err != nil {
+		if errors.Is(err, model.ErrPartsOutOfStock) {
+			metrics.PartsOutOfStock()
+		}
+		return model.CreateOrderOutput{}, err
+	}
+	metrics.OrderCreated()
 
 	output := model.CreateOrderOutput{
 		OrderUUID:  order.OrderUUID,
@@ -77,51 +184,304 @@ func (s *orderService) CreateOrder(ctx context.Context, input model.CreateOrderI
 }
 
 func (s *orderService) GetOrder(ctx context.Context, orderUUID string) (model.Order, error) {
-	return s.repo.Get(ctx, orderUUID)
+	return s.getOrder(ctx, orderUUID)
+}
+
+func (s *orderService) GetOrderStatusHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusChange, error) {
+	_, err := s.getOrder(ctx, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetStatusHistory(ctx, orderUUID)
+}
+
+func (s *orderService) ListOrders(ctx context.Context, input model.ListOrdersInput) (model.ListOrdersOutput, error) {
+	after, err := decodeCursor(input.Cursor)
+	if err != nil {
+		return model.ListOrdersOutput{}, err
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultListOrdersLimit
+	}
+	sort := input.Sort
+	if sort == "" {
+		sort = model.OrdersSortCreatedAtDesc
+	}
+	// Пользователь видит только свои заказы, администратор - все
+	filter := input.Filter
+	if !restrictFilter(ctx, &filter) {
+		return model.ListOrdersOutput{}, nil
+	}
+
+	// Запрашиваем на один заказ больше, чтобы понять, есть ли следующая страница
+	orders, err := s.repo.List(ctx, filter, model.OrdersPage{
+		Sort:  sort,
+		After: after,
+		Limit: limit + 1,
+	})
+	if err != nil {
+		return model.ListOrdersOutput{}, err
+	}
+
+	output := model.ListOrdersOutput{Orders: orders}
+	if len(orders) > limit {
+		output.Orders = orders[:limit]
+		output.NextCursor = encodeCursor(output.Orders[limit-1])
+	}
+
+	return output, nil
 }
 
 func (s *orderService) CancelOrder(ctx context.Context, orderUUID string) (model.Order, error) {
-	order, err := s.repo.Get(ctx, orderUUID)
+	order, err := s.getOrder(ctx, orderUUID)
 	if err != nil {
 		return model.Order{}, err
 	}
 
-	if order.Status == model.OrderStatusPAID {
-		return model.Order{}, model.ErrOrderAlreadyPaid
+	const reason = "cancelled by user"
+	cancelledAt := time.Now().UTC()
+	err = order.TransitionTo(model.OrderStatusCANCELLED, reason, cancelledAt)
+	if err != nil {
+		return model.Order{}, err
+	}
+	err = recordEvent(&order, converter.OrderCancelledToEvent(order, reason), cancelledAt)
+	if err != nil {
+		return model.Order{}, err
 	}
-
-	order.Status = model.OrderStatusCANCELLED
 
 	err = s.repo.Update(ctx, order)
 	if err != nil {
 		return model.Order{}, err
 	}
+	metrics.OrderCancelled()
+	s.releaseReservation(ctx, order.OrderUUID)
 
 	return order, nil
 }
 
-func (s *orderService) PayOrder(ctx context.Context, input model.PayOrderInput) (model.PayOrderOutput, error) {
-	order, err := s.repo.Get(ctx, input.OrderUUID.String())
+// ExpireOverdueOrders переводит в статус EXPIRED заказы, не оплаченные до крайнего
+// срока, и снимает резерв их деталей. Безопасен при запуске в нескольких репликах:
+// заказ, измененный другой репликой или оплатой, пропускается по конфликту версий.
+func (s *orderService) ExpireOverdueOrders(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	orders, err := s.repo.ListOverdue(ctx, now.Add(-paymentDeadlineGrace), expireBatchSize)
 	if err != nil {
-		return model.PayOrderOutput{}, err
+		return 0, err
 	}
 
-	txUUID, err := s.paymentClient.PayOrder(ctx, order.UserUUID, order.OrderUUID, input.PaymentMethod)
+	const reason = "payment deadline exceeded"
+	var expired int
+	for _, order := range orders {
+		err = order.TransitionTo(model.OrderStatusEXPIRED, reason, now)
+		if err != nil {
+			continue
+		}
+		err = recordEvent(&order, converter.OrderExpiredToEvent(order, reason), now)
+		if err != nil {
+			return expired, err
+		}
+
+		err = s.repo.Update(ctx, order)
+		if errors.Is(err, model.ErrOrderConflict) {
+			continue
+		}
+		if err != nil {
+			return expired, err
+		}
+
+		metrics.OrderExpired()
+		s.releaseReservation(ctx, order.OrderUUID)
+		expired++
+	}
+
+	return expired, nil
+}
+
+// DeleteExpiredIdempotencyKeys удаляет ключи идемпотентности, срок хранения
+// которых истек. После этого ключ можно использовать для нового запроса.
+func (s *orderService) DeleteExpiredIdempotencyKeys(ctx context.Context) (int, error) {
+	return s.idempotencyRepo.DeleteExpired(ctx, time.Now().UTC(), idempotencyKeysBatchSize)
+}
+
+// recordEvent добавляет событие в NewEvents заказа, чтобы хранилище
+// записало его в outbox в одной транзакции с заказом
+func recordEvent(order *model.Order, event *genOrderEventsV1.OrderEvent, at time.Time) error {
+	msg, err := converter.EventToOutboxMessage(event, order.OrderUUID, at)
+	if err != nil {
+		return err
+	}
+	order.NewEvents = append(order.NewEvents, msg)
+	return nil
+}
+
+// partPrice возвращает цену детали. Старые версии inventory передают
+// только устаревшее поле price, его переводим в валюту по умолчанию.
+func partPrice(part *genInventoryV1.Part) (money.Money, error) {
+	if price := part.GetPriceMoney(); price != nil {
+		return money.New(price.GetAmount(), price.GetCurrency()), nil
+	}
+	return money.FromFloat(part.GetPrice(), money.DefaultCurrency) //nolint:staticcheck // совместимость со старым inventory
+}
+
+func partCategoryFromProto(category genInventoryV1.Category) model.PartCategory {
+	switch category {
+	case genInventoryV1.Category_CATEGORY_ENGINE:
+		return model.PartCategoryENGINE
+	case genInventoryV1.Category_CATEGORY_FUEL:
+		return model.PartCategoryFUEL
+	case genInventoryV1.Category_CATEGORY_PORTHOLE:
+		return model.PartCategoryPORTHOLE
+	case genInventoryV1.Category_CATEGORY_WING:
+		return model.PartCategoryWING
+	case genInventoryV1.Category_CATEGORY_SHIELD:
+		return model.PartCategorySHIELD
+	default:
+		return model.PartCategoryUNSPECIFIED
+	}
+}
+
+// releaseReservation возвращает зарезервированные для заказа детали на склад.
+// Ошибка только логируется: результат операции над заказом от нее не зависит.
+func (s *orderService) releaseReservation(ctx context.Context, orderUUID uuid.UUID) {
+	err := s.inventoryClient.ReleaseReservation(ctx, orderUUID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to release reservation", slog.String("order_uuid", orderUUID.String()), slog.Any("error", err))
+	}
+}
+
+func (s *orderService) PayOrder(ctx context.Context, input model.PayOrderInput) (model.PayOrderOutput, error) {
+	return withIdempotency(ctx, s.idempotencyRepo, s.idempotencyKeyTTL, operationPayOrder, input.IdempotencyKey, input,
+		func() (model.PayOrderOutput, error) {
+			return s.payOrder(ctx, input)
+		})
+}
+
+func (s *orderService) payOrder(ctx context.Context, input model.PayOrderInput) (model.PayOrderOutput, error) {
+	order, err := s.getOrder(ctx, input.OrderUUID.String())
 	if err != nil {
-		log.Println("failed to process payment:", err)
 		return model.PayOrderOutput{}, err
 	}
 
-	order.Status = model.OrderStatusPAID
-	order.PaymentMethod = &input.PaymentMethod
-	order.TransactionUUID = txUUID
+	// Проверяем переход заранее, чтобы не списывать средства за заказ,
+	// который уже нельзя оплатить
+	if !order.Status.CanTransitionTo(model.OrderStatusPAID) {
+		return model.PayOrderOutput{}, &model.StatusTransitionError{From: order.Status, To: model.OrderStatusPAID}
+	}
+	if !time.Now().Before(order.PaymentDeadline) {
+		return model.PayOrderOutput{}, model.ErrPaymentDeadlineExceeded
+	}
 
+	// Захватываем версию заказа до списания средств: если заказ успели изменить
+	// после чтения, обновление завершится конфликтом и оплата не будет проведена.
 	err = s.repo.Update(ctx, order)
 	if err != nil {
 		return model.PayOrderOutput{}, err
 	}
+	order.Version++
+
+	// Списание средств выполняется сагой: если оплату не удастся сохранить
+	// в заказе, средства вернутся, а прерванная сага продолжится после перезапуска
+	saga := model.Saga{
+		Type:          model.SagaTypePayOrder,
+		OrderUUID:     order.OrderUUID,
+		UserUUID:      order.UserUUID,
+		PaymentMethod: &input.PaymentMethod,
+		Amount:        order.TotalPrice,
+	}
+	steps := s.payOrderSteps(&order)
+	err = s.startSaga(ctx, &saga, steps)
+	if err != nil {
+		return model.PayOrderOutput{}, err
+	}
+	err = s.runSaga(ctx, &saga, steps)
+	if err != nil {
+		return model.PayOrderOutput{}, err
+	}
 
 	return model.PayOrderOutput{
-		TransactionUUID: *txUUID,
+		TransactionUUID: *saga.TransactionUUID,
+	}, nil
+}
+
+func (s *orderService) RefundOrder(ctx context.Context, input model.RefundOrderInput) (model.RefundOrderOutput, error) {
+	return withIdempotency(ctx, s.idempotencyRepo, s.idempotencyKeyTTL, operationRefundOrder, input.IdempotencyKey, input,
+		func() (model.RefundOrderOutput, error) {
+			return s.refundOrder(ctx, input)
+		})
+}
+
+func (s *orderService) refundOrder(ctx context.Context, input model.RefundOrderInput) (model.RefundOrderOutput, error) {
+	order, err := s.getOrder(ctx, input.OrderUUID.String())
+	if err != nil {
+		return model.RefundOrderOutput{}, err
+	}
+
+	// Из PAID и PARTIALLY_REFUNDED разрешены оба статуса возврата, поэтому
+	// проверяем переход заранее, чтобы не возвращать средства за неоплаченный
+	// или уже полностью возвращенный заказ
+	if !order.Status.CanTransitionTo(model.OrderStatusREFUNDED) {
+		return model.RefundOrderOutput{}, &model.StatusTransitionError{From: order.Status, To: model.OrderStatusREFUNDED}
+	}
+
+	refundable, err := order.TotalPrice.Sub(order.RefundedAmount)
+	if err != nil {
+		return model.RefundOrderOutput{}, err
+	}
+	amount := refundable
+	if input.Amount != nil {
+		amount = *input.Amount
+		if amount.Amount <= 0 || amount.Currency != order.TotalPrice.Currency {
+			return model.RefundOrderOutput{}, model.ErrInvalidRefundAmount
+		}
+	}
+	if amount.Amount > refundable.Amount || amount.IsZero() {
+		return model.RefundOrderOutput{}, model.ErrRefundExceedsPayment
+	}
+	totalRefunded, err := order.RefundedAmount.Add(amount)
+	if err != nil {
+		return model.RefundOrderOutput{}, err
+	}
+
+	status := model.OrderStatusPARTIALLYREFUNDED
+	if totalRefunded.Amount == order.TotalPrice.Amount {
+		status = model.OrderStatusREFUNDED
+	}
+	if order.TransactionUUID == nil {
+		return model.RefundOrderOutput{}, fmt.Errorf("order %s has no payment transaction", order.OrderUUID)
+	}
+
+	reason := input.Reason
+	if reason == "" {
+		reason = "refund requested"
+	}
+
+	// Возврат выполняется сагой: если сохранить его в заказе не удастся,
+	// прерванная сага продолжится после перезапуска и не вернет средства повторно
+	saga := model.Saga{
+		Type:            model.SagaTypeRefundOrder,
+		OrderUUID:       order.OrderUUID,
+		UserUUID:        order.UserUUID,
+		Amount:          amount,
+		TransactionUUID: order.TransactionUUID,
+		Reason:          reason,
+		RefundedBefore:  order.RefundedAmount,
+	}
+	steps := s.refundOrderSteps(&order)
+	err = s.startSaga(ctx, &saga, steps)
+	if err != nil {
+		return model.RefundOrderOutput{}, err
+	}
+	err = s.runSaga(ctx, &saga, steps)
+	if err != nil {
+		return model.RefundOrderOutput{}, err
+	}
+
+	return model.RefundOrderOutput{
+		RefundUUID:     saga.SagaUUID,
+		RefundedAmount: amount,
+		TotalRefunded:  totalRefunded,
+		Status:         status,
 	}, nil
 }
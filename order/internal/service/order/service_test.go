@@ -1 +1,723 @@
 package order
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/utils"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+	genCommonV1 "github.com/xgmsx/rsf/shared/pkg/proto/common/v1"
+	genInventoryV1 "github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1"
+)
+
+func newPendingOrder() model.Order {
+	createdAt := time.Now().UTC()
+	return model.Order{
+		OrderUUID:       uuid.New(),
+		UserUUID:        uuid.New(),
+		Items:           []model.OrderItem{{PartUUID: uuid.New(), Quantity: 1, UnitPrice: money.New(10000, money.DefaultCurrency)}},
+		TotalPrice:      money.New(10000, money.DefaultCurrency),
+		Status:          model.OrderStatusPENDINGPAYMENT,
+		CreatedAt:       createdAt,
+		PaymentDeadline: createdAt.Add(testPaymentTimeout),
+		Version:         1,
+	}
+}
+
+func newPaidOrder() model.Order {
+	order := newPendingOrder()
+	order.Status = model.OrderStatusPAID
+	order.PaymentMethod = utils.ToPtr(model.PaymentMethodCARD)
+	order.TransactionUUID = utils.ToPtr(uuid.New())
+	order.RefundedAmount = money.Zero(money.DefaultCurrency)
+	return order
+}
+
+func newOverdueOrder() model.Order {
+	order := newPendingOrder()
+	order.CreatedAt = order.CreatedAt.Add(-2 * testPaymentTimeout)
+	order.PaymentDeadline = order.CreatedAt.Add(testPaymentTimeout)
+	return order
+}
+
+func (s *ServiceSuite) TestCreateOrder() {
+	engineUUID, shieldUUID := uuid.New(), uuid.New()
+	parts := []*genInventoryV1.Part{
+		{
+			Uuid:       engineUUID.String(),
+			Name:       "Hyperdrive Engine",
+			PriceMoney: &genCommonV1.Money{Amount: 10000, Currency: "RUB"},
+			Category:   genInventoryV1.Category_CATEGORY_ENGINE,
+		},
+		// Старая версия inventory передает только цену с плавающей точкой
+		{
+			Uuid:     shieldUUID.String(),
+			Name:     "Quantum Shield Generator",
+			Price:    11.725,
+			Category: genInventoryV1.Category_CATEGORY_SHIELD,
+		},
+	}
+	// Повторяющаяся деталь объединяется в одну позицию
+	input := model.CreateOrderInput{
+		UserUUID: uuid.New(),
+		Items: []model.CreateOrderItem{
+			{PartUUID: engineUUID, Quantity: 1},
+			{PartUUID: shieldUUID, Quantity: 2},
+			{PartUUID: engineUUID, Quantity: 1},
+		},
+	}
+	partUUIDs := []uuid.UUID{engineUUID, shieldUUID}
+	expectedItems := []model.OrderItem{
+		{PartUUID: engineUUID, Quantity: 2, UnitPrice: money.New(10000, "RUB"), Name: "Hyperdrive Engine", Category: model.PartCategoryENGINE},
+		{PartUUID: shieldUUID, Quantity: 2, UnitPrice: money.New(1173, "RUB"), Name: "Quantum Shield Generator", Category: model.PartCategorySHIELD},
+	}
+
+	testCases := []struct {
+		name               string
+		expectedErr        error
+		expectedSagaStatus model.SagaStatus
+		setupMock          func()
+	}{
+		{
+			name:               "Happy path",
+			expectedSagaStatus: model.SagaStatusCOMPLETED,
+			setupMock: func() {
+				s.inventoryClient.On("GetParts", s.ctx, partUUIDs).Return(parts, nil).Once()
+				s.inventoryClient.On("ReserveParts", s.ctx, mock.Anything, expectedItems, testPaymentTimeout+paymentDeadlineGrace).Return(nil).Once()
+				s.orderRepo.On("Create", s.ctx, mock.MatchedBy(func(o model.Order) bool {
+					return o.Status == model.OrderStatusPENDINGPAYMENT && len(o.NewStatusChanges) == 1 &&
+						o.TotalPrice == money.New(22346, "RUB") && len(o.Items) == 2 &&
+						o.PaymentDeadline.Equal(o.CreatedAt.Add(testPaymentTimeout)) &&
+						len(o.NewEvents) == 1 && o.NewEvents[0].EventType == "order.v1.OrderCreated"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:        "Part does not exist",
+			expectedErr: model.ErrPartDoesNotExist,
+			setupMock: func() {
+				s.inventoryClient.On("GetParts", s.ctx, partUUIDs).Return(parts[:1], nil).Once()
+			},
+		},
+		{
+			name:               "Parts out of stock",
+			expectedErr:        model.ErrPartsOutOfStock,
+			expectedSagaStatus: model.SagaStatusCOMPENSATED,
+			setupMock: func() {
+				s.inventoryClient.On("GetParts", s.ctx, partUUIDs).Return(parts, nil).Once()
+				s.inventoryClient.On("ReserveParts", s.ctx, mock.Anything, expectedItems, testPaymentTimeout+paymentDeadlineGrace).Return(model.ErrPartsOutOfStock).Once()
+			},
+		},
+		{
+			name:               "Reservation released when order is not saved",
+			expectedErr:        model.ErrOrderExists,
+			expectedSagaStatus: model.SagaStatusCOMPENSATED,
+			setupMock: func() {
+				s.inventoryClient.On("GetParts", s.ctx, partUUIDs).Return(parts, nil).Once()
+				s.inventoryClient.On("ReserveParts", s.ctx, mock.Anything, expectedItems, testPaymentTimeout+paymentDeadlineGrace).Return(nil).Once()
+				s.orderRepo.On("Create", s.ctx, mock.Anything).Return(model.ErrOrderExists).Once()
+				s.inventoryClient.On("ReleaseReservation", s.ctx, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			s.savedSagas = nil
+			tc.setupMock()
+
+			// act
+			output, err := s.service.CreateOrder(s.ctx, input)
+
+			// assert
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Empty(output)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(money.New(22346, "RUB"), output.TotalPrice)
+			}
+			if tc.expectedSagaStatus != "" {
+				s.Require().Equal(model.SagaTypeCreateOrder, s.lastSaga().Type)
+				s.Require().Equal(tc.expectedSagaStatus, s.lastSaga().Status)
+			} else {
+				s.Require().Empty(s.savedSagas)
+			}
+		})
+	}
+}
+
+func (s *ServiceSuite) TestPayOrder() {
+	txUUID := uuid.New()
+
+	testCases := []struct {
+		name               string
+		order              model.Order
+		expectedErr        error
+		expectedSagaStatus model.SagaStatus
+		setupMock          func(model.Order)
+	}{
+		{
+			name:               "Happy path",
+			order:              newPendingOrder(),
+			expectedSagaStatus: model.SagaStatusCOMPLETED,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(nil).Once()
+				s.paymentClient.On("PayOrder", s.ctx, order.UserUUID, order.OrderUUID, model.PaymentMethodCARD, order.TotalPrice).
+					Return(&txUUID, nil).Once()
+				s.inventoryClient.On("CommitReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.MatchedBy(func(o model.Order) bool {
+					return o.Version == order.Version+1 && o.Status == model.OrderStatusPAID && *o.TransactionUUID == txUUID &&
+						len(o.NewStatusChanges) == 1 && o.NewStatusChanges[0].From == model.OrderStatusPENDINGPAYMENT &&
+						len(o.NewEvents) == 1 && o.NewEvents[0].EventType == "order.v1.OrderPaid"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "Cancelled order",
+			order: func() model.Order {
+				order := newPendingOrder()
+				order.Status = model.OrderStatusCANCELLED
+				return order
+			}(),
+			expectedErr: model.ErrInvalidStatusTransition,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name: "Already paid order",
+			order: func() model.Order {
+				order := newPendingOrder()
+				order.Status = model.OrderStatusPAID
+				return order
+			}(),
+			expectedErr: model.ErrInvalidStatusTransition,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name:        "Payment deadline exceeded",
+			order:       newOverdueOrder(),
+			expectedErr: model.ErrPaymentDeadlineExceeded,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name:        "Order changed before payment",
+			order:       newPendingOrder(),
+			expectedErr: model.ErrOrderConflict,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(model.ErrOrderConflict).Once()
+			},
+		},
+		{
+			name:               "Payment refunded when order cancelled during payment",
+			order:              newPendingOrder(),
+			expectedErr:        model.ErrInvalidStatusTransition,
+			expectedSagaStatus: model.SagaStatusCOMPENSATED,
+			setupMock: func(order model.Order) {
+				cancelled := order
+				cancelled.Status = model.OrderStatusCANCELLED
+				cancelled.Version = order.Version + 2
+
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(nil).Once()
+				s.paymentClient.On("PayOrder", s.ctx, order.UserUUID, order.OrderUUID, model.PaymentMethodCARD, order.TotalPrice).
+					Return(&txUUID, nil).Once()
+				s.inventoryClient.On("CommitReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.Anything).Return(model.ErrOrderConflict).Once()
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(cancelled, nil).Once()
+				s.inventoryClient.On("ReleaseReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+				s.paymentClient.On("RefundPayment", s.ctx, txUUID, mock.Anything, order.TotalPrice, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name:               "Confirmation retried after conflict",
+			order:              newPendingOrder(),
+			expectedSagaStatus: model.SagaStatusCOMPLETED,
+			setupMock: func(order model.Order) {
+				// Заказ изменился, но его все еще можно оплатить
+				changed := order
+				changed.Version = order.Version + 2
+
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(nil).Once()
+				s.paymentClient.On("PayOrder", s.ctx, order.UserUUID, order.OrderUUID, model.PaymentMethodCARD, order.TotalPrice).
+					Return(&txUUID, nil).Once()
+				s.inventoryClient.On("CommitReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.MatchedBy(func(o model.Order) bool {
+					return o.Version == order.Version+1
+				})).Return(model.ErrOrderConflict).Once()
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(changed, nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.MatchedBy(func(o model.Order) bool {
+					return o.Version == changed.Version && o.Status == model.OrderStatusPAID && *o.TransactionUUID == txUUID
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:               "Saga left for recovery when order cannot be saved",
+			order:              newPendingOrder(),
+			expectedErr:        assert.AnError,
+			expectedSagaStatus: model.SagaStatusRUNNING,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(nil).Once()
+				s.paymentClient.On("PayOrder", s.ctx, order.UserUUID, order.OrderUUID, model.PaymentMethodCARD, order.TotalPrice).
+					Return(&txUUID, nil).Once()
+				s.inventoryClient.On("CommitReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.Anything).Return(assert.AnError).Once()
+			},
+		},
+		{
+			name:               "Payment declined",
+			order:              newPendingOrder(),
+			expectedErr:        model.ErrPaymentDeclined,
+			expectedSagaStatus: model.SagaStatusCOMPENSATED,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(nil).Once()
+				s.paymentClient.On("PayOrder", s.ctx, order.UserUUID, order.OrderUUID, model.PaymentMethodCARD, order.TotalPrice).
+					Return(nil, model.ErrPaymentDeclined).Once()
+			},
+		},
+		{
+			name:               "Payment service unavailable",
+			order:              newPendingOrder(),
+			expectedErr:        model.ErrServiceUnavailable,
+			expectedSagaStatus: model.SagaStatusRUNNING,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(nil).Once()
+				s.paymentClient.On("PayOrder", s.ctx, order.UserUUID, order.OrderUUID, model.PaymentMethodCARD, order.TotalPrice).
+					Return(nil, model.ErrServiceUnavailable).Once()
+			},
+		},
+		{
+			name:               "Reservation expired",
+			order:              newPendingOrder(),
+			expectedErr:        model.ErrReservationExpired,
+			expectedSagaStatus: model.SagaStatusCOMPENSATED,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(nil).Once()
+				s.paymentClient.On("PayOrder", s.ctx, order.UserUUID, order.OrderUUID, model.PaymentMethodCARD, order.TotalPrice).
+					Return(&txUUID, nil).Once()
+				s.inventoryClient.On("CommitReservation", s.ctx, order.OrderUUID).Return(model.ErrReservationExpired).Once()
+				s.paymentClient.On("RefundPayment", s.ctx, txUUID, mock.Anything, order.TotalPrice, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name:        "Order not found",
+			order:       newPendingOrder(),
+			expectedErr: model.ErrOrderNotFound,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(model.Order{}, model.ErrOrderNotFound).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			s.savedSagas = nil
+			tc.setupMock(tc.order)
+
+			// act
+			output, err := s.service.PayOrder(s.ctx, model.PayOrderInput{
+				OrderUUID:     tc.order.OrderUUID,
+				PaymentMethod: model.PaymentMethodCARD,
+			})
+
+			// assert
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Empty(output)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(txUUID, output.TransactionUUID)
+			}
+			if tc.expectedSagaStatus != "" {
+				s.Require().Equal(model.SagaTypePayOrder, s.lastSaga().Type)
+				s.Require().Equal(tc.expectedSagaStatus, s.lastSaga().Status)
+			} else {
+				s.Require().Empty(s.savedSagas)
+			}
+		})
+	}
+}
+
+func (s *ServiceSuite) TestCancelOrder() {
+	testCases := []struct {
+		name        string
+		order       model.Order
+		expectedErr error
+		setupMock   func(model.Order)
+	}{
+		{
+			name:  "Happy path",
+			order: newPendingOrder(),
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.MatchedBy(func(o model.Order) bool {
+					return o.Version == order.Version && o.Status == model.OrderStatusCANCELLED &&
+						len(o.NewStatusChanges) == 1 && o.NewStatusChanges[0].To == model.OrderStatusCANCELLED &&
+						len(o.NewEvents) == 1 && o.NewEvents[0].EventType == "order.v1.OrderCancelled"
+				})).Return(nil).Once()
+				s.inventoryClient.On("ReleaseReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+			},
+		},
+		{
+			name: "Paid order",
+			order: func() model.Order {
+				order := newPendingOrder()
+				order.Status = model.OrderStatusPAID
+				return order
+			}(),
+			expectedErr: model.ErrInvalidStatusTransition,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name:        "Order changed concurrently",
+			order:       newPendingOrder(),
+			expectedErr: model.ErrOrderConflict,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.Anything).Return(model.ErrOrderConflict).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			tc.setupMock(tc.order)
+
+			// act
+			order, err := s.service.CancelOrder(s.ctx, tc.order.OrderUUID.String())
+
+			// assert
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Empty(order)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(model.OrderStatusCANCELLED, order.Status)
+			}
+		})
+	}
+}
+
+func (s *ServiceSuite) TestExpireOverdueOrders() {
+	testCases := []struct {
+		name            string
+		orders          []model.Order
+		expectedExpired int
+		expectedErr     error
+		setupMock       func([]model.Order)
+	}{
+		{
+			name:            "Overdue orders expired",
+			orders:          []model.Order{newOverdueOrder(), newOverdueOrder()},
+			expectedExpired: 2,
+			setupMock: func(orders []model.Order) {
+				s.orderRepo.On("ListOverdue", s.ctx, mock.Anything, expireBatchSize).Return(orders, nil).Once()
+				for _, order := range orders {
+					s.orderRepo.On("Update", s.ctx, mock.MatchedBy(func(o model.Order) bool {
+						return o.OrderUUID == order.OrderUUID && o.Status == model.OrderStatusEXPIRED &&
+							len(o.NewStatusChanges) == 1 && o.NewStatusChanges[0].Reason == "payment deadline exceeded" &&
+							len(o.NewEvents) == 1 && o.NewEvents[0].EventType == "order.v1.OrderExpired"
+					})).Return(nil).Once()
+					s.inventoryClient.On("ReleaseReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+				}
+			},
+		},
+		{
+			name:            "Order changed by another replica is skipped",
+			orders:          []model.Order{newOverdueOrder(), newOverdueOrder()},
+			expectedExpired: 1,
+			setupMock: func(orders []model.Order) {
+				s.orderRepo.On("ListOverdue", s.ctx, mock.Anything, expireBatchSize).Return(orders, nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.MatchedBy(func(o model.Order) bool {
+					return o.OrderUUID == orders[0].OrderUUID
+				})).Return(model.ErrOrderConflict).Once()
+				s.orderRepo.On("Update", s.ctx, mock.MatchedBy(func(o model.Order) bool {
+					return o.OrderUUID == orders[1].OrderUUID
+				})).Return(nil).Once()
+				s.inventoryClient.On("ReleaseReservation", s.ctx, orders[1].OrderUUID).Return(nil).Once()
+			},
+		},
+		{
+			name:        "Repository error",
+			expectedErr: assert.AnError,
+			setupMock: func([]model.Order) {
+				s.orderRepo.On("ListOverdue", s.ctx, mock.Anything, expireBatchSize).Return(nil, assert.AnError).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			tc.setupMock(tc.orders)
+
+			// act
+			expired, err := s.service.ExpireOverdueOrders(s.ctx)
+
+			// assert
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				s.Require().NoError(err)
+			}
+			s.Require().Equal(tc.expectedExpired, expired)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestListOrders() {
+	orders := []model.Order{newPendingOrder(), newPendingOrder(), newPendingOrder()}
+	filter := model.OrdersFilter{UserUUID: &orders[0].UserUUID}
+
+	s.Run("First page with next cursor", func() {
+		s.orderRepo.On("List", s.ctx, filter, model.OrdersPage{
+			Sort:  model.OrdersSortCreatedAtDesc,
+			Limit: 3,
+		}).Return(orders, nil).Once()
+
+		output, err := s.service.ListOrders(s.ctx, model.ListOrdersInput{Filter: filter, Limit: 2})
+		s.Require().NoError(err)
+		s.Require().Equal(orders[:2], output.Orders)
+		s.Require().NotEmpty(output.NextCursor)
+
+		after, err := decodeCursor(output.NextCursor)
+		s.Require().NoError(err)
+		s.Require().Equal(orders[1].OrderUUID, after.OrderUUID)
+	})
+
+	s.Run("Last page without next cursor", func() {
+		after := &model.OrdersCursor{CreatedAt: orders[1].CreatedAt.Add(1), OrderUUID: orders[1].OrderUUID}
+		s.orderRepo.On("List", s.ctx, filter, model.OrdersPage{
+			Sort:  model.OrdersSortCreatedAtAsc,
+			After: after,
+			Limit: 21,
+		}).Return(orders[2:], nil).Once()
+
+		output, err := s.service.ListOrders(s.ctx, model.ListOrdersInput{
+			Filter: filter,
+			Sort:   model.OrdersSortCreatedAtAsc,
+			Cursor: encodeCursor(model.Order{CreatedAt: after.CreatedAt, OrderUUID: after.OrderUUID}),
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(orders[2:], output.Orders)
+		s.Require().Empty(output.NextCursor)
+	})
+
+	s.Run("Invalid cursor", func() {
+		_, err := s.service.ListOrders(s.ctx, model.ListOrdersInput{Cursor: "not a cursor"})
+		s.Require().ErrorIs(err, model.ErrInvalidCursor)
+	})
+}
+
+func (s *ServiceSuite) TestRefundOrder() {
+	testCases := []struct {
+		name               string
+		order              model.Order
+		amount             *money.Money
+		expectedStatus     model.OrderStatus
+		expectedTotal      money.Money
+		expectedErr        error
+		expectedSagaStatus model.SagaStatus
+		setupMock          func(model.Order)
+	}{
+		{
+			name:               "Full refund",
+			expectedSagaStatus: model.SagaStatusCOMPLETED,
+			order:              newPaidOrder(),
+			expectedStatus:     model.OrderStatusREFUNDED,
+			expectedTotal:      money.New(10000, money.DefaultCurrency),
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(nil).Once()
+				s.paymentClient.On("RefundPayment", s.ctx, *order.TransactionUUID, mock.Anything, order.TotalPrice, "refund requested").
+					Return(nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.MatchedBy(func(o model.Order) bool {
+					return o.Version == order.Version+1 && o.Status == model.OrderStatusREFUNDED &&
+						o.RefundedAmount == order.TotalPrice &&
+						len(o.NewStatusChanges) == 1 && o.NewStatusChanges[0].From == model.OrderStatusPAID &&
+						len(o.NewEvents) == 1 && o.NewEvents[0].EventType == "order.v1.OrderRefunded"
+				})).Return(nil).Once()
+				s.inventoryClient.On("ReleaseReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+			},
+		},
+		{
+			name:               "Partial refund keeps parts",
+			expectedSagaStatus: model.SagaStatusCOMPLETED,
+			order:              newPaidOrder(),
+			amount:             utils.ToPtr(money.New(2500, money.DefaultCurrency)),
+			expectedStatus:     model.OrderStatusPARTIALLYREFUNDED,
+			expectedTotal:      money.New(2500, money.DefaultCurrency),
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(nil).Once()
+				s.paymentClient.On("RefundPayment", s.ctx, *order.TransactionUUID, mock.Anything, money.New(2500, money.DefaultCurrency), "refund requested").
+					Return(nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.MatchedBy(func(o model.Order) bool {
+					return o.Status == model.OrderStatusPARTIALLYREFUNDED &&
+						o.RefundedAmount == money.New(2500, money.DefaultCurrency)
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:               "Rest of partially refunded order",
+			expectedSagaStatus: model.SagaStatusCOMPLETED,
+			order: func() model.Order {
+				order := newPaidOrder()
+				order.Status = model.OrderStatusPARTIALLYREFUNDED
+				order.RefundedAmount = money.New(2500, money.DefaultCurrency)
+				return order
+			}(),
+			expectedStatus: model.OrderStatusREFUNDED,
+			expectedTotal:  money.New(10000, money.DefaultCurrency),
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(nil).Once()
+				s.paymentClient.On("RefundPayment", s.ctx, *order.TransactionUUID, mock.Anything, money.New(7500, money.DefaultCurrency), "refund requested").
+					Return(nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.MatchedBy(func(o model.Order) bool {
+					return o.Status == model.OrderStatusREFUNDED && o.RefundedAmount == order.TotalPrice
+				})).Return(nil).Once()
+				s.inventoryClient.On("ReleaseReservation", s.ctx, order.OrderUUID).Return(nil).Once()
+			},
+		},
+		{
+			name:        "Unpaid order",
+			order:       newPendingOrder(),
+			expectedErr: model.ErrInvalidStatusTransition,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name: "Already refunded order",
+			order: func() model.Order {
+				order := newPaidOrder()
+				order.Status = model.OrderStatusREFUNDED
+				order.RefundedAmount = order.TotalPrice
+				return order
+			}(),
+			expectedErr: model.ErrInvalidStatusTransition,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name:        "Refund exceeds paid amount",
+			order:       newPaidOrder(),
+			amount:      utils.ToPtr(money.New(10001, money.DefaultCurrency)),
+			expectedErr: model.ErrRefundExceedsPayment,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name:        "Refund in another currency",
+			order:       newPaidOrder(),
+			amount:      utils.ToPtr(money.New(100, "USD")),
+			expectedErr: model.ErrInvalidRefundAmount,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+			},
+		},
+		{
+			name:               "Payment service rejects refund",
+			order:              newPaidOrder(),
+			expectedErr:        model.ErrRefundExceedsPayment,
+			expectedSagaStatus: model.SagaStatusCOMPENSATED,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(nil).Once()
+				s.paymentClient.On("RefundPayment", s.ctx, *order.TransactionUUID, mock.Anything, order.TotalPrice, "refund requested").
+					Return(model.ErrRefundExceedsPayment).Once()
+			},
+		},
+		{
+			name:               "Payment service unavailable",
+			order:              newPaidOrder(),
+			expectedErr:        model.ErrServiceUnavailable,
+			expectedSagaStatus: model.SagaStatusRUNNING,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(nil).Once()
+				s.paymentClient.On("RefundPayment", s.ctx, *order.TransactionUUID, mock.Anything, order.TotalPrice, "refund requested").
+					Return(model.ErrServiceUnavailable).Once()
+			},
+		},
+		{
+			name:               "Refund not saved",
+			order:              newPaidOrder(),
+			expectedErr:        assert.AnError,
+			expectedSagaStatus: model.SagaStatusRUNNING,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(nil).Once()
+				s.paymentClient.On("RefundPayment", s.ctx, *order.TransactionUUID, mock.Anything, order.TotalPrice, "refund requested").
+					Return(nil).Once()
+				s.orderRepo.On("Update", s.ctx, mock.Anything).Return(assert.AnError).Once()
+			},
+		},
+		{
+			name:               "Order changed concurrently",
+			order:              newPaidOrder(),
+			expectedErr:        model.ErrOrderConflict,
+			expectedSagaStatus: model.SagaStatusCOMPENSATED,
+			setupMock: func(order model.Order) {
+				s.orderRepo.On("Get", s.ctx, order.OrderUUID.String()).Return(order, nil).Once()
+				s.orderRepo.On("Update", s.ctx, order).Return(model.ErrOrderConflict).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			s.savedSagas = nil
+			tc.setupMock(tc.order)
+			input := model.RefundOrderInput{
+				OrderUUID: tc.order.OrderUUID,
+				Amount:    tc.amount,
+			}
+
+			// act
+			output, err := s.service.RefundOrder(s.ctx, input)
+
+			// assert
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Empty(output)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(s.lastSaga().SagaUUID, output.RefundUUID)
+				s.Require().Equal(tc.expectedStatus, output.Status)
+				s.Require().Equal(tc.expectedTotal, output.TotalRefunded)
+			}
+			if tc.expectedSagaStatus != "" {
+				s.Require().Equal(model.SagaTypeRefundOrder, s.lastSaga().Type)
+				s.Require().Equal(tc.expectedSagaStatus, s.lastSaga().Status)
+			} else {
+				s.Require().Empty(s.savedSagas)
+			}
+		})
+	}
+}
@@ -1 +1,72 @@
 package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	clientMocks "github.com/xgmsx/rsf/order/internal/client/mocks"
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/repository/mocks"
+	"github.com/xgmsx/rsf/shared/pkg/auth"
+)
+
+const (
+	testPaymentTimeout    = 15 * time.Minute
+	testIdempotencyKeyTTL = 24 * time.Hour
+)
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx             context.Context //nolint:containedctx
+	orderRepo       *mocks.OrderRepository
+	sagaRepo        *mocks.SagaRepository
+	idempotencyRepo *mocks.IdempotencyRepository
+	inventoryClient *clientMocks.InventoryClient
+	paymentClient   *clientMocks.PaymentClient
+	service         *orderService
+
+	// savedSagas - все сохраненные состояния саг в порядке сохранения
+	savedSagas []model.Saga
+}
+
+func (s *ServiceSuite) SetupTest() {
+	// Бизнес-логику проверяем от имени администратора, которому доступны
+	// все заказы; проверки доступа пользователей - в TestOrderAccess
+	s.ctx = auth.ContextWithIdentity(context.Background(), auth.Identity{
+		UserUUID: uuid.New(),
+		Roles:    []string{auth.RoleAdmin},
+	})
+	s.orderRepo = mocks.NewOrderRepository(s.T())
+	s.sagaRepo = mocks.NewSagaRepository(s.T())
+	s.idempotencyRepo = mocks.NewIdempotencyRepository(s.T())
+	s.inventoryClient = clientMocks.NewInventoryClient(s.T())
+	s.paymentClient = clientMocks.NewPaymentClient(s.T())
+	s.service = NewOrderService(s.orderRepo, s.sagaRepo, s.idempotencyRepo, s.inventoryClient, s.paymentClient,
+		testPaymentTimeout, testIdempotencyKeyTTL)
+
+	s.savedSagas = nil
+	saveSaga := func(_ context.Context, saga model.Saga) error {
+		s.savedSagas = append(s.savedSagas, saga)
+		return nil
+	}
+	s.sagaRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(saveSaga).Maybe()
+	s.sagaRepo.EXPECT().Update(mock.Anything, mock.Anything).RunAndReturn(saveSaga).Maybe()
+}
+
+// lastSaga возвращает последнее сохраненное состояние саги
+func (s *ServiceSuite) lastSaga() model.Saga {
+	s.Require().NotEmpty(s.savedSagas)
+	return s.savedSagas[len(s.savedSagas)-1]
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestOrderService(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/xgmsx/rsf/order/internal/model"
 )
 
@@ -11,4 +13,31 @@ type OrderService interface {
 	PayOrder(ctx context.Context, request model.PayOrderInput) (model.PayOrderOutput, error)
 	CreateOrder(ctx context.Context, order model.CreateOrderInput) (model.CreateOrderOutput, error)
 	GetOrder(ctx context.Context, orderUUID string) (model.Order, error)
+	ListOrders(ctx context.Context, input model.ListOrdersInput) (model.ListOrdersOutput, error)
+	GetOrderStatusHistory(ctx context.Context, orderUUID string) ([]model.OrderStatusChange, error)
+	ExpireOverdueOrders(ctx context.Context) (int, error)
+	// DeleteExpiredIdempotencyKeys удаляет ключи идемпотентности с истекшим
+	// сроком хранения и возвращает число удаленных
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int, error)
+	// RefundOrder возвращает часть или весь остаток оплаты заказа. После возврата
+	// всего остатка заказ переходит в статус REFUNDED и детали возвращаются на склад.
+	RefundOrder(ctx context.Context, input model.RefundOrderInput) (model.RefundOrderOutput, error)
+	// RecoverSagas продолжает или откатывает саги создания и оплаты заказов,
+	// прерванные перезапуском сервиса
+	RecoverSagas(ctx context.Context) (int, error)
+}
+
+type WebhookService interface {
+	CreateWebhook(ctx context.Context, input model.WebhookInput) (model.WebhookSubscription, error)
+	GetWebhook(ctx context.Context, webhookUUID uuid.UUID) (model.WebhookSubscription, error)
+	ListWebhooks(ctx context.Context) ([]model.WebhookSubscription, error)
+	UpdateWebhook(ctx context.Context, webhookUUID uuid.UUID, input model.WebhookInput) (model.WebhookSubscription, error)
+	DeleteWebhook(ctx context.Context, webhookUUID uuid.UUID) error
+	RotateWebhookSecret(ctx context.Context, webhookUUID uuid.UUID) (model.WebhookSubscription, error)
+	ListDeadLetters(ctx context.Context, webhookUUID uuid.UUID) ([]model.WebhookDelivery, error)
+	ReplayDeadLetter(ctx context.Context, webhookUUID, deliveryUUID uuid.UUID) (model.WebhookDelivery, error)
+	// EnqueueDeliveries создает доставки событий outbox подписанным на них вебхукам
+	EnqueueDeliveries(ctx context.Context, events []model.OutboxMessage) error
+	// DeliverDue отправляет доставки, время попытки которых наступило
+	DeliverDue(ctx context.Context) (int, error)
 }
@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/model/converter"
+	"github.com/xgmsx/rsf/shared/pkg/auth"
+)
+
+func userContext(roles ...string) context.Context {
+	return auth.ContextWithIdentity(context.Background(), auth.Identity{UserUUID: uuid.New(), Roles: roles})
+}
+
+// TestWebhookAccess проверяет, что вызывающему без роли администратора вебхуки
+// не видны: каждая операция возвращает model.ErrWebhookNotFound, не обращаясь к хранилищу
+func (s *ServiceSuite) TestWebhookAccess() {
+	webhookUUID := uuid.New()
+	input := model.WebhookInput{
+		URL:        "https://erp.example.com/hooks/orders",
+		EventTypes: []model.WebhookEventType{model.WebhookEventOrderPaid},
+	}
+
+	operations := []struct {
+		name string
+		call func(ctx context.Context) error
+	}{
+		{
+			name: "CreateWebhook",
+			call: func(ctx context.Context) error {
+				_, err := s.service.CreateWebhook(ctx, input)
+				return err
+			},
+		},
+		{
+			name: "GetWebhook",
+			call: func(ctx context.Context) error {
+				_, err := s.service.GetWebhook(ctx, webhookUUID)
+				return err
+			},
+		},
+		{
+			name: "ListWebhooks",
+			call: func(ctx context.Context) error {
+				_, err := s.service.ListWebhooks(ctx)
+				return err
+			},
+		},
+		{
+			name: "UpdateWebhook",
+			call: func(ctx context.Context) error {
+				_, err := s.service.UpdateWebhook(ctx, webhookUUID, input)
+				return err
+			},
+		},
+		{
+			name: "DeleteWebhook",
+			call: func(ctx context.Context) error {
+				return s.service.DeleteWebhook(ctx, webhookUUID)
+			},
+		},
+		{
+			name: "RotateWebhookSecret",
+			call: func(ctx context.Context) error {
+				_, err := s.service.RotateWebhookSecret(ctx, webhookUUID)
+				return err
+			},
+		},
+		{
+			name: "ListDeadLetters",
+			call: func(ctx context.Context) error {
+				_, err := s.service.ListDeadLetters(ctx, webhookUUID)
+				return err
+			},
+		},
+		{
+			name: "ReplayDeadLetter",
+			call: func(ctx context.Context) error {
+				_, err := s.service.ReplayDeadLetter(ctx, webhookUUID, uuid.New())
+				return err
+			},
+		},
+		{
+			name: "EnqueueDeliveries",
+			call: func(ctx context.Context) error {
+				return s.service.EnqueueDeliveries(ctx, []model.OutboxMessage{{EventUUID: uuid.New()}})
+			},
+		},
+	}
+
+	callers := []struct {
+		name string
+		ctx  context.Context //nolint:containedctx
+	}{
+		{name: "User", ctx: userContext()},
+		{name: "User with unrelated role", ctx: userContext("support")},
+	}
+
+	for _, op := range operations {
+		for _, caller := range callers {
+			s.Run(op.name+"/"+caller.name, func() {
+				// act
+				err := op.call(caller.ctx)
+
+				// assert
+				s.Require().ErrorIs(err, model.ErrWebhookNotFound)
+			})
+		}
+	}
+
+	s.Run("ListWebhooks/Anonymous", func() {
+		// act
+		_, err := s.service.ListWebhooks(context.Background())
+
+		// assert
+		s.Require().ErrorIs(err, model.ErrWebhookNotFound)
+	})
+
+	s.Run("EnqueueDeliveries/Background job", func() {
+		// arrange
+		ctx := context.Background()
+		subscriber := newSubscription(model.WebhookEventOrderPaid)
+		order := model.Order{OrderUUID: uuid.New(), UserUUID: uuid.New()}
+		event, err := converter.EventToOutboxMessage(converter.OrderPaidToEvent(order), order.OrderUUID, time.Now().UTC())
+		s.Require().NoError(err)
+		s.repo.On("ListSubscriptions", ctx).Return([]model.WebhookSubscription{subscriber}, nil).Once()
+		s.repo.On("CreateDeliveries", ctx, mock.Anything).Return(nil).Once()
+
+		// act
+		err = s.service.EnqueueDeliveries(ctx, []model.OutboxMessage{event})
+
+		// assert
+		s.Require().NoError(err)
+	})
+}
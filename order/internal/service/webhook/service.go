@@ -0,0 +1,394 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/netip"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/rsf/order/internal/client"
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/model/converter"
+	"github.com/xgmsx/rsf/order/internal/repository"
+	def "github.com/xgmsx/rsf/order/internal/service"
+	"github.com/xgmsx/rsf/shared/pkg/auth"
+)
+
+var _ def.WebhookService = (*webhookService)(nil)
+
+const (
+	// maxDeliveryAttempts - число попыток, после которого доставка
+	// переносится в список недоставленных (dead-letter)
+	maxDeliveryAttempts = 8
+	// retryBaseDelay и retryMaxDelay задают экспоненциальную задержку
+	// между попытками: 10с, 20с, 40с, ... но не больше часа
+	retryBaseDelay = 10 * time.Second
+	retryMaxDelay  = time.Hour
+	// deliveryLease - на сколько откладывается следующая попытка взятой
+	// в работу доставки, должно быть больше таймаута HTTP-клиента
+	deliveryLease    = time.Minute
+	deliverBatchSize = 50
+
+	secretPrefix = "whsec_"
+	secretSize   = 32
+)
+
+var knownEventTypes = []model.WebhookEventType{
+	model.WebhookEventOrderCreated,
+	model.WebhookEventOrderPaid,
+	model.WebhookEventOrderCancelled,
+	model.WebhookEventOrderRefunded,
+	model.WebhookEventOrderExpired,
+}
+
+type webhookService struct {
+	repo   repository.WebhookRepository
+	client client.WebhookClient
+	// lookupHost разрешает имя хоста адреса вебхука в IP-адреса
+	lookupHost func(ctx context.Context, host string) ([]netip.Addr, error)
+}
+
+func NewWebhookService(repo repository.WebhookRepository, client client.WebhookClient) *webhookService {
+	return &webhookService{
+		repo:   repo,
+		client: client,
+		lookupHost: func(ctx context.Context, host string) ([]netip.Addr, error) {
+			return net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+		},
+	}
+}
+
+func (s *webhookService) CreateWebhook(ctx context.Context, input model.WebhookInput) (model.WebhookSubscription, error) {
+	if !isAdmin(ctx) {
+		return model.WebhookSubscription{}, model.ErrWebhookNotFound
+	}
+	eventTypes, err := s.validateWebhookInput(ctx, input)
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+	secret, err := newSecret()
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	subscription := model.WebhookSubscription{
+		WebhookUUID: uuid.New(),
+		URL:         input.URL,
+		EventTypes:  eventTypes,
+		Secret:      secret,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	err = s.repo.CreateSubscription(ctx, subscription)
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	return subscription, nil
+}
+
+func (s *webhookService) GetWebhook(ctx context.Context, webhookUUID uuid.UUID) (model.WebhookSubscription, error) {
+	if !isAdmin(ctx) {
+		return model.WebhookSubscription{}, model.ErrWebhookNotFound
+	}
+	return s.repo.GetSubscription(ctx, webhookUUID)
+}
+
+func (s *webhookService) ListWebhooks(ctx context.Context) ([]model.WebhookSubscription, error) {
+	if !isAdmin(ctx) {
+		return nil, model.ErrWebhookNotFound
+	}
+	return s.repo.ListSubscriptions(ctx)
+}
+
+func (s *webhookService) UpdateWebhook(ctx context.Context, webhookUUID uuid.UUID, input model.WebhookInput) (model.WebhookSubscription, error) {
+	if !isAdmin(ctx) {
+		return model.WebhookSubscription{}, model.ErrWebhookNotFound
+	}
+	eventTypes, err := s.validateWebhookInput(ctx, input)
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	subscription, err := s.repo.GetSubscription(ctx, webhookUUID)
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+	subscription.URL = input.URL
+	subscription.EventTypes = eventTypes
+	subscription.UpdatedAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	err = s.repo.UpdateSubscription(ctx, subscription)
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	return subscription, nil
+}
+
+func (s *webhookService) DeleteWebhook(ctx context.Context, webhookUUID uuid.UUID) error {
+	if !isAdmin(ctx) {
+		return model.ErrWebhookNotFound
+	}
+	return s.repo.DeleteSubscription(ctx, webhookUUID)
+}
+
+// RotateWebhookSecret заменяет секрет подписи. Доставки, отправленные
+// после ротации, подписываются уже новым секретом.
+func (s *webhookService) RotateWebhookSecret(ctx context.Context, webhookUUID uuid.UUID) (model.WebhookSubscription, error) {
+	if !isAdmin(ctx) {
+		return model.WebhookSubscription{}, model.ErrWebhookNotFound
+	}
+	subscription, err := s.repo.GetSubscription(ctx, webhookUUID)
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	subscription.Secret, err = newSecret()
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+	subscription.UpdatedAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	err = s.repo.UpdateSubscription(ctx, subscription)
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	return subscription, nil
+}
+
+func (s *webhookService) ListDeadLetters(ctx context.Context, webhookUUID uuid.UUID) ([]model.WebhookDelivery, error) {
+	if !isAdmin(ctx) {
+		return nil, model.ErrWebhookNotFound
+	}
+	_, err := s.repo.GetSubscription(ctx, webhookUUID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListDeadDeliveries(ctx, webhookUUID)
+}
+
+// ReplayDeadLetter возвращает недоставленное событие в очередь отправки
+// с новым набором попыток
+func (s *webhookService) ReplayDeadLetter(ctx context.Context, webhookUUID, deliveryUUID uuid.UUID) (model.WebhookDelivery, error) {
+	if !isAdmin(ctx) {
+		return model.WebhookDelivery{}, model.ErrWebhookNotFound
+	}
+	delivery, err := s.repo.GetDelivery(ctx, deliveryUUID)
+	if err != nil {
+		return model.WebhookDelivery{}, err
+	}
+	if delivery.WebhookUUID != webhookUUID {
+		return model.WebhookDelivery{}, model.ErrWebhookDeliveryNotFound
+	}
+	if delivery.Status != model.WebhookDeliveryStatusDEAD {
+		return model.WebhookDelivery{}, model.ErrWebhookDeliveryNotDead
+	}
+
+	delivery.Status = model.WebhookDeliveryStatusPENDING
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now().UTC()
+
+	err = s.repo.UpdateDelivery(ctx, delivery)
+	if err != nil {
+		return model.WebhookDelivery{}, err
+	}
+
+	return delivery, nil
+}
+
+func (s *webhookService) EnqueueDeliveries(ctx context.Context, events []model.OutboxMessage) error {
+	if !isSystemOrAdmin(ctx) {
+		return model.ErrWebhookNotFound
+	}
+	subscriptions, err := s.repo.ListSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	var deliveries []model.WebhookDelivery
+	for _, event := range events {
+		eventType, ok := converter.WebhookEventTypeFromOutbox(event)
+		if !ok {
+			continue
+		}
+
+		var payload []byte
+		for _, subscription := range subscriptions {
+			if !slices.Contains(subscription.EventTypes, eventType) {
+				continue
+			}
+			if payload == nil {
+				payload, err = converter.OutboxMessageToWebhookPayload(event, eventType)
+				if err != nil {
+					return err
+				}
+			}
+			deliveries = append(deliveries, model.WebhookDelivery{
+				DeliveryUUID:  uuid.New(),
+				WebhookUUID:   subscription.WebhookUUID,
+				EventUUID:     event.EventUUID,
+				EventType:     eventType,
+				Payload:       payload,
+				Status:        model.WebhookDeliveryStatusPENDING,
+				NextAttemptAt: now,
+				CreatedAt:     now,
+			})
+		}
+	}
+
+	return s.repo.CreateDeliveries(ctx, deliveries)
+}
+
+func (s *webhookService) DeliverDue(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	deliveries, err := s.repo.ClaimDueDeliveries(ctx, now, now.Add(deliveryLease), deliverBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var delivered int
+	for _, delivery := range deliveries {
+		subscription, err := s.repo.GetSubscription(ctx, delivery.WebhookUUID)
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			continue
+		}
+		if err != nil {
+			return delivered, err
+		}
+
+		sendErr := s.client.Send(ctx, subscription.URL, subscription.Secret, delivery)
+		if sendErr == nil {
+			delivered++
+		}
+		recordAttempt(&delivery, sendErr, time.Now().UTC())
+
+		err = s.repo.UpdateDelivery(ctx, delivery)
+		if errors.Is(err, model.ErrWebhookDeliveryNotFound) {
+			continue
+		}
+		if err != nil {
+			return delivered, err
+		}
+	}
+
+	return delivered, nil
+}
+
+// recordAttempt обновляет доставку по результату попытки: успешная доставка
+// завершается, неудачная откладывается с экспоненциальной задержкой, а после
+// maxDeliveryAttempts переносится в список недоставленных
+func recordAttempt(delivery *model.WebhookDelivery, sendErr error, at time.Time) {
+	delivery.Attempts++
+	if sendErr == nil {
+		delivery.Status = model.WebhookDeliveryStatusDELIVERED
+		delivery.DeliveredAt = &at
+		delivery.LastError = ""
+		return
+	}
+
+	delivery.LastError = sendErr.Error()
+	if delivery.Attempts >= maxDeliveryAttempts {
+		delivery.Status = model.WebhookDeliveryStatusDEAD
+		slog.Warn("webhook delivery moved to dead-letter list",
+			slog.String("delivery_uuid", delivery.DeliveryUUID.String()),
+			slog.Any("error", sendErr),
+		)
+		return
+	}
+	delivery.NextAttemptAt = at.Add(retryDelay(delivery.Attempts))
+}
+
+// retryDelay возвращает задержку перед следующей попыткой после attempts неудачных
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, retryMaxDelay)
+}
+
+// isAdmin сообщает, является ли вызывающий администратором. Подписки получают события
+// заказов всех пользователей, поэтому управлять ими может только администратор,
+// а остальным вебхуки отвечают model.ErrWebhookNotFound, как чужие заказы.
+func isAdmin(ctx context.Context) bool {
+	identity, ok := auth.IdentityFromContext(ctx)
+	return ok && identity.HasRole(auth.RoleAdmin)
+}
+
+// isSystemOrAdmin пропускает администраторов и фоновые задачи, которые
+// вызывают сервис без личности пользователя
+func isSystemOrAdmin(ctx context.Context) bool {
+	identity, ok := auth.IdentityFromContext(ctx)
+	return !ok || identity.HasRole(auth.RoleAdmin)
+}
+
+// validateWebhookInput проверяет адрес и возвращает типы событий без повторов
+func (s *webhookService) validateWebhookInput(ctx context.Context, input model.WebhookInput) ([]model.WebhookEventType, error) {
+	u, err := url.Parse(input.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, model.ErrInvalidWebhookURL
+	}
+	err = s.validateWebhookHost(ctx, u.Hostname())
+	if err != nil {
+		return nil, err
+	}
+
+	eventTypes := make([]model.WebhookEventType, 0, len(input.EventTypes))
+	for _, eventType := range input.EventTypes {
+		if !slices.Contains(knownEventTypes, eventType) {
+			return nil, fmt.Errorf("%w: unknown event type %q", model.ErrInvalidWebhookEventTypes, eventType)
+		}
+		if !slices.Contains(eventTypes, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+	if len(eventTypes) == 0 {
+		return nil, model.ErrInvalidWebhookEventTypes
+	}
+
+	return eventTypes, nil
+}
+
+// validateWebhookHost отклоняет хосты, которые являются или разрешаются в непубличные
+// адреса. Клиент повторяет проверку при каждой доставке, так как DNS-запись
+// может измениться после регистрации.
+func (s *webhookService) validateWebhookHost(ctx context.Context, host string) error {
+	var addrs []netip.Addr
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addrs = []netip.Addr{addr}
+	} else if addrs, err = s.lookupHost(ctx, host); err != nil || len(addrs) == 0 {
+		return fmt.Errorf("%w: host %q does not resolve", model.ErrInvalidWebhookURL, host)
+	}
+
+	for _, addr := range addrs {
+		if !model.WebhookAddrAllowed(addr) {
+			return fmt.Errorf("%w: host %q is not a public address", model.ErrInvalidWebhookURL, host)
+		}
+	}
+	return nil
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, secretSize)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return secretPrefix + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,320 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/model/converter"
+)
+
+func newSubscription(eventTypes ...model.WebhookEventType) model.WebhookSubscription {
+	return model.WebhookSubscription{
+		WebhookUUID: uuid.New(),
+		URL:         "https://erp.example.com/hooks/orders",
+		EventTypes:  eventTypes,
+		Secret:      "whsec_test",
+	}
+}
+
+func newPendingDelivery(webhookUUID uuid.UUID, attempts int) model.WebhookDelivery {
+	return model.WebhookDelivery{
+		DeliveryUUID: uuid.New(),
+		WebhookUUID:  webhookUUID,
+		EventUUID:    uuid.New(),
+		EventType:    model.WebhookEventOrderPaid,
+		Payload:      []byte(`{}`),
+		Status:       model.WebhookDeliveryStatusPENDING,
+		Attempts:     attempts,
+	}
+}
+
+func (s *ServiceSuite) TestCreateWebhook() {
+	testCases := []struct {
+		name        string
+		input       model.WebhookInput
+		expectedErr error
+		setupMock   func()
+	}{
+		{
+			name: "Happy path",
+			input: model.WebhookInput{
+				URL:        "https://erp.example.com/hooks/orders",
+				EventTypes: []model.WebhookEventType{model.WebhookEventOrderPaid, model.WebhookEventOrderPaid},
+			},
+			setupMock: func() {
+				s.repo.On("CreateSubscription", s.ctx, mock.MatchedBy(func(sub model.WebhookSubscription) bool {
+					return len(sub.EventTypes) == 1 && len(sub.Secret) == len(secretPrefix)+2*secretSize
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:        "Invalid url",
+			input:       model.WebhookInput{URL: "ftp://erp.example.com", EventTypes: []model.WebhookEventType{model.WebhookEventOrderPaid}},
+			expectedErr: model.ErrInvalidWebhookURL,
+			setupMock:   func() {},
+		},
+		{
+			name:        "Loopback address",
+			input:       model.WebhookInput{URL: "http://127.0.0.1:8080/hooks", EventTypes: []model.WebhookEventType{model.WebhookEventOrderPaid}},
+			expectedErr: model.ErrInvalidWebhookURL,
+			setupMock:   func() {},
+		},
+		{
+			name:        "Loopback IPv6 address",
+			input:       model.WebhookInput{URL: "http://[::1]/hooks", EventTypes: []model.WebhookEventType{model.WebhookEventOrderPaid}},
+			expectedErr: model.ErrInvalidWebhookURL,
+			setupMock:   func() {},
+		},
+		{
+			name:        "Cloud metadata address",
+			input:       model.WebhookInput{URL: "http://169.254.169.254/latest/meta-data", EventTypes: []model.WebhookEventType{model.WebhookEventOrderPaid}},
+			expectedErr: model.ErrInvalidWebhookURL,
+			setupMock:   func() {},
+		},
+		{
+			name:        "Private address",
+			input:       model.WebhookInput{URL: "https://192.168.1.10/hooks", EventTypes: []model.WebhookEventType{model.WebhookEventOrderPaid}},
+			expectedErr: model.ErrInvalidWebhookURL,
+			setupMock:   func() {},
+		},
+		{
+			name:        "Host resolving to loopback",
+			input:       model.WebhookInput{URL: "http://localhost:8080/hooks", EventTypes: []model.WebhookEventType{model.WebhookEventOrderPaid}},
+			expectedErr: model.ErrInvalidWebhookURL,
+			setupMock:   func() {},
+		},
+		{
+			name:        "Host resolving to private address",
+			input:       model.WebhookInput{URL: "https://internal.example.com/hooks", EventTypes: []model.WebhookEventType{model.WebhookEventOrderPaid}},
+			expectedErr: model.ErrInvalidWebhookURL,
+			setupMock:   func() {},
+		},
+		{
+			name:        "Unresolvable host",
+			input:       model.WebhookInput{URL: "https://unknown.example.com/hooks", EventTypes: []model.WebhookEventType{model.WebhookEventOrderPaid}},
+			expectedErr: model.ErrInvalidWebhookURL,
+			setupMock:   func() {},
+		},
+		{
+			name:        "Unknown event type",
+			input:       model.WebhookInput{URL: "https://erp.example.com", EventTypes: []model.WebhookEventType{"order.shipped"}},
+			expectedErr: model.ErrInvalidWebhookEventTypes,
+			setupMock:   func() {},
+		},
+		{
+			name:        "No event types",
+			input:       model.WebhookInput{URL: "https://erp.example.com"},
+			expectedErr: model.ErrInvalidWebhookEventTypes,
+			setupMock:   func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			tc.setupMock()
+
+			// act
+			subscription, err := s.service.CreateWebhook(s.ctx, tc.input)
+
+			// assert
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Empty(subscription)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.input.URL, subscription.URL)
+				s.Require().NotEmpty(subscription.Secret)
+			}
+		})
+	}
+}
+
+func (s *ServiceSuite) TestRotateWebhookSecret() {
+	subscription := newSubscription(model.WebhookEventOrderPaid)
+	s.repo.On("GetSubscription", s.ctx, subscription.WebhookUUID).Return(subscription, nil).Once()
+	s.repo.On("UpdateSubscription", s.ctx, mock.MatchedBy(func(sub model.WebhookSubscription) bool {
+		return sub.WebhookUUID == subscription.WebhookUUID && sub.Secret != subscription.Secret
+	})).Return(nil).Once()
+
+	rotated, err := s.service.RotateWebhookSecret(s.ctx, subscription.WebhookUUID)
+	s.Require().NoError(err)
+	s.Require().NotEqual(subscription.Secret, rotated.Secret)
+}
+
+func (s *ServiceSuite) TestEnqueueDeliveries() {
+	paidSubscriber := newSubscription(model.WebhookEventOrderPaid)
+	createdSubscriber := newSubscription(model.WebhookEventOrderCreated)
+
+	order := model.Order{OrderUUID: uuid.New(), UserUUID: uuid.New()}
+	event, err := converter.EventToOutboxMessage(converter.OrderPaidToEvent(order), order.OrderUUID, time.Now().UTC())
+	s.Require().NoError(err)
+
+	s.repo.On("ListSubscriptions", s.ctx).Return([]model.WebhookSubscription{paidSubscriber, createdSubscriber}, nil).Once()
+	s.repo.On("CreateDeliveries", s.ctx, mock.MatchedBy(func(deliveries []model.WebhookDelivery) bool {
+		if len(deliveries) != 1 {
+			return false
+		}
+		var payload struct {
+			EventUUID string `json:"event_uuid"`
+			EventType string `json:"event_type"`
+			OrderUUID string `json:"order_uuid"`
+		}
+		d := deliveries[0]
+		return json.Unmarshal(d.Payload, &payload) == nil &&
+			d.WebhookUUID == paidSubscriber.WebhookUUID && d.EventUUID == event.EventUUID &&
+			payload.EventUUID == event.EventUUID.String() && payload.EventType == "order.paid" &&
+			payload.OrderUUID == order.OrderUUID.String()
+	})).Return(nil).Once()
+
+	err = s.service.EnqueueDeliveries(s.ctx, []model.OutboxMessage{event})
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestEnqueueExpiredOrderDeliveries() {
+	expiredSubscriber := newSubscription(model.WebhookEventOrderExpired)
+
+	order := model.Order{OrderUUID: uuid.New(), UserUUID: uuid.New(), PaymentDeadline: time.Now().UTC()}
+	event, err := converter.EventToOutboxMessage(
+		converter.OrderExpiredToEvent(order, "payment deadline exceeded"), order.OrderUUID, time.Now().UTC())
+	s.Require().NoError(err)
+
+	s.repo.On("ListSubscriptions", s.ctx).Return([]model.WebhookSubscription{expiredSubscriber}, nil).Once()
+	s.repo.On("CreateDeliveries", s.ctx, mock.MatchedBy(func(deliveries []model.WebhookDelivery) bool {
+		return len(deliveries) == 1 && deliveries[0].WebhookUUID == expiredSubscriber.WebhookUUID &&
+			deliveries[0].EventType == model.WebhookEventOrderExpired
+	})).Return(nil).Once()
+
+	err = s.service.EnqueueDeliveries(s.ctx, []model.OutboxMessage{event})
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestDeliverDue() {
+	subscription := newSubscription(model.WebhookEventOrderPaid)
+
+	testCases := []struct {
+		name              string
+		delivery          model.WebhookDelivery
+		sendErr           error
+		expectedDelivered int
+		expectedStatus    model.WebhookDeliveryStatus
+	}{
+		{
+			name:              "Delivered",
+			delivery:          newPendingDelivery(subscription.WebhookUUID, 0),
+			expectedDelivered: 1,
+			expectedStatus:    model.WebhookDeliveryStatusDELIVERED,
+		},
+		{
+			name:           "Retried with backoff",
+			delivery:       newPendingDelivery(subscription.WebhookUUID, 2),
+			sendErr:        model.ErrWebhookDeliveryRejected,
+			expectedStatus: model.WebhookDeliveryStatusPENDING,
+		},
+		{
+			name:           "Moved to dead-letter list",
+			delivery:       newPendingDelivery(subscription.WebhookUUID, maxDeliveryAttempts-1),
+			sendErr:        assert.AnError,
+			expectedStatus: model.WebhookDeliveryStatusDEAD,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			var saved model.WebhookDelivery
+			s.repo.On("ClaimDueDeliveries", s.ctx, mock.Anything, mock.Anything, deliverBatchSize).
+				Return([]model.WebhookDelivery{tc.delivery}, nil).Once()
+			s.repo.On("GetSubscription", s.ctx, subscription.WebhookUUID).Return(subscription, nil).Once()
+			s.client.On("Send", s.ctx, subscription.URL, subscription.Secret, tc.delivery).Return(tc.sendErr).Once()
+			s.repo.On("UpdateDelivery", s.ctx, mock.Anything).Run(func(args mock.Arguments) {
+				saved = args.Get(1).(model.WebhookDelivery)
+			}).Return(nil).Once()
+
+			// act
+			start := time.Now().UTC()
+			delivered, err := s.service.DeliverDue(s.ctx)
+
+			// assert
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedDelivered, delivered)
+			s.Require().Equal(tc.expectedStatus, saved.Status)
+			s.Require().Equal(tc.delivery.Attempts+1, saved.Attempts)
+			if tc.expectedStatus == model.WebhookDeliveryStatusPENDING {
+				s.Require().WithinDuration(start.Add(retryDelay(saved.Attempts)), saved.NextAttemptAt, time.Second)
+				s.Require().NotEmpty(saved.LastError)
+			}
+		})
+	}
+}
+
+func (s *ServiceSuite) TestReplayDeadLetter() {
+	webhookUUID := uuid.New()
+	dead := newPendingDelivery(webhookUUID, maxDeliveryAttempts)
+	dead.Status = model.WebhookDeliveryStatusDEAD
+
+	testCases := []struct {
+		name        string
+		webhookUUID uuid.UUID
+		delivery    model.WebhookDelivery
+		expectedErr error
+		setupMock   func(model.WebhookDelivery)
+	}{
+		{
+			name:        "Happy path",
+			webhookUUID: webhookUUID,
+			delivery:    dead,
+			setupMock: func(d model.WebhookDelivery) {
+				s.repo.On("UpdateDelivery", s.ctx, mock.MatchedBy(func(u model.WebhookDelivery) bool {
+					return u.DeliveryUUID == d.DeliveryUUID && u.Status == model.WebhookDeliveryStatusPENDING && u.Attempts == 0
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:        "Delivery of another webhook",
+			webhookUUID: uuid.New(),
+			delivery:    dead,
+			expectedErr: model.ErrWebhookDeliveryNotFound,
+			setupMock:   func(model.WebhookDelivery) {},
+		},
+		{
+			name:        "Delivery is not dead",
+			webhookUUID: webhookUUID,
+			delivery:    newPendingDelivery(webhookUUID, 1),
+			expectedErr: model.ErrWebhookDeliveryNotDead,
+			setupMock:   func(model.WebhookDelivery) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			s.repo.On("GetDelivery", s.ctx, tc.delivery.DeliveryUUID).Return(tc.delivery, nil).Once()
+			tc.setupMock(tc.delivery)
+
+			// act
+			delivery, err := s.service.ReplayDeadLetter(s.ctx, tc.webhookUUID, tc.delivery.DeliveryUUID)
+
+			// assert
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Empty(delivery)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(model.WebhookDeliveryStatusPENDING, delivery.Status)
+			}
+		})
+	}
+}
+
+func (s *ServiceSuite) TestRetryDelay() {
+	s.Require().Equal(10*time.Second, retryDelay(1))
+	s.Require().Equal(20*time.Second, retryDelay(2))
+	s.Require().Equal(80*time.Second, retryDelay(4))
+	s.Require().Equal(retryMaxDelay, retryDelay(100))
+}
@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	clientMocks "github.com/xgmsx/rsf/order/internal/client/mocks"
+	"github.com/xgmsx/rsf/order/internal/repository/mocks"
+	"github.com/xgmsx/rsf/shared/pkg/auth"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx     context.Context //nolint:containedctx
+	repo    *mocks.WebhookRepository
+	client  *clientMocks.WebhookClient
+	service *webhookService
+}
+
+func (s *ServiceSuite) SetupTest() {
+	// Вебхуками управляет только администратор; отказ остальным - в TestWebhookAccess
+	s.ctx = auth.ContextWithIdentity(context.Background(), auth.Identity{
+		UserUUID: uuid.New(),
+		Roles:    []string{auth.RoleAdmin},
+	})
+	s.repo = mocks.NewWebhookRepository(s.T())
+	s.client = clientMocks.NewWebhookClient(s.T())
+	s.service = NewWebhookService(s.repo, s.client)
+	s.service.lookupHost = lookupTestHost
+}
+
+// testHosts - DNS-записи хостов, используемых в тестах
+var testHosts = map[string][]netip.Addr{
+	"erp.example.com":      {netip.MustParseAddr("203.0.113.10")},
+	"localhost":            {netip.MustParseAddr("127.0.0.1"), netip.MustParseAddr("::1")},
+	"internal.example.com": {netip.MustParseAddr("203.0.113.11"), netip.MustParseAddr("10.0.0.5")},
+}
+
+func lookupTestHost(_ context.Context, host string) ([]netip.Addr, error) {
+	addrs, ok := testHosts[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestWebhookService(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
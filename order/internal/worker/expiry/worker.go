@@ -0,0 +1,59 @@
+package expiry
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/xgmsx/rsf/order/internal/service"
+)
+
+// worker периодически переводит неоплаченные в срок заказы в статус EXPIRED
+// и удаляет ключи идемпотентности с истекшим сроком хранения
+type worker struct {
+	service  service.OrderService
+	interval time.Duration
+}
+
+func NewWorker(service service.OrderService, interval time.Duration) *worker {
+	return &worker{
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Run выполняет проходы с заданным интервалом до отмены контекста
+func (w *worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.expire(ctx)
+			w.deleteIdempotencyKeys(ctx)
+		}
+	}
+}
+
+func (w *worker) expire(ctx context.Context) {
+	expired, err := w.service.ExpireOverdueOrders(ctx)
+	if err != nil && ctx.Err() == nil {
+		slog.ErrorContext(ctx, "failed to expire overdue orders", slog.Any("error", err))
+	}
+	if expired > 0 {
+		slog.InfoContext(ctx, "overdue orders expired", slog.Int("count", expired))
+	}
+}
+
+func (w *worker) deleteIdempotencyKeys(ctx context.Context) {
+	deleted, err := w.service.DeleteExpiredIdempotencyKeys(ctx)
+	if err != nil && ctx.Err() == nil {
+		slog.ErrorContext(ctx, "failed to delete expired idempotency keys", slog.Any("error", err))
+	}
+	if deleted > 0 {
+		slog.InfoContext(ctx, "expired idempotency keys deleted", slog.Int("count", deleted))
+	}
+}
@@ -0,0 +1,97 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/xgmsx/rsf/order/internal/broker"
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/repository"
+)
+
+const (
+	// batchSize - максимальное число сообщений, публикуемых за одну транзакцию
+	batchSize = 100
+
+	HeaderEventType = "event-type"
+	HeaderEventUUID = "event-uuid"
+)
+
+// relay переносит события из outbox в брокер сообщений и передает их
+// дополнительным обработчикам, например постановке доставок вебхуков.
+// Сообщение удаляется из outbox только после подтверждения брокером и всеми
+// обработчиками, поэтому при сбое оно будет опубликовано повторно (at-least-once).
+type relay struct {
+	repo      repository.OutboxRepository
+	publisher broker.Publisher
+	topic     string
+	interval  time.Duration
+	handlers  []repository.OutboxPublishFunc
+}
+
+func NewRelay(repo repository.OutboxRepository, publisher broker.Publisher, topic string, interval time.Duration, handlers ...repository.OutboxPublishFunc) *relay {
+	return &relay{
+		repo:      repo,
+		publisher: publisher,
+		topic:     topic,
+		interval:  interval,
+		handlers:  handlers,
+	}
+}
+
+// Run публикует накопленные события с заданным интервалом до отмены контекста
+func (r *relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := r.Flush(ctx)
+			if err != nil && ctx.Err() == nil {
+				slog.ErrorContext(ctx, "failed to publish order events", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// Flush публикует все события, накопленные в outbox к моменту вызова
+func (r *relay) Flush(ctx context.Context) (int, error) {
+	var total int
+	for {
+		published, err := r.repo.ProcessPending(ctx, batchSize, r.publish)
+		total += published
+		if err != nil || published < batchSize {
+			return total, err
+		}
+	}
+}
+
+func (r *relay) publish(ctx context.Context, messages []model.OutboxMessage) error {
+	brokerMessages := make([]broker.Message, len(messages))
+	for i, msg := range messages {
+		brokerMessages[i] = broker.Message{
+			Key:   []byte(msg.OrderUUID.String()),
+			Value: msg.Payload,
+			Headers: map[string]string{
+				HeaderEventType: msg.EventType,
+				HeaderEventUUID: msg.EventUUID.String(),
+			},
+		}
+	}
+	err := r.publisher.Publish(ctx, r.topic, brokerMessages...)
+	if err != nil {
+		return err
+	}
+
+	for _, handle := range r.handlers {
+		err = handle(ctx, messages)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
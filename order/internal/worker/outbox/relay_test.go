@@ -0,0 +1,132 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/xgmsx/rsf/order/internal/broker"
+	"github.com/xgmsx/rsf/order/internal/broker/memory"
+	"github.com/xgmsx/rsf/order/internal/model"
+	"github.com/xgmsx/rsf/order/internal/model/converter"
+	orderRepo "github.com/xgmsx/rsf/order/internal/repository/order"
+	genOrderEventsV1 "github.com/xgmsx/rsf/shared/pkg/proto/order/v1"
+)
+
+const testTopic = "order.events"
+
+type failingPublisher struct{}
+
+func (failingPublisher) Publish(context.Context, string, ...broker.Message) error {
+	return errors.New("broker is unavailable")
+}
+
+func (failingPublisher) Close() error { return nil }
+
+func newOrderWithEvents(t *testing.T) model.Order {
+	t.Helper()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	order := model.Order{
+		OrderUUID: uuid.New(),
+		UserUUID:  uuid.New(),
+		Items:     []model.OrderItem{{PartUUID: uuid.New(), Quantity: 1}},
+		Status:    model.OrderStatusPENDINGPAYMENT,
+		CreatedAt: now,
+	}
+	for _, event := range []*genOrderEventsV1.OrderEvent{
+		converter.OrderCreatedToEvent(order),
+		converter.OrderCancelledToEvent(order, "cancelled by user"),
+	} {
+		msg, err := converter.EventToOutboxMessage(event, order.OrderUUID, now)
+		require.NoError(t, err)
+		order.NewEvents = append(order.NewEvents, msg)
+	}
+	return order
+}
+
+func TestRelayFlush(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Events published in order and removed from outbox", func(t *testing.T) {
+		repo := orderRepo.NewOrderRepository()
+		order := newOrderWithEvents(t)
+		require.NoError(t, repo.Create(ctx, order))
+		publisher := memory.NewBroker()
+
+		published, err := NewRelay(repo, publisher, testTopic, time.Second).Flush(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, published)
+
+		messages := publisher.Messages(testTopic)
+		require.Len(t, messages, 2)
+		for i, msg := range messages {
+			require.Equal(t, order.OrderUUID.String(), string(msg.Key))
+			require.Equal(t, order.NewEvents[i].EventType, msg.Headers[HeaderEventType])
+
+			var event genOrderEventsV1.OrderEvent
+			require.NoError(t, proto.Unmarshal(msg.Value, &event))
+			require.Equal(t, order.NewEvents[i].EventUUID.String(), event.GetEventUuid())
+		}
+		require.Equal(t, "order.v1.OrderCreated", messages[0].Headers[HeaderEventType])
+		require.Equal(t, "order.v1.OrderCancelled", messages[1].Headers[HeaderEventType])
+
+		published, err = NewRelay(repo, publisher, testTopic, time.Second).Flush(ctx)
+		require.NoError(t, err)
+		require.Zero(t, published)
+	})
+
+	t.Run("Events kept in outbox when broker fails", func(t *testing.T) {
+		repo := orderRepo.NewOrderRepository()
+		require.NoError(t, repo.Create(ctx, newOrderWithEvents(t)))
+
+		_, err := NewRelay(repo, failingPublisher{}, testTopic, time.Second).Flush(ctx)
+		require.Error(t, err)
+
+		publisher := memory.NewBroker()
+		published, err := NewRelay(repo, publisher, testTopic, time.Second).Flush(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, published)
+		require.Len(t, publisher.Messages(testTopic), 2)
+	})
+
+	t.Run("Events passed to handlers after broker", func(t *testing.T) {
+		repo := orderRepo.NewOrderRepository()
+		order := newOrderWithEvents(t)
+		require.NoError(t, repo.Create(ctx, order))
+
+		var handled []model.OutboxMessage
+		handler := func(_ context.Context, messages []model.OutboxMessage) error {
+			handled = append(handled, messages...)
+			return nil
+		}
+
+		published, err := NewRelay(repo, memory.NewBroker(), testTopic, time.Second, handler).Flush(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, published)
+		require.Len(t, handled, 2)
+		require.Equal(t, order.NewEvents[0].EventUUID, handled[0].EventUUID)
+		require.Equal(t, order.NewEvents[1].EventUUID, handled[1].EventUUID)
+	})
+
+	t.Run("Events kept in outbox when handler fails", func(t *testing.T) {
+		repo := orderRepo.NewOrderRepository()
+		require.NoError(t, repo.Create(ctx, newOrderWithEvents(t)))
+
+		handler := func(context.Context, []model.OutboxMessage) error {
+			return errors.New("webhooks are unavailable")
+		}
+
+		_, err := NewRelay(repo, memory.NewBroker(), testTopic, time.Second, handler).Flush(ctx)
+		require.Error(t, err)
+
+		published, err := NewRelay(repo, memory.NewBroker(), testTopic, time.Second).Flush(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, published)
+	})
+}
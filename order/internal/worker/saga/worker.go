@@ -0,0 +1,48 @@
+package saga
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/xgmsx/rsf/order/internal/service"
+)
+
+// worker периодически восстанавливает саги создания и оплаты заказов,
+// прерванные перезапуском или сбоем сервиса
+type worker struct {
+	service  service.OrderService
+	interval time.Duration
+}
+
+func NewWorker(service service.OrderService, interval time.Duration) *worker {
+	return &worker{
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Run выполняет проходы с заданным интервалом до отмены контекста
+func (w *worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.recover(ctx)
+		}
+	}
+}
+
+func (w *worker) recover(ctx context.Context) {
+	recovered, err := w.service.RecoverSagas(ctx)
+	if err != nil && ctx.Err() == nil {
+		slog.ErrorContext(ctx, "failed to recover sagas", slog.Any("error", err))
+	}
+	if recovered > 0 {
+		slog.InfoContext(ctx, "sagas recovered", slog.Int("count", recovered))
+	}
+}
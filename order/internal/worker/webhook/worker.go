@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/xgmsx/rsf/order/internal/service"
+)
+
+// worker периодически отправляет доставки вебхуков, время попытки которых наступило
+type worker struct {
+	service  service.WebhookService
+	interval time.Duration
+}
+
+func NewWorker(service service.WebhookService, interval time.Duration) *worker {
+	return &worker{
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Run выполняет проходы с заданным интервалом до отмены контекста
+func (w *worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.deliver(ctx)
+		}
+	}
+}
+
+func (w *worker) deliver(ctx context.Context) {
+	delivered, err := w.service.DeliverDue(ctx)
+	if err != nil && ctx.Err() == nil {
+		slog.ErrorContext(ctx, "failed to deliver webhooks", slog.Any("error", err))
+	}
+	if delivered > 0 {
+		slog.InfoContext(ctx, "webhooks delivered", slog.Int("count", delivered))
+	}
+}
@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE orders
+(
+    order_uuid       UUID PRIMARY KEY,
+    user_uuid        UUID             NOT NULL,
+    total_price      DOUBLE PRECISION NOT NULL,
+    transaction_uuid UUID,
+    payment_method   TEXT,
+    status           TEXT             NOT NULL,
+    created_at       TIMESTAMPTZ      NOT NULL DEFAULT now(),
+    updated_at       TIMESTAMPTZ      NOT NULL DEFAULT now()
+);
+
+CREATE INDEX orders_user_uuid_idx ON orders (user_uuid);
+
+CREATE TABLE order_part_uuids
+(
+    order_uuid UUID    NOT NULL REFERENCES orders (order_uuid) ON DELETE CASCADE,
+    position   INTEGER NOT NULL,
+    part_uuid  UUID    NOT NULL,
+    PRIMARY KEY (order_uuid, position)
+);
+
+-- +goose Down
+DROP TABLE order_part_uuids;
+DROP TABLE orders;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE orders
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE orders
+    DROP COLUMN version;
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE idempotency_keys
+(
+    operation    TEXT        NOT NULL,
+    key          TEXT        NOT NULL,
+    request_hash TEXT        NOT NULL,
+    response     JSONB,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    completed_at TIMESTAMPTZ,
+    PRIMARY KEY (operation, key)
+);
+
+-- +goose Down
+DROP TABLE idempotency_keys;
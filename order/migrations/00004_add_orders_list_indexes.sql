@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE INDEX orders_created_at_idx ON orders (created_at, order_uuid);
+CREATE INDEX orders_user_uuid_created_at_idx ON orders (user_uuid, created_at, order_uuid);
+CREATE INDEX order_part_uuids_part_uuid_idx ON order_part_uuids (part_uuid);
+DROP INDEX orders_user_uuid_idx;
+
+-- +goose Down
+CREATE INDEX orders_user_uuid_idx ON orders (user_uuid);
+DROP INDEX order_part_uuids_part_uuid_idx;
+DROP INDEX orders_user_uuid_created_at_idx;
+DROP INDEX orders_created_at_idx;
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE order_status_history
+(
+    id          BIGSERIAL PRIMARY KEY,
+    order_uuid  UUID        NOT NULL REFERENCES orders (order_uuid) ON DELETE CASCADE,
+    from_status TEXT,
+    to_status   TEXT        NOT NULL,
+    reason      TEXT        NOT NULL,
+    changed_at  TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX order_status_history_order_uuid_idx ON order_status_history (order_uuid, id);
+
+-- Для уже существующих заказов известен только текущий статус
+INSERT INTO order_status_history (order_uuid, from_status, to_status, reason, changed_at)
+SELECT order_uuid, NULL, status, 'status before history tracking', updated_at
+FROM orders;
+
+-- +goose Down
+DROP TABLE order_status_history;
@@ -0,0 +1,49 @@
+-- +goose Up
+CREATE TABLE order_items
+(
+    order_uuid UUID             NOT NULL REFERENCES orders (order_uuid) ON DELETE CASCADE,
+    position   INTEGER          NOT NULL,
+    part_uuid  UUID             NOT NULL,
+    quantity   BIGINT           NOT NULL CHECK (quantity > 0),
+    unit_price DOUBLE PRECISION NOT NULL,
+    name       TEXT             NOT NULL,
+    category   TEXT             NOT NULL,
+    PRIMARY KEY (order_uuid, position)
+);
+
+CREATE INDEX order_items_part_uuid_idx ON order_items (part_uuid);
+
+-- Цены и названия деталей старых заказов не сохранялись, поэтому для них
+-- переносится только количество, а итоговой суммой остается orders.total_price
+INSERT INTO order_items (order_uuid, position, part_uuid, quantity, unit_price, name, category)
+SELECT order_uuid,
+       row_number() OVER (PARTITION BY order_uuid ORDER BY min(position)) - 1,
+       part_uuid,
+       count(*),
+       0,
+       '',
+       'UNSPECIFIED'
+FROM order_part_uuids
+GROUP BY order_uuid, part_uuid;
+
+DROP TABLE order_part_uuids;
+
+-- +goose Down
+CREATE TABLE order_part_uuids
+(
+    order_uuid UUID    NOT NULL REFERENCES orders (order_uuid) ON DELETE CASCADE,
+    position   INTEGER NOT NULL,
+    part_uuid  UUID    NOT NULL,
+    PRIMARY KEY (order_uuid, position)
+);
+
+CREATE INDEX order_part_uuids_part_uuid_idx ON order_part_uuids (part_uuid);
+
+INSERT INTO order_part_uuids (order_uuid, position, part_uuid)
+SELECT i.order_uuid,
+       row_number() OVER (PARTITION BY i.order_uuid ORDER BY i.position, n) - 1,
+       i.part_uuid
+FROM order_items i
+         CROSS JOIN generate_series(1, i.quantity) AS n;
+
+DROP TABLE order_items;
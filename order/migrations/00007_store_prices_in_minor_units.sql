@@ -0,0 +1,30 @@
+-- +goose Up
+ALTER TABLE orders
+    ADD COLUMN total_price_amount BIGINT,
+    ADD COLUMN currency           TEXT NOT NULL DEFAULT 'RUB';
+UPDATE orders SET total_price_amount = round(total_price::numeric * 100);
+ALTER TABLE orders
+    ALTER COLUMN total_price_amount SET NOT NULL,
+    ALTER COLUMN currency DROP DEFAULT,
+    DROP COLUMN total_price;
+
+-- Позиции заказа всегда в валюте самого заказа
+ALTER TABLE order_items ADD COLUMN unit_price_amount BIGINT;
+UPDATE order_items SET unit_price_amount = round(unit_price::numeric * 100);
+ALTER TABLE order_items
+    ALTER COLUMN unit_price_amount SET NOT NULL,
+    DROP COLUMN unit_price;
+
+-- +goose Down
+ALTER TABLE order_items ADD COLUMN unit_price DOUBLE PRECISION;
+UPDATE order_items SET unit_price = unit_price_amount / 100.0;
+ALTER TABLE order_items
+    ALTER COLUMN unit_price SET NOT NULL,
+    DROP COLUMN unit_price_amount;
+
+ALTER TABLE orders ADD COLUMN total_price DOUBLE PRECISION;
+UPDATE orders SET total_price = total_price_amount / 100.0;
+ALTER TABLE orders
+    ALTER COLUMN total_price SET NOT NULL,
+    DROP COLUMN total_price_amount,
+    DROP COLUMN currency;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE orders ADD COLUMN payment_deadline TIMESTAMPTZ;
+UPDATE orders SET payment_deadline = created_at + INTERVAL '15 minutes';
+ALTER TABLE orders ALTER COLUMN payment_deadline SET NOT NULL;
+
+CREATE INDEX orders_pending_payment_deadline_idx ON orders (payment_deadline)
+    WHERE status = 'PENDING_PAYMENT';
+
+-- +goose Down
+DROP INDEX orders_pending_payment_deadline_idx;
+ALTER TABLE orders DROP COLUMN payment_deadline;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE order_outbox
+(
+    id         BIGSERIAL PRIMARY KEY,
+    event_uuid UUID        NOT NULL UNIQUE,
+    order_uuid UUID        NOT NULL,
+    event_type TEXT        NOT NULL,
+    payload    BYTEA       NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+-- +goose Down
+DROP TABLE order_outbox;
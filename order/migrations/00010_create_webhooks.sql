@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE webhook_subscriptions
+(
+    webhook_uuid UUID PRIMARY KEY,
+    url          TEXT        NOT NULL,
+    event_types  TEXT[]      NOT NULL,
+    secret       TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    updated_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE webhook_deliveries
+(
+    delivery_uuid   UUID PRIMARY KEY,
+    webhook_uuid    UUID        NOT NULL REFERENCES webhook_subscriptions (webhook_uuid) ON DELETE CASCADE,
+    event_uuid      UUID        NOT NULL,
+    event_type      TEXT        NOT NULL,
+    payload         BYTEA       NOT NULL,
+    status          TEXT        NOT NULL,
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_error      TEXT        NOT NULL DEFAULT '',
+    created_at      TIMESTAMPTZ NOT NULL,
+    delivered_at    TIMESTAMPTZ,
+    UNIQUE (webhook_uuid, event_uuid)
+);
+
+CREATE INDEX webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_at)
+    WHERE status = 'PENDING';
+CREATE INDEX webhook_deliveries_dead_idx ON webhook_deliveries (webhook_uuid, created_at)
+    WHERE status = 'DEAD';
+
+-- +goose Down
+DROP TABLE webhook_deliveries;
+DROP TABLE webhook_subscriptions;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Сумма возвратов хранится в валюте заказа
+ALTER TABLE orders ADD COLUMN refunded_amount BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN refunded_amount;
@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE sagas
+(
+    saga_uuid        UUID PRIMARY KEY,
+    type             TEXT        NOT NULL,
+    order_uuid       UUID        NOT NULL,
+    user_uuid        UUID        NOT NULL,
+    status           TEXT        NOT NULL,
+    step             TEXT        NOT NULL,
+    payment_method   TEXT,
+    amount           BIGINT      NOT NULL,
+    currency         TEXT        NOT NULL,
+    transaction_uuid UUID,
+    last_error       TEXT        NOT NULL DEFAULT '',
+    version          BIGINT      NOT NULL,
+    created_at       TIMESTAMPTZ NOT NULL,
+    updated_at       TIMESTAMPTZ NOT NULL
+);
+
+-- Воркер восстановления читает только незавершенные саги
+CREATE INDEX sagas_unfinished_updated_at_idx ON sagas (updated_at)
+    WHERE status IN ('RUNNING', 'COMPENSATING');
+
+-- +goose Down
+DROP TABLE sagas;
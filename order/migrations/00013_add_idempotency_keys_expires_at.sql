@@ -0,0 +1,10 @@
+-- +goose Up
+-- Ключи, сохраненные до появления срока хранения, хранятся еще сутки
+ALTER TABLE idempotency_keys ADD COLUMN expires_at TIMESTAMPTZ NOT NULL DEFAULT now() + INTERVAL '24 hours';
+
+-- Фоновый воркер удаляет ключи с истекшим сроком хранения
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+
+-- +goose Down
+DROP INDEX idempotency_keys_expires_at_idx;
+ALTER TABLE idempotency_keys DROP COLUMN expires_at;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Причина возврата и сумма возвратов заказа до него для саги возврата
+ALTER TABLE sagas ADD COLUMN reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE sagas ADD COLUMN refunded_before BIGINT NOT NULL DEFAULT 0;
+
+-- У заказа может выполняться только один возврат
+CREATE UNIQUE INDEX sagas_unfinished_refund_order_uuid_idx ON sagas (order_uuid)
+    WHERE type = 'refund_order' AND status IN ('RUNNING', 'COMPENSATING');
+
+-- +goose Down
+DROP INDEX sagas_unfinished_refund_order_uuid_idx;
+ALTER TABLE sagas DROP COLUMN refunded_before;
+ALTER TABLE sagas DROP COLUMN reason;
@@ -0,0 +1,8 @@
+// Package migrations содержит версионированные SQL-миграции базы данных
+// сервиса Order. Файлы встраиваются в бинарник и применяются при старте.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -2,124 +2,80 @@ package main
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
-	"net"
-	"net/http"
-	"os"
-	"os/signal"
-	"syscall"
-	"time"
+	"log/slog"
 
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/reflection"
 
 	paymentApiV1 "github.com/xgmsx/rsf/payment/internal/api/v1/payment"
+	"github.com/xgmsx/rsf/payment/internal/config"
+	transactionRepo "github.com/xgmsx/rsf/payment/internal/repository/transaction"
 	paymentService "github.com/xgmsx/rsf/payment/internal/service/payment"
+	"github.com/xgmsx/rsf/shared/pkg/app"
+	"github.com/xgmsx/rsf/shared/pkg/auth"
 	"github.com/xgmsx/rsf/shared/pkg/interceptor"
-	genInventoryV1 "github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1"
+	"github.com/xgmsx/rsf/shared/pkg/logger"
 	genPaymentV1 "github.com/xgmsx/rsf/shared/pkg/proto/payment/v1"
-	"github.com/xgmsx/rsf/shared/pkg/swagger"
+	"github.com/xgmsx/rsf/shared/pkg/tracing"
 )
 
-const (
-	grpcPort = 50051
-	httpPort = 8080
-)
+// serviceName - имя сервиса в трассировке и логах
+const serviceName = "api-payment"
 
 func main() {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
+	if err := run(); err != nil {
+		logger.Fatal("ошибка работы сервиса", slog.Any("error", err))
+	}
+}
+
+// run запускает сервис и возвращает управление после его остановки
+func run() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("ошибка конфигурации: %w", err)
+	}
+	err = logger.Init(serviceName, cfg.LogLevel)
 	if err != nil {
-		log.Printf("failed to listen: %v\n", err)
-		return
+		return fmt.Errorf("ошибка инициализации логгера: %w", err)
+	}
+
+	// Инициализируем трассировку до создания серверов
+	shutdownTracing, err := tracing.Init(context.Background(), serviceName, cfg.Tracing)
+	if err != nil {
+		return fmt.Errorf("ошибка инициализации трассировки: %w", err)
 	}
 	defer func() {
-		if e := lis.Close(); e != nil {
-			log.Printf("failed to close listener: %v\n", e)
+		if terr := shutdownTracing(context.Background()); terr != nil {
+			slog.Error("failed to shutdown tracing", slog.Any("error", terr))
 		}
 	}()
 
 	// Инициализируем слои приложения
-	service := paymentService.NewService()
+	repo := transactionRepo.NewTransactionRepository()
+	service := paymentService.NewService(repo)
 	api := paymentApiV1.NewPaymentAPI(service)
 
-	// Инициализируем gRPC сервер
-	server := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			grpc.UnaryServerInterceptor(interceptor.LoggerInterceptor()),
-		),
-	)
-	genPaymentV1.RegisterPaymentServiceServer(server, api)
-	reflection.Register(server)
-
-	// Запускаем gRPC сервер
-	go func() {
-		log.Printf("🚀 gRPC server listening on %d\n", grpcPort)
-		err = server.Serve(lis)
-		if err != nil {
-			log.Printf("failed to serve: %v\n", err)
-			return
-		}
-	}()
-
-	// Инициализируем HTTP сервер с gRPC Gateway и Swagger UI
-	go func() {
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-
-		// Создаем мультиплексор для HTTP запросов в gRPC-gateway
-		mux := runtime.NewServeMux()
-		err = genInventoryV1.RegisterInventoryServiceHandlerFromEndpoint(
-			ctx,
-			mux,
-			fmt.Sprintf("localhost:%d", grpcPort),
-			[]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())},
-		)
-		if err != nil {
-			log.Printf("Failed to register gateway: %v\n", err)
-			return
-		}
-
-		// Создаем мультиплексор для Swagger UI
-		httpMux := http.NewServeMux()
-		httpMux.Handle("/api/", mux)
-
-		httpMux.Handle("/swagger/", swagger.NewSwaggerHandler(
-			"/swagger/", "payment.swagger.json", "api"))
-
-		httpMux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if r.URL.Path == "/" {
-				http.Redirect(w, r, "/swagger/", http.StatusMovedPermanently)
-				return
-			}
-			http.NotFound(w, r)
-		}))
-
-		// Создаем HTTP gateway сервер
-		gwServer := &http.Server{
-			Addr:              fmt.Sprintf(":%d", httpPort),
-			Handler:           httpMux,
-			ReadHeaderTimeout: 10 * time.Second,
-		}
-
-		// Запускаем HTTP сервер
-		log.Printf("🌐 HTTP server with gRPC-Gateway and Swagger UI listening on %d\n", httpPort)
-		err = gwServer.ListenAndServe()
-		if err != nil && errors.Is(err, http.ErrServerClosed) {
-			log.Printf("Failed to serve HTTP: %v\n", err)
-			return
+	var interceptors []grpc.UnaryServerInterceptor
+	if cfg.AuthEnabled() {
+		verifier, authErr := auth.NewVerifier(cfg.Auth())
+		if authErr != nil {
+			return fmt.Errorf("ошибка инициализации проверки токенов: %w", authErr)
 		}
-	}()
-
-	// Graceful shutdown
-	notify := make(chan os.Signal, 1)
-	signal.Notify(notify, syscall.SIGINT, syscall.SIGTERM)
-	<-notify
+		interceptors = append(interceptors, interceptor.AuthInterceptor(verifier))
+	}
 
-	log.Println("🛑 Завершение работы сервера...")
-	server.GracefulStop()
-	log.Println("✅ Сервер остановлен")
+	// Запускаем gRPC сервер и HTTP gateway со Swagger UI
+	a := app.New(app.Config{
+		Name:              serviceName,
+		GRPCPort:          cfg.GRPCPort,
+		HTTPPort:          cfg.HTTPPort,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		ShutdownTimeout:   cfg.ShutdownTimeout,
+		SwaggerFile:       "payment.swagger.json",
+		Interceptors:      interceptors,
+	})
+	a.RegisterService(&genPaymentV1.PaymentService_ServiceDesc, api,
+		genPaymentV1.RegisterPaymentServiceHandlerFromEndpoint)
+
+	return a.Run(context.Background())
 }
@@ -16,10 +16,28 @@ func (h *paymentAPI) PayOrder(ctx context.Context, req *genPaymentV1.PayOrderReq
 	input := converter.PayInputFromRequest(req)
 	output, err := h.service.PayOrder(ctx, input)
 	if err != nil {
-		if errors.Is(err, model.ErrInvalidPaymentMethod) {
+		if errors.Is(err, model.ErrInvalidPaymentMethod) || errors.Is(err, model.ErrInvalidAmount) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	return converter.PayOutputToResponse(output), nil
 }
+
+func (h *paymentAPI) RefundPayment(ctx context.Context, req *genPaymentV1.RefundPaymentRequest) (*genPaymentV1.RefundPaymentResponse, error) {
+	input := converter.RefundInputFromRequest(req)
+	output, err := h.service.RefundPayment(ctx, input)
+	if err != nil {
+		if errors.Is(err, model.ErrTransactionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, model.ErrRefundExceedsPayment) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, model.ErrInvalidAmount) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return converter.RefundOutputToResponse(output), nil
+}
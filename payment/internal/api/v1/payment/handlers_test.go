@@ -0,0 +1,200 @@
+package payment
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/xgmsx/rsf/payment/internal/model"
+	"github.com/xgmsx/rsf/payment/internal/model/converter"
+	"github.com/xgmsx/rsf/shared/pkg/interceptor"
+	genCommonV1 "github.com/xgmsx/rsf/shared/pkg/proto/common/v1"
+	genPaymentV1 "github.com/xgmsx/rsf/shared/pkg/proto/payment/v1"
+)
+
+// violatedFields возвращает поля из деталей google.rpc.BadRequest ошибки
+func violatedFields(err error) []string {
+	var fields []string
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.GetFieldViolations() {
+				fields = append(fields, v.GetField())
+			}
+		}
+	}
+	return fields
+}
+
+func (s *APISuite) TestPayOrderHandler() {
+	validRequest := func() *genPaymentV1.PayOrderRequest {
+		return &genPaymentV1.PayOrderRequest{
+			OrderUuid:     uuid.NewString(),
+			UserUuid:      uuid.NewString(),
+			PaymentMethod: genPaymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+			Amount:        &genCommonV1.Money{Amount: 1000, Currency: "RUB"},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		req            func() *genPaymentV1.PayOrderRequest
+		expectedCode   codes.Code
+		expectedFields []string
+		setupMock      func(*genPaymentV1.PayOrderRequest)
+	}{
+		{
+			name: "Happy path",
+			req:  validRequest,
+			setupMock: func(req *genPaymentV1.PayOrderRequest) {
+				s.service.On("PayOrder", s.ctx, converter.PayInputFromRequest(req)).
+					Return(model.PayOrderOutput{TransactionUUID: uuid.NewString()}, nil).Once()
+			},
+		},
+		{
+			name: "Empty request",
+			req: func() *genPaymentV1.PayOrderRequest {
+				return &genPaymentV1.PayOrderRequest{}
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"order_uuid", "user_uuid", "payment_method"},
+		},
+		{
+			name: "Malformed order uuid",
+			req: func() *genPaymentV1.PayOrderRequest {
+				req := validRequest()
+				req.OrderUuid = "order-42"
+				return req
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"order_uuid"},
+		},
+		{
+			name: "Malformed user uuid",
+			req: func() *genPaymentV1.PayOrderRequest {
+				req := validRequest()
+				req.UserUuid = "' OR 1=1 --"
+				return req
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"user_uuid"},
+		},
+		{
+			name: "Unspecified payment method",
+			req: func() *genPaymentV1.PayOrderRequest {
+				req := validRequest()
+				req.PaymentMethod = genPaymentV1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+				return req
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"payment_method"},
+		},
+		{
+			name: "Undefined payment method",
+			req: func() *genPaymentV1.PayOrderRequest {
+				req := validRequest()
+				req.PaymentMethod = genPaymentV1.PaymentMethod(42)
+				return req
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"payment_method"},
+		},
+		{
+			name: "Malformed amount currency",
+			req: func() *genPaymentV1.PayOrderRequest {
+				req := validRequest()
+				req.Amount.Currency = "RUBLE"
+				return req
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"amount.currency"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			req := tc.req()
+			if tc.setupMock != nil {
+				tc.setupMock(req)
+			}
+
+			// act
+			resp, err := interceptor.InvokeValidated(s.ctx, req, s.api.PayOrder)
+
+			// assert
+			if tc.expectedCode == codes.OK {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(resp.GetTransactionUuid())
+			} else {
+				s.Require().Nil(resp)
+				s.Require().Equal(tc.expectedCode, status.Code(err))
+				s.Require().ElementsMatch(tc.expectedFields, violatedFields(err))
+			}
+		})
+	}
+}
+
+func (s *APISuite) TestRefundPaymentHandler() {
+	testCases := []struct {
+		name           string
+		req            *genPaymentV1.RefundPaymentRequest
+		gotErr         error
+		expectedCode   codes.Code
+		expectedFields []string
+		setupMock      func(*genPaymentV1.RefundPaymentRequest, error)
+	}{
+		{
+			name: "Happy path",
+			req:  &genPaymentV1.RefundPaymentRequest{TransactionUuid: uuid.NewString(), Reason: "damaged"},
+			setupMock: func(req *genPaymentV1.RefundPaymentRequest, err error) {
+				s.service.On("RefundPayment", s.ctx, converter.RefundInputFromRequest(req)).
+					Return(model.RefundPaymentOutput{RefundUUID: uuid.NewString()}, err).Once()
+			},
+		},
+		{
+			name:         "Transaction not found",
+			req:          &genPaymentV1.RefundPaymentRequest{TransactionUuid: uuid.NewString()},
+			gotErr:       model.ErrTransactionNotFound,
+			expectedCode: codes.NotFound,
+			setupMock: func(req *genPaymentV1.RefundPaymentRequest, err error) {
+				s.service.On("RefundPayment", s.ctx, converter.RefundInputFromRequest(req)).
+					Return(model.RefundPaymentOutput{}, err).Once()
+			},
+		},
+		{
+			name:           "Malformed transaction uuid",
+			req:            &genPaymentV1.RefundPaymentRequest{TransactionUuid: "tx-1"},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"transaction_uuid"},
+			setupMock:      func(req *genPaymentV1.RefundPaymentRequest, err error) {},
+		},
+		{
+			name:           "Malformed refund uuid",
+			req:            &genPaymentV1.RefundPaymentRequest{TransactionUuid: uuid.NewString(), RefundUuid: "refund-1"},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"refund_uuid"},
+			setupMock:      func(req *genPaymentV1.RefundPaymentRequest, err error) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			tc.setupMock(tc.req, tc.gotErr)
+
+			// act
+			resp, err := interceptor.InvokeValidated(s.ctx, tc.req, s.api.RefundPayment)
+
+			// assert
+			if tc.expectedCode == codes.OK {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(resp.GetRefundUuid())
+			} else {
+				s.Require().Nil(resp)
+				s.Require().Equal(tc.expectedCode, status.Code(err))
+				s.Require().ElementsMatch(tc.expectedFields, violatedFields(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package payment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/xgmsx/rsf/payment/internal/service/mocks"
+)
+
+type APISuite struct {
+	suite.Suite
+
+	ctx     context.Context //nolint:containedctx
+	service *mocks.PaymentService
+	api     *paymentAPI
+}
+
+func (s *APISuite) SetupTest() {
+	s.ctx = context.Background()
+	s.service = mocks.NewPaymentService(s.T())
+	s.api = NewPaymentAPI(s.service)
+}
+
+func (s *APISuite) TearDownTest() {}
+
+func TestPaymentAPI(t *testing.T) {
+	suite.Run(t, new(APISuite))
+}
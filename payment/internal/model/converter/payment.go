@@ -2,6 +2,8 @@ package converter
 
 import (
 	"github.com/xgmsx/rsf/payment/internal/model"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+	genCommonV1 "github.com/xgmsx/rsf/shared/pkg/proto/common/v1"
 	genPaymentV1 "github.com/xgmsx/rsf/shared/pkg/proto/payment/v1"
 )
 
@@ -9,6 +11,7 @@ func PayInputFromRequest(request *genPaymentV1.PayOrderRequest) model.PayOrderIn
 	return model.PayOrderInput{
 		OrderID:       request.GetOrderUuid(),
 		PaymentMethod: model.PaymentMethod(request.GetPaymentMethod()),
+		Amount:        money.New(request.GetAmount().GetAmount(), request.GetAmount().GetCurrency()),
 	}
 }
 
@@ -17,3 +20,27 @@ func PayOutputToResponse(output model.PayOrderOutput) *genPaymentV1.PayOrderResp
 		TransactionUuid: output.TransactionUUID,
 	}
 }
+
+func RefundInputFromRequest(request *genPaymentV1.RefundPaymentRequest) model.RefundPaymentInput {
+	return model.RefundPaymentInput{
+		TransactionUUID: request.GetTransactionUuid(),
+		Amount:          money.New(request.GetAmount().GetAmount(), request.GetAmount().GetCurrency()),
+		Reason:          request.GetReason(),
+		RefundUUID:      request.GetRefundUuid(),
+	}
+}
+
+func RefundOutputToResponse(output model.RefundPaymentOutput) *genPaymentV1.RefundPaymentResponse {
+	return &genPaymentV1.RefundPaymentResponse{
+		RefundUuid:      output.RefundUUID,
+		RefundedAmount:  MoneyToProto(output.RefundedAmount),
+		RemainingAmount: MoneyToProto(output.RemainingAmount),
+	}
+}
+
+func MoneyToProto(m money.Money) *genCommonV1.Money {
+	return &genCommonV1.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
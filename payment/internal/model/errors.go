@@ -2,4 +2,10 @@ package model
 
 import "errors"
 
-var ErrInvalidPaymentMethod = errors.New("invalid payment method provided")
+var (
+	ErrInvalidPaymentMethod = errors.New("invalid payment method provided")
+	ErrInvalidAmount        = errors.New("invalid payment amount provided")
+
+	ErrTransactionNotFound  = errors.New("transaction not found")
+	ErrRefundExceedsPayment = errors.New("refund amount exceeds the refundable payment amount")
+)
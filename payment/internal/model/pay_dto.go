@@ -1,8 +1,12 @@
 package model
 
+import "github.com/xgmsx/rsf/shared/pkg/money"
+
 type PayOrderInput struct {
 	OrderID       string
 	PaymentMethod PaymentMethod
+	// Amount не заполнена у старых клиентов, которые не передают сумму
+	Amount money.Money
 }
 
 type PayOrderOutput struct {
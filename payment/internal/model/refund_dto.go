@@ -0,0 +1,19 @@
+package model
+
+import "github.com/xgmsx/rsf/shared/pkg/money"
+
+type RefundPaymentInput struct {
+	TransactionUUID string
+	// Amount не заполнена, если нужно вернуть весь остаток платежа
+	Amount money.Money
+	Reason string
+	// RefundUUID - идентификатор возврата от клиента, по нему повторный
+	// запрос возвращает уже проведенный возврат. Может быть пустым
+	RefundUUID string
+}
+
+type RefundPaymentOutput struct {
+	RefundUUID      string
+	RefundedAmount  money.Money
+	RemainingAmount money.Money
+}
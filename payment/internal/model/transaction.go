@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"github.com/xgmsx/rsf/shared/pkg/money"
+)
+
+// Transaction - проведенная оплата заказа
+type Transaction struct {
+	TransactionUUID string
+	OrderID         string
+	PaymentMethod   PaymentMethod
+	Amount          money.Money
+	// Refunded - сумма всех возвратов по транзакции
+	Refunded  money.Money
+	Refunds   []Refund
+	CreatedAt time.Time
+}
+
+// Refund - возврат части или всей суммы транзакции
+type Refund struct {
+	RefundUUID      string
+	TransactionUUID string
+	Amount          money.Money
+	Reason          string
+	CreatedAt       time.Time
+}
+
+// Refundable возвращает сумму транзакции, которую еще можно вернуть
+func (t Transaction) Refundable() (money.Money, error) {
+	return t.Amount.Sub(t.Refunded)
+}
+
+// Refund возвращает возврат транзакции с идентификатором refundUUID
+func (t Transaction) Refund(refundUUID string) (Refund, bool) {
+	if refundUUID == "" {
+		return Refund{}, false
+	}
+	for _, refund := range t.Refunds {
+		if refund.RefundUUID == refundUUID {
+			return refund, true
+		}
+	}
+	return Refund{}, false
+}
@@ -0,0 +1,208 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/xgmsx/rsf/payment/internal/model"
+)
+
+// TransactionRepository is an autogenerated mock type for the TransactionRepository type
+type TransactionRepository struct {
+	mock.Mock
+}
+
+type TransactionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TransactionRepository) EXPECT() *TransactionRepository_Expecter {
+	return &TransactionRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddRefund provides a mock function with given fields: ctx, refund
+func (_m *TransactionRepository) AddRefund(ctx context.Context, refund model.Refund) (model.Transaction, error) {
+	ret := _m.Called(ctx, refund)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRefund")
+	}
+
+	var r0 model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Refund) (model.Transaction, error)); ok {
+		return rf(ctx, refund)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Refund) model.Transaction); ok {
+		r0 = rf(ctx, refund)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Refund) error); ok {
+		r1 = rf(ctx, refund)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_AddRefund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRefund'
+type TransactionRepository_AddRefund_Call struct {
+	*mock.Call
+}
+
+// AddRefund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refund model.Refund
+func (_e *TransactionRepository_Expecter) AddRefund(ctx interface{}, refund interface{}) *TransactionRepository_AddRefund_Call {
+	return &TransactionRepository_AddRefund_Call{Call: _e.mock.On("AddRefund", ctx, refund)}
+}
+
+func (_c *TransactionRepository_AddRefund_Call) Run(run func(ctx context.Context, refund model.Refund)) *TransactionRepository_AddRefund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Refund))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_AddRefund_Call) Return(_a0 model.Transaction, _a1 error) *TransactionRepository_AddRefund_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_AddRefund_Call) RunAndReturn(run func(context.Context, model.Refund) (model.Transaction, error)) *TransactionRepository_AddRefund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, transactionUUID
+func (_m *TransactionRepository) Get(ctx context.Context, transactionUUID string) (model.Transaction, error) {
+	ret := _m.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Transaction, error)); ok {
+		return rf(ctx, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Transaction); ok {
+		r0 = rf(ctx, transactionUUID)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type TransactionRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *TransactionRepository_Expecter) Get(ctx interface{}, transactionUUID interface{}) *TransactionRepository_Get_Call {
+	return &TransactionRepository_Get_Call{Call: _e.mock.On("Get", ctx, transactionUUID)}
+}
+
+func (_c *TransactionRepository_Get_Call) Run(run func(ctx context.Context, transactionUUID string)) *TransactionRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_Get_Call) Return(_a0 model.Transaction, _a1 error) *TransactionRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_Get_Call) RunAndReturn(run func(context.Context, string) (model.Transaction, error)) *TransactionRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrCreateByOrder provides a mock function with given fields: ctx, transaction
+func (_m *TransactionRepository) GetOrCreateByOrder(ctx context.Context, transaction model.Transaction) (model.Transaction, error) {
+	ret := _m.Called(ctx, transaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrCreateByOrder")
+	}
+
+	var r0 model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Transaction) (model.Transaction, error)); ok {
+		return rf(ctx, transaction)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Transaction) model.Transaction); ok {
+		r0 = rf(ctx, transaction)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Transaction) error); ok {
+		r1 = rf(ctx, transaction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_GetOrCreateByOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrCreateByOrder'
+type TransactionRepository_GetOrCreateByOrder_Call struct {
+	*mock.Call
+}
+
+// GetOrCreateByOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transaction model.Transaction
+func (_e *TransactionRepository_Expecter) GetOrCreateByOrder(ctx interface{}, transaction interface{}) *TransactionRepository_GetOrCreateByOrder_Call {
+	return &TransactionRepository_GetOrCreateByOrder_Call{Call: _e.mock.On("GetOrCreateByOrder", ctx, transaction)}
+}
+
+func (_c *TransactionRepository_GetOrCreateByOrder_Call) Run(run func(ctx context.Context, transaction model.Transaction)) *TransactionRepository_GetOrCreateByOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Transaction))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_GetOrCreateByOrder_Call) Return(_a0 model.Transaction, _a1 error) *TransactionRepository_GetOrCreateByOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_GetOrCreateByOrder_Call) RunAndReturn(run func(context.Context, model.Transaction) (model.Transaction, error)) *TransactionRepository_GetOrCreateByOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransactionRepository creates a new instance of TransactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransactionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransactionRepository {
+	mock := &TransactionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
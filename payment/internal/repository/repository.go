@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/xgmsx/rsf/payment/internal/model"
+)
+
+type TransactionRepository interface {
+	// GetOrCreateByOrder атомарно возвращает последнюю транзакцию заказа
+	// transaction.OrderID, если ее сумма совпадает с transaction.Amount и она
+	// возвращена не полностью. Иначе сохраняет и возвращает transaction.
+	GetOrCreateByOrder(ctx context.Context, transaction model.Transaction) (model.Transaction, error)
+	// Get возвращает model.ErrTransactionNotFound, если транзакции нет
+	Get(ctx context.Context, transactionUUID string) (model.Transaction, error)
+	// AddRefund атомарно добавляет возврат к транзакции и возвращает ее новое
+	// состояние. Если сумма возвратов превысит сумму транзакции, транзакция
+	// не меняется и возвращается model.ErrRefundExceedsPayment. Возврат
+	// с уже сохраненным RefundUUID не добавляется повторно.
+	AddRefund(ctx context.Context, refund model.Refund) (model.Transaction, error)
+}
@@ -0,0 +1,97 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/xgmsx/rsf/payment/internal/model"
+	def "github.com/xgmsx/rsf/payment/internal/repository"
+)
+
+var _ def.TransactionRepository = (*transactionRepository)(nil)
+
+type transactionRepository struct {
+	mu   sync.RWMutex
+	data map[string]*model.Transaction
+}
+
+func NewTransactionRepository() *transactionRepository {
+	return &transactionRepository{
+		data: make(map[string]*model.Transaction),
+	}
+}
+
+func (r *transactionRepository) GetOrCreateByOrder(_ context.Context, transaction model.Transaction) (model.Transaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	latest := r.latestByOrderLocked(transaction.OrderID)
+	if latest != nil && latest.Amount == transaction.Amount && latest.Refunded.Amount < latest.Amount.Amount {
+		return *cloneTransaction(latest), nil
+	}
+
+	if _, ok := r.data[transaction.TransactionUUID]; ok {
+		return model.Transaction{}, fmt.Errorf("transaction %s already exists", transaction.TransactionUUID)
+	}
+	r.data[transaction.TransactionUUID] = cloneTransaction(&transaction)
+	return *cloneTransaction(&transaction), nil
+}
+
+func (r *transactionRepository) Get(_ context.Context, transactionUUID string) (model.Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	transaction, ok := r.data[transactionUUID]
+	if !ok {
+		return model.Transaction{}, model.ErrTransactionNotFound
+	}
+	return *cloneTransaction(transaction), nil
+}
+
+func (r *transactionRepository) AddRefund(_ context.Context, refund model.Refund) (model.Transaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	transaction, ok := r.data[refund.TransactionUUID]
+	if !ok {
+		return model.Transaction{}, model.ErrTransactionNotFound
+	}
+	if _, ok := transaction.Refund(refund.RefundUUID); ok {
+		return *cloneTransaction(transaction), nil
+	}
+
+	refunded, err := transaction.Refunded.Add(refund.Amount)
+	if err != nil {
+		return model.Transaction{}, fmt.Errorf("%w: %w", model.ErrInvalidAmount, err)
+	}
+	if refunded.Amount > transaction.Amount.Amount {
+		return model.Transaction{}, model.ErrRefundExceedsPayment
+	}
+
+	transaction.Refunded = refunded
+	transaction.Refunds = append(transaction.Refunds, refund)
+	return *cloneTransaction(transaction), nil
+}
+
+// latestByOrderLocked возвращает последнюю транзакцию заказа или nil.
+// Вызывается под r.mu.
+func (r *transactionRepository) latestByOrderLocked(orderID string) *model.Transaction {
+	var latest *model.Transaction
+	for _, transaction := range r.data {
+		if transaction.OrderID != orderID {
+			continue
+		}
+		if latest == nil || transaction.CreatedAt.After(latest.CreatedAt) {
+			latest = transaction
+		}
+	}
+	return latest
+}
+
+func cloneTransaction(transaction *model.Transaction) *model.Transaction {
+	clone := *transaction
+	clone.Refunds = slices.Clone(transaction.Refunds)
+	return &clone
+}
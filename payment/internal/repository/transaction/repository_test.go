@@ -0,0 +1,142 @@
+package transaction
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgmsx/rsf/payment/internal/model"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+)
+
+func TestAddRefund(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTransactionRepository()
+
+	transaction := model.Transaction{
+		TransactionUUID: uuid.NewString(),
+		OrderID:         uuid.NewString(),
+		Amount:          money.New(10000, "RUB"),
+	}
+	_, err := repo.GetOrCreateByOrder(ctx, transaction)
+	require.NoError(t, err)
+
+	newRefund := func(amount int64) model.Refund {
+		return model.Refund{
+			RefundUUID:      uuid.NewString(),
+			TransactionUUID: transaction.TransactionUUID,
+			Amount:          money.New(amount, "RUB"),
+		}
+	}
+
+	first := newRefund(4000)
+	updated, err := repo.AddRefund(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, money.New(4000, "RUB"), updated.Refunded)
+
+	// Повтор возврата с тем же идентификатором не меняет транзакцию
+	updated, err = repo.AddRefund(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, money.New(4000, "RUB"), updated.Refunded)
+	require.Len(t, updated.Refunds, 1)
+
+	_, err = repo.AddRefund(ctx, newRefund(6001))
+	require.ErrorIs(t, err, model.ErrRefundExceedsPayment)
+
+	updated, err = repo.AddRefund(ctx, newRefund(6000))
+	require.NoError(t, err)
+	require.Equal(t, transaction.Amount, updated.Refunded)
+	require.Len(t, updated.Refunds, 2)
+
+	stored, err := repo.Get(ctx, transaction.TransactionUUID)
+	require.NoError(t, err)
+	require.Equal(t, updated, stored)
+
+	_, err = repo.AddRefund(ctx, model.Refund{TransactionUUID: uuid.NewString(), Amount: money.New(1, "RUB")})
+	require.ErrorIs(t, err, model.ErrTransactionNotFound)
+}
+
+func TestGetOrCreateByOrder(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTransactionRepository()
+	orderID := uuid.NewString()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newTransaction := func(orderID string, amount int64, at time.Time) model.Transaction {
+		return model.Transaction{
+			TransactionUUID: uuid.NewString(),
+			OrderID:         orderID,
+			Amount:          money.New(amount, "RUB"),
+			CreatedAt:       at,
+		}
+	}
+
+	first := newTransaction(orderID, 10000, createdAt)
+	got, err := repo.GetOrCreateByOrder(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, first, got)
+
+	// Повторная оплата той же суммой возвращает существующую транзакцию
+	got, err = repo.GetOrCreateByOrder(ctx, newTransaction(orderID, 10000, createdAt.Add(time.Hour)))
+	require.NoError(t, err)
+	require.Equal(t, first.TransactionUUID, got.TransactionUUID)
+
+	// Оплата другой суммой создает новую транзакцию, и дальше возвращается она
+	second := newTransaction(orderID, 20000, createdAt.Add(time.Hour))
+	got, err = repo.GetOrCreateByOrder(ctx, second)
+	require.NoError(t, err)
+	require.Equal(t, second.TransactionUUID, got.TransactionUUID)
+
+	_, err = repo.GetOrCreateByOrder(ctx, newTransaction(uuid.NewString(), 20000, createdAt.Add(2*time.Hour)))
+	require.NoError(t, err)
+
+	got, err = repo.GetOrCreateByOrder(ctx, newTransaction(orderID, 20000, createdAt.Add(2*time.Hour)))
+	require.NoError(t, err)
+	require.Equal(t, second.TransactionUUID, got.TransactionUUID)
+
+	// Полностью возвращенная оплата не считается: заказ оплачивается заново
+	_, err = repo.AddRefund(ctx, model.Refund{
+		RefundUUID:      uuid.NewString(),
+		TransactionUUID: second.TransactionUUID,
+		Amount:          second.Amount,
+	})
+	require.NoError(t, err)
+	got, err = repo.GetOrCreateByOrder(ctx, newTransaction(orderID, 20000, createdAt.Add(3*time.Hour)))
+	require.NoError(t, err)
+	require.NotEqual(t, second.TransactionUUID, got.TransactionUUID)
+}
+
+func TestGetOrCreateByOrderConcurrently(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTransactionRepository()
+	orderID := uuid.NewString()
+
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		ids = make(map[string]struct{})
+	)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := repo.GetOrCreateByOrder(ctx, model.Transaction{
+				TransactionUUID: uuid.NewString(),
+				OrderID:         orderID,
+				Amount:          money.New(10000, "RUB"),
+				CreatedAt:       time.Now().UTC(),
+			})
+			require.NoError(t, err)
+			mu.Lock()
+			ids[got.TransactionUUID] = struct{}{}
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, ids, 1)
+}
@@ -79,6 +79,63 @@ func (_c *PaymentService_PayOrder_Call) RunAndReturn(run func(context.Context, m
 	return _c
 }
 
+// RefundPayment provides a mock function with given fields: ctx, input
+func (_m *PaymentService) RefundPayment(ctx context.Context, input model.RefundPaymentInput) (model.RefundPaymentOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 model.RefundPaymentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefundPaymentInput) (model.RefundPaymentOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefundPaymentInput) model.RefundPaymentOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(model.RefundPaymentOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.RefundPaymentInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_RefundPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundPayment'
+type PaymentService_RefundPayment_Call struct {
+	*mock.Call
+}
+
+// RefundPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input model.RefundPaymentInput
+func (_e *PaymentService_Expecter) RefundPayment(ctx interface{}, input interface{}) *PaymentService_RefundPayment_Call {
+	return &PaymentService_RefundPayment_Call{Call: _e.mock.On("RefundPayment", ctx, input)}
+}
+
+func (_c *PaymentService_RefundPayment_Call) Run(run func(ctx context.Context, input model.RefundPaymentInput)) *PaymentService_RefundPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.RefundPaymentInput))
+	})
+	return _c
+}
+
+func (_c *PaymentService_RefundPayment_Call) Return(_a0 model.RefundPaymentOutput, _a1 error) *PaymentService_RefundPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_RefundPayment_Call) RunAndReturn(run func(context.Context, model.RefundPaymentInput) (model.RefundPaymentOutput, error)) *PaymentService_RefundPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPaymentService creates a new instance of PaymentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPaymentService(t interface {
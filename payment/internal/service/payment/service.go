@@ -2,27 +2,113 @@ package payment
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/xgmsx/rsf/payment/internal/model"
+	"github.com/xgmsx/rsf/payment/internal/repository"
 	def "github.com/xgmsx/rsf/payment/internal/service"
 )
 
 var _ def.PaymentService = (*paymentService)(nil)
 
-type paymentService struct{}
+type paymentService struct {
+	repo repository.TransactionRepository
+}
 
-func NewService() *paymentService {
-	return &paymentService{}
+func NewService(repo repository.TransactionRepository) *paymentService {
+	return &paymentService{repo: repo}
 }
 
 func (s *paymentService) PayOrder(ctx context.Context, input model.PayOrderInput) (model.PayOrderOutput, error) {
 	if input.PaymentMethod == model.PaymentMethod_UNSPECIFIED {
 		return model.PayOrderOutput{}, model.ErrInvalidPaymentMethod
 	}
-	transactionUUID := uuid.New()
+	if input.Amount.Amount < 0 || (input.Amount.Amount > 0 && len(input.Amount.Currency) != 3) {
+		return model.PayOrderOutput{}, model.ErrInvalidAmount
+	}
+
+	// Повторная оплата заказа (например, при восстановлении саги в order)
+	// возвращает уже проведенную транзакцию, а не списывает средства еще раз.
+	// Полностью возвращенная оплата не считается: заказ можно оплатить заново.
+	transaction, err := s.repo.GetOrCreateByOrder(ctx, model.Transaction{
+		TransactionUUID: uuid.New().String(),
+		OrderID:         input.OrderID,
+		PaymentMethod:   input.PaymentMethod,
+		Amount:          input.Amount,
+		CreatedAt:       time.Now().UTC(),
+	})
+	if err != nil {
+		return model.PayOrderOutput{}, err
+	}
+
 	return model.PayOrderOutput{
-		TransactionUUID: transactionUUID.String(),
+		TransactionUUID: transaction.TransactionUUID,
+	}, nil
+}
+
+func (s *paymentService) RefundPayment(ctx context.Context, input model.RefundPaymentInput) (model.RefundPaymentOutput, error) {
+	if input.Amount.Amount < 0 {
+		return model.RefundPaymentOutput{}, model.ErrInvalidAmount
+	}
+
+	transaction, err := s.repo.Get(ctx, input.TransactionUUID)
+	if err != nil {
+		return model.RefundPaymentOutput{}, err
+	}
+	// Повторный возврат с тем же идентификатором (например, при восстановлении
+	// саги в order) возвращает уже проведенный возврат
+	if refund, ok := transaction.Refund(input.RefundUUID); ok {
+		return refundOutput(transaction, refund)
+	}
+
+	amount := input.Amount
+	if amount.IsZero() {
+		amount, err = transaction.Refundable()
+		if err != nil {
+			return model.RefundPaymentOutput{}, err
+		}
+		if amount.IsZero() {
+			return model.RefundPaymentOutput{}, model.ErrRefundExceedsPayment
+		}
+	}
+	if amount.Currency != transaction.Amount.Currency {
+		return model.RefundPaymentOutput{}, model.ErrInvalidAmount
+	}
+
+	refundUUID := input.RefundUUID
+	if refundUUID == "" {
+		refundUUID = uuid.New().String()
+	}
+	refund := model.Refund{
+		RefundUUID:      refundUUID,
+		TransactionUUID: transaction.TransactionUUID,
+		Amount:          amount,
+		Reason:          input.Reason,
+		CreatedAt:       time.Now().UTC(),
+	}
+	transaction, err = s.repo.AddRefund(ctx, refund)
+	if err != nil {
+		return model.RefundPaymentOutput{}, err
+	}
+	// Параллельный запрос с тем же идентификатором мог провести возврат раньше
+	if stored, ok := transaction.Refund(refund.RefundUUID); ok {
+		refund = stored
+	}
+
+	return refundOutput(transaction, refund)
+}
+
+func refundOutput(transaction model.Transaction, refund model.Refund) (model.RefundPaymentOutput, error) {
+	remaining, err := transaction.Refundable()
+	if err != nil {
+		return model.RefundPaymentOutput{}, err
+	}
+
+	return model.RefundPaymentOutput{
+		RefundUUID:      refund.RefundUUID,
+		RefundedAmount:  refund.Amount,
+		RemainingAmount: remaining,
 	}, nil
 }
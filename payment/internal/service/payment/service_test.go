@@ -1 +1,283 @@
 package payment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/xgmsx/rsf/payment/internal/model"
+	"github.com/xgmsx/rsf/shared/pkg/money"
+)
+
+func newTransaction(amount, refunded int64) model.Transaction {
+	return model.Transaction{
+		TransactionUUID: uuid.NewString(),
+		OrderID:         uuid.NewString(),
+		PaymentMethod:   model.PaymentMethod_CARD,
+		Amount:          money.New(amount, "RUB"),
+		Refunded:        money.New(refunded, "RUB"),
+	}
+}
+
+func (s *ServiceSuite) TestPayOrder() {
+	testCases := []struct {
+		name        string
+		input       model.PayOrderInput
+		expectedErr error
+		// expectedTransactionUUID - UUID уже проведенной транзакции, если она должна вернуться
+		expectedTransactionUUID string
+		setupMock               func(model.PayOrderInput)
+	}{
+		{
+			name: "Happy path",
+			input: model.PayOrderInput{
+				OrderID:       uuid.NewString(),
+				PaymentMethod: model.PaymentMethod_SBP,
+				Amount:        money.New(10000, "RUB"),
+			},
+			setupMock: func(input model.PayOrderInput) {
+				s.transactionRepo.On("GetOrCreateByOrder", s.ctx, mock.MatchedBy(func(t model.Transaction) bool {
+					return t.OrderID == input.OrderID && t.Amount == input.Amount && t.Refunded.IsZero()
+				})).Return(func(_ context.Context, t model.Transaction) (model.Transaction, error) {
+					return t, nil
+				}).Once()
+			},
+		},
+		{
+			name: "Repeated payment returns existing transaction",
+			input: model.PayOrderInput{
+				OrderID:       "3c6bd1a8-6d0b-4b57-8f1e-62a8d4ad2d10",
+				PaymentMethod: model.PaymentMethod_CARD,
+				Amount:        money.New(10000, "RUB"),
+			},
+			expectedTransactionUUID: "0f4a6f1c-2c8e-4f8e-9c55-4d1b8f1e9a01",
+			setupMock: func(input model.PayOrderInput) {
+				existing := newTransaction(10000, 2500)
+				existing.TransactionUUID = "0f4a6f1c-2c8e-4f8e-9c55-4d1b8f1e9a01"
+				existing.OrderID = input.OrderID
+				s.transactionRepo.On("GetOrCreateByOrder", s.ctx, mock.Anything).Return(existing, nil).Once()
+			},
+		},
+		{
+			name: "Repository error",
+			input: model.PayOrderInput{
+				OrderID:       uuid.NewString(),
+				PaymentMethod: model.PaymentMethod_CARD,
+				Amount:        money.New(10000, "RUB"),
+			},
+			expectedErr: assert.AnError,
+			setupMock: func(model.PayOrderInput) {
+				s.transactionRepo.On("GetOrCreateByOrder", s.ctx, mock.Anything).Return(model.Transaction{}, assert.AnError).Once()
+			},
+		},
+		{
+			name:        "Unspecified payment method",
+			input:       model.PayOrderInput{OrderID: uuid.NewString(), Amount: money.New(10000, "RUB")},
+			expectedErr: model.ErrInvalidPaymentMethod,
+			setupMock:   func(model.PayOrderInput) {},
+		},
+		{
+			name: "Negative amount",
+			input: model.PayOrderInput{
+				OrderID:       uuid.NewString(),
+				PaymentMethod: model.PaymentMethod_CARD,
+				Amount:        money.New(-1, "RUB"),
+			},
+			expectedErr: model.ErrInvalidAmount,
+			setupMock:   func(model.PayOrderInput) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			tc.setupMock(tc.input)
+
+			// act
+			output, err := s.service.PayOrder(s.ctx, tc.input)
+
+			// assert
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Empty(output)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(uuid.Validate(output.TransactionUUID))
+				if tc.expectedTransactionUUID != "" {
+					s.Require().Equal(tc.expectedTransactionUUID, output.TransactionUUID)
+				}
+			}
+		})
+	}
+}
+
+func (s *ServiceSuite) TestRefundPayment() {
+	testCases := []struct {
+		name              string
+		transaction       model.Transaction
+		amount            money.Money
+		refundUUID        string
+		expectedRefunded  money.Money
+		expectedRemaining money.Money
+		expectedErr       error
+		setupMock         func(model.Transaction)
+	}{
+		{
+			name:              "Full refund",
+			transaction:       newTransaction(10000, 0),
+			expectedRefunded:  money.New(10000, "RUB"),
+			expectedRemaining: money.New(0, "RUB"),
+			setupMock: func(t model.Transaction) {
+				s.transactionRepo.On("Get", s.ctx, t.TransactionUUID).Return(t, nil).Once()
+				s.transactionRepo.On("AddRefund", s.ctx, mock.MatchedBy(func(r model.Refund) bool {
+					return r.TransactionUUID == t.TransactionUUID && r.Amount == money.New(10000, "RUB")
+				})).Return(func() model.Transaction {
+					t.Refunded = t.Amount
+					return t
+				}(), nil).Once()
+			},
+		},
+		{
+			name:              "Partial refund",
+			transaction:       newTransaction(10000, 0),
+			amount:            money.New(2500, "RUB"),
+			expectedRefunded:  money.New(2500, "RUB"),
+			expectedRemaining: money.New(7500, "RUB"),
+			setupMock: func(t model.Transaction) {
+				s.transactionRepo.On("Get", s.ctx, t.TransactionUUID).Return(t, nil).Once()
+				s.transactionRepo.On("AddRefund", s.ctx, mock.MatchedBy(func(r model.Refund) bool {
+					return r.Amount == money.New(2500, "RUB")
+				})).Return(func() model.Transaction {
+					t.Refunded = money.New(2500, "RUB")
+					return t
+				}(), nil).Once()
+			},
+		},
+		{
+			name:              "Full refund of partially refunded transaction",
+			transaction:       newTransaction(10000, 2500),
+			expectedRefunded:  money.New(7500, "RUB"),
+			expectedRemaining: money.New(0, "RUB"),
+			setupMock: func(t model.Transaction) {
+				s.transactionRepo.On("Get", s.ctx, t.TransactionUUID).Return(t, nil).Once()
+				s.transactionRepo.On("AddRefund", s.ctx, mock.MatchedBy(func(r model.Refund) bool {
+					return r.Amount == money.New(7500, "RUB")
+				})).Return(func() model.Transaction {
+					t.Refunded = t.Amount
+					return t
+				}(), nil).Once()
+			},
+		},
+		{
+			name:              "Repeated refund returns existing refund",
+			transaction:       newTransaction(10000, 10000),
+			refundUUID:        "6a1f2b7e-3c4d-4e5f-8a9b-0c1d2e3f4a5b",
+			expectedRefunded:  money.New(10000, "RUB"),
+			expectedRemaining: money.New(0, "RUB"),
+			setupMock: func(t model.Transaction) {
+				t.Refunds = []model.Refund{{
+					RefundUUID:      "6a1f2b7e-3c4d-4e5f-8a9b-0c1d2e3f4a5b",
+					TransactionUUID: t.TransactionUUID,
+					Amount:          money.New(10000, "RUB"),
+				}}
+				s.transactionRepo.On("Get", s.ctx, t.TransactionUUID).Return(t, nil).Once()
+			},
+		},
+		{
+			name:              "Refund with client refund uuid",
+			transaction:       newTransaction(10000, 0),
+			amount:            money.New(2500, "RUB"),
+			refundUUID:        "7b2a3c8f-4d5e-4f60-9bac-1d2e3f4a5b6c",
+			expectedRefunded:  money.New(2500, "RUB"),
+			expectedRemaining: money.New(7500, "RUB"),
+			setupMock: func(t model.Transaction) {
+				s.transactionRepo.On("Get", s.ctx, t.TransactionUUID).Return(t, nil).Once()
+				s.transactionRepo.On("AddRefund", s.ctx, mock.MatchedBy(func(r model.Refund) bool {
+					return r.RefundUUID == "7b2a3c8f-4d5e-4f60-9bac-1d2e3f4a5b6c"
+				})).Return(func() model.Transaction {
+					t.Refunded = money.New(2500, "RUB")
+					t.Refunds = []model.Refund{{
+						RefundUUID:      "7b2a3c8f-4d5e-4f60-9bac-1d2e3f4a5b6c",
+						TransactionUUID: t.TransactionUUID,
+						Amount:          money.New(2500, "RUB"),
+					}}
+					return t
+				}(), nil).Once()
+			},
+		},
+		{
+			name:        "Already fully refunded",
+			transaction: newTransaction(10000, 10000),
+			expectedErr: model.ErrRefundExceedsPayment,
+			setupMock: func(t model.Transaction) {
+				s.transactionRepo.On("Get", s.ctx, t.TransactionUUID).Return(t, nil).Once()
+			},
+		},
+		{
+			name:        "Refund exceeds payment",
+			transaction: newTransaction(10000, 5000),
+			amount:      money.New(6000, "RUB"),
+			expectedErr: model.ErrRefundExceedsPayment,
+			setupMock: func(t model.Transaction) {
+				s.transactionRepo.On("Get", s.ctx, t.TransactionUUID).Return(t, nil).Once()
+				s.transactionRepo.On("AddRefund", s.ctx, mock.Anything).Return(model.Transaction{}, model.ErrRefundExceedsPayment).Once()
+			},
+		},
+		{
+			name:        "Currency mismatch",
+			transaction: newTransaction(10000, 0),
+			amount:      money.New(100, "USD"),
+			expectedErr: model.ErrInvalidAmount,
+			setupMock: func(t model.Transaction) {
+				s.transactionRepo.On("Get", s.ctx, t.TransactionUUID).Return(t, nil).Once()
+			},
+		},
+		{
+			name:        "Negative amount",
+			transaction: newTransaction(10000, 0),
+			amount:      money.New(-100, "RUB"),
+			expectedErr: model.ErrInvalidAmount,
+			setupMock:   func(model.Transaction) {},
+		},
+		{
+			name:        "Transaction not found",
+			transaction: newTransaction(10000, 0),
+			expectedErr: model.ErrTransactionNotFound,
+			setupMock: func(t model.Transaction) {
+				s.transactionRepo.On("Get", s.ctx, t.TransactionUUID).Return(model.Transaction{}, model.ErrTransactionNotFound).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// arrange
+			tc.setupMock(tc.transaction)
+			input := model.RefundPaymentInput{
+				TransactionUUID: tc.transaction.TransactionUUID,
+				Amount:          tc.amount,
+				Reason:          "customer request",
+				RefundUUID:      tc.refundUUID,
+			}
+
+			// act
+			output, err := s.service.RefundPayment(s.ctx, input)
+
+			// assert
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Empty(output)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(uuid.Validate(output.RefundUUID))
+				if tc.refundUUID != "" {
+					s.Require().Equal(tc.refundUUID, output.RefundUUID)
+				}
+				s.Require().Equal(tc.expectedRefunded, output.RefundedAmount)
+				s.Require().Equal(tc.expectedRemaining, output.RemainingAmount)
+			}
+		})
+	}
+}
@@ -1 +1,30 @@
 package payment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/xgmsx/rsf/payment/internal/repository/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx             context.Context //nolint:containedctx
+	transactionRepo *mocks.TransactionRepository
+	service         *paymentService
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.transactionRepo = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepo)
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestPaymentService(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
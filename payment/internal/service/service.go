@@ -8,4 +8,7 @@ import (
 
 type PaymentService interface {
 	PayOrder(ctx context.Context, input model.PayOrderInput) (model.PayOrderOutput, error)
+	// RefundPayment возвращает указанную сумму или, если она не задана,
+	// весь остаток транзакции
+	RefundPayment(ctx context.Context, input model.RefundPaymentInput) (model.RefundPaymentOutput, error)
 }
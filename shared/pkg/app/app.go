@@ -0,0 +1,266 @@
+// Package app - общий каркас gRPC-сервиса: gRPC сервер, HTTP gateway к нему
+// со Swagger UI, метриками и проверками здоровья, фоновые задачи, обработка
+// сигналов и упорядоченная остановка.
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/xgmsx/rsf/shared/pkg/health"
+	"github.com/xgmsx/rsf/shared/pkg/interceptor"
+	"github.com/xgmsx/rsf/shared/pkg/logger"
+	"github.com/xgmsx/rsf/shared/pkg/metrics"
+	"github.com/xgmsx/rsf/shared/pkg/swagger"
+	"github.com/xgmsx/rsf/shared/pkg/tracing"
+)
+
+// Config - настройки серверов сервиса
+type Config struct {
+	// Name - имя сервиса в трассировке и логах
+	Name     string
+	GRPCPort int
+	HTTPPort int
+	// ReadHeaderTimeout ограничивает чтение заголовков запроса HTTP gateway
+	ReadHeaderTimeout time.Duration
+	// ShutdownTimeout ограничивает каждый этап остановки: HTTP gateway,
+	// gRPC сервер и фоновые задачи
+	ShutdownTimeout time.Duration
+	// SwaggerFile - файл спецификации в shared/api для Swagger UI, без Swagger UI, если пуст
+	SwaggerFile string
+	// Interceptors выполняются после стандартных request_id, логирования, метрик
+	// и перехвата паники, но до проверки запроса по правилам protoc-gen-validate
+	Interceptors []grpc.UnaryServerInterceptor
+}
+
+// GatewayRegisterFunc регистрирует обработчики gateway сервиса, которые
+// вызывают его по адресу endpoint. Совпадает с сигнатурой сгенерированных
+// функций Register<Service>HandlerFromEndpoint.
+type GatewayRegisterFunc func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
+
+// App владеет серверами сервиса и управляет их жизненным циклом
+type App struct {
+	cfg        Config
+	grpcServer *grpc.Server
+	gatewayMux *runtime.ServeMux
+	httpMux    *http.ServeMux
+	checker    *health.Checker
+	gateways   []GatewayRegisterFunc
+	services   []string
+	tasks      []func(ctx context.Context)
+}
+
+// New создает сервис с gRPC сервером, на котором уже зарегистрированы
+// grpc.health.v1 и reflection
+func New(cfg Config) *App {
+	interceptors := append([]grpc.UnaryServerInterceptor{
+		interceptor.RequestIDInterceptor(),
+		interceptor.LoggerInterceptor(),
+		interceptor.MetricsInterceptor(),
+		interceptor.RecoveryInterceptor(),
+	}, cfg.Interceptors...)
+	interceptors = append(interceptors, interceptor.ValidateInterceptor())
+
+	a := &App{
+		cfg: cfg,
+		grpcServer: grpc.NewServer(
+			tracing.ServerOption(),
+			grpc.ChainUnaryInterceptor(interceptors...),
+		),
+		gatewayMux: runtime.NewServeMux(),
+		httpMux:    http.NewServeMux(),
+		checker:    health.NewChecker(),
+	}
+	reflection.Register(a.grpcServer)
+	return a
+}
+
+// RegisterService регистрирует реализацию impl сервиса desc на gRPC сервере
+// и его обработчики gateway, если gateway задан
+func (a *App) RegisterService(desc *grpc.ServiceDesc, impl any, gateway GatewayRegisterFunc) {
+	a.grpcServer.RegisterService(desc, impl)
+	a.services = append(a.services, desc.ServiceName)
+	if gateway != nil {
+		a.gateways = append(a.gateways, gateway)
+	}
+}
+
+// Checker возвращает проверки готовности сервиса для добавления зависимостей
+func (a *App) Checker() *health.Checker {
+	return a.checker
+}
+
+// Handle добавляет обработчик HTTP сервера
+func (a *App) Handle(pattern string, handler http.Handler) {
+	a.httpMux.Handle(pattern, handler)
+}
+
+// Go добавляет фоновую задачу. Задача запускается в Run и должна завершиться,
+// когда ее контекст отменен при остановке.
+func (a *App) Go(task func(ctx context.Context)) {
+	a.tasks = append(a.tasks, task)
+}
+
+// Run запускает серверы и фоновые задачи и ждет SIGINT, SIGTERM или отмены ctx.
+// Затем сервис переводится в NOT_SERVING и останавливается по порядку:
+// HTTP gateway, gRPC сервер, фоновые задачи. Возвращает ошибку, если сервер
+// не удалось запустить или он завершился сам. Run вызывается один раз.
+func (a *App) Run(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	grpcListener, err := net.Listen("tcp", fmt.Sprintf(":%d", a.cfg.GRPCPort))
+	if err != nil {
+		return fmt.Errorf("failed to listen gRPC port: %w", err)
+	}
+	httpListener, err := net.Listen("tcp", fmt.Sprintf(":%d", a.cfg.HTTPPort))
+	if err != nil {
+		_ = grpcListener.Close()
+		return fmt.Errorf("failed to listen HTTP port: %w", err)
+	}
+
+	// Соединения gateway с gRPC сервером закрываются после остановки gateway
+	gatewayCtx, closeGateway := context.WithCancel(context.WithoutCancel(ctx))
+	defer closeGateway()
+	httpServer, err := a.newHTTPServer(gatewayCtx)
+	if err != nil {
+		_ = grpcListener.Close()
+		_ = httpListener.Close()
+		return err
+	}
+	a.checker.RegisterGRPC(a.grpcServer, a.services...)
+
+	serveErrs := make(chan error, 2)
+	go func() {
+		slog.Info("gRPC server listening", slog.Int("port", a.cfg.GRPCPort))
+		if serr := a.grpcServer.Serve(grpcListener); serr != nil {
+			serveErrs <- fmt.Errorf("failed to serve gRPC: %w", serr)
+		}
+	}()
+	go func() {
+		slog.Info("HTTP server with gRPC-Gateway and Swagger UI listening", slog.Int("port", a.cfg.HTTPPort))
+		if serr := httpServer.Serve(httpListener); !errors.Is(serr, http.ErrServerClosed) {
+			serveErrs <- fmt.Errorf("failed to serve HTTP: %w", serr)
+		}
+	}()
+
+	taskCtx, cancelTasks := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelTasks()
+	var tasks sync.WaitGroup
+	for _, task := range a.tasks {
+		tasks.Add(1)
+		go func() {
+			defer tasks.Done()
+			task(taskCtx)
+		}()
+	}
+
+	var runErr error
+	select {
+	case <-ctx.Done():
+	case runErr = <-serveErrs:
+		slog.Error("server failed", slog.Any("error", runErr))
+	}
+
+	slog.Info("завершение работы сервера")
+	a.shutdown(httpServer, closeGateway, cancelTasks, &tasks)
+	slog.Info("сервер остановлен")
+
+	return runErr
+}
+
+// shutdown останавливает сервис: сначала проверки сообщают NOT_SERVING, затем
+// gateway перестает принимать запросы и дожидается текущих, после этого
+// gRPC сервер дожидается своих вызовов и последними завершаются фоновые задачи.
+// Каждый этап ждет не дольше ShutdownTimeout, поэтому медленная остановка
+// серверов не отнимает время у фоновых задач.
+func (a *App) shutdown(httpServer *http.Server, closeGateway, cancelTasks context.CancelFunc, tasks *sync.WaitGroup) {
+	a.checker.Shutdown()
+
+	httpCtx, httpCancel := context.WithTimeout(context.Background(), a.cfg.ShutdownTimeout)
+	defer httpCancel()
+	if err := httpServer.Shutdown(httpCtx); err != nil {
+		slog.Error("ошибка при остановке HTTP сервера", slog.Any("error", err))
+	}
+	closeGateway()
+
+	grpcCtx, grpcCancel := context.WithTimeout(context.Background(), a.cfg.ShutdownTimeout)
+	defer grpcCancel()
+	stopped := make(chan struct{})
+	go func() {
+		a.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-grpcCtx.Done():
+		slog.Error("истек таймаут остановки gRPC сервера")
+		a.grpcServer.Stop()
+	}
+
+	tasksCtx, tasksCancel := context.WithTimeout(context.Background(), a.cfg.ShutdownTimeout)
+	defer tasksCancel()
+	cancelTasks()
+	done := make(chan struct{})
+	go func() {
+		tasks.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-tasksCtx.Done():
+		slog.Error("истек таймаут остановки фоновых задач")
+	}
+}
+
+// newHTTPServer регистрирует обработчики gateway и собирает HTTP сервер
+func (a *App) newHTTPServer(ctx context.Context) (*http.Server, error) {
+	endpoint := fmt.Sprintf("localhost:%d", a.cfg.GRPCPort)
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		tracing.DialOption(),
+		grpc.WithUnaryInterceptor(interceptor.ForwardRequestIDInterceptor()),
+	}
+	for _, register := range a.gateways {
+		if err := register(ctx, a.gatewayMux, endpoint, opts); err != nil {
+			return nil, fmt.Errorf("failed to register gateway: %w", err)
+		}
+	}
+
+	a.httpMux.Handle("/api/", a.gatewayMux)
+	// Метрики gRPC сервера в формате Prometheus
+	a.httpMux.Handle("/metrics", metrics.Handler())
+	// Проверки живости и готовности
+	a.httpMux.Handle("/healthz", a.checker.LivenessHandler())
+	a.httpMux.Handle("/readyz", a.checker.ReadinessHandler())
+
+	if a.cfg.SwaggerFile != "" {
+		a.httpMux.Handle("/swagger/", swagger.NewSwaggerHandler("/swagger/", a.cfg.SwaggerFile, "api"))
+		a.httpMux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/" {
+				http.Redirect(w, r, "/swagger/", http.StatusMovedPermanently)
+				return
+			}
+			http.NotFound(w, r)
+		}))
+	}
+
+	return &http.Server{
+		Handler:           tracing.HTTPMiddleware(a.cfg.Name, nil)(logger.RequestIDMiddleware(logger.AccessLogMiddleware(a.httpMux))),
+		ReadHeaderTimeout: a.cfg.ReadHeaderTimeout,
+	}, nil
+}
@@ -0,0 +1,221 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/xgmsx/rsf/shared/pkg/logger"
+	genInventoryV1 "github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1"
+)
+
+const partUUID = "1f0d6a8e-7a4c-4d4e-9a7b-2f6b0c1d2e3f"
+
+type inventoryServer struct {
+	genInventoryV1.UnimplementedInventoryServiceServer
+	requestIDs chan string
+}
+
+func (s *inventoryServer) GetPart(ctx context.Context, req *genInventoryV1.GetPartRequest) (*genInventoryV1.GetPartResponse, error) {
+	s.requestIDs <- logger.RequestIDFromContext(ctx)
+	return &genInventoryV1.GetPartResponse{Part: &genInventoryV1.Part{Uuid: req.GetUuid()}}, nil
+}
+
+// freePort возвращает свободный TCP порт
+func freePort(t *testing.T) int {
+	t.Helper()
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = lis.Close() }()
+	return lis.Addr().(*net.TCPAddr).Port
+}
+
+// waitReady ждет, пока сервис по адресу baseURL не станет готов
+func waitReady(t *testing.T, baseURL string) {
+	t.Helper()
+	for range 100 {
+		res, err := client.Get(baseURL + "/readyz")
+		if err == nil {
+			_ = res.Body.Close()
+			if res.StatusCode == http.StatusOK {
+				return
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatal("service did not become ready")
+}
+
+// client не держит соединения открытыми, чтобы не задерживать остановку сервера
+var client = &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+
+func get(t *testing.T, url string, header http.Header) (*http.Response, []byte) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header = header
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res, body
+}
+
+func TestApp(t *testing.T) {
+	httpPort := freePort(t)
+	a := New(Config{
+		Name:            "api-test",
+		GRPCPort:        freePort(t),
+		HTTPPort:        httpPort,
+		ShutdownTimeout: 5 * time.Second,
+	})
+	server := &inventoryServer{requestIDs: make(chan string, 1)}
+	a.RegisterService(&genInventoryV1.InventoryService_ServiceDesc, server,
+		genInventoryV1.RegisterInventoryServiceHandlerFromEndpoint)
+
+	taskStopped := make(chan struct{})
+	a.Go(func(ctx context.Context) {
+		<-ctx.Done()
+		close(taskStopped)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	runErr := make(chan error, 1)
+	go func() { runErr <- a.Run(ctx) }()
+
+	baseURL := fmt.Sprintf("http://localhost:%d", httpPort)
+	waitReady(t, baseURL)
+
+	// Неверный запрос отклоняется до вызова сервиса
+	res, body := get(t, baseURL+"/api/v1/part/part-1", nil)
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected validation error, got %d %s", res.StatusCode, body)
+	}
+
+	// Gateway вызывает зарегистрированный сервис и передает ему X-Request-ID
+	res, body = get(t, baseURL+"/api/v1/part/"+partUUID, http.Header{logger.RequestIDHeader: {"req-1"}})
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected gateway response: %d %s", res.StatusCode, body)
+	}
+	var part genInventoryV1.GetPartResponse
+	if err := protojson.Unmarshal(body, &part); err != nil {
+		t.Fatalf("response is not JSON: %v", err)
+	}
+	if part.GetPart().GetUuid() != partUUID {
+		t.Fatalf("unexpected part: %s", body)
+	}
+	if requestID := <-server.requestIDs; requestID != "req-1" {
+		t.Fatalf("request id not forwarded: %q", requestID)
+	}
+
+	// Остановка завершает серверы и фоновые задачи
+	cancel()
+	select {
+	case err := <-runErr:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("service did not stop")
+	}
+	select {
+	case <-taskStopped:
+	default:
+		t.Fatal("background task not stopped")
+	}
+	if _, err := client.Get(baseURL + "/healthz"); err == nil {
+		t.Fatal("HTTP server still serving after shutdown")
+	}
+}
+
+// blockingServer не отвечает на GetPart, пока вызов не прервут
+type blockingServer struct {
+	genInventoryV1.UnimplementedInventoryServiceServer
+	started chan struct{}
+}
+
+func (s *blockingServer) GetPart(ctx context.Context, _ *genInventoryV1.GetPartRequest) (*genInventoryV1.GetPartResponse, error) {
+	close(s.started)
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestShutdownGivesTasksTheirOwnTimeout(t *testing.T) {
+	const shutdownTimeout = 300 * time.Millisecond
+	grpcPort, httpPort := freePort(t), freePort(t)
+	a := New(Config{GRPCPort: grpcPort, HTTPPort: httpPort, ShutdownTimeout: shutdownTimeout})
+	server := &blockingServer{started: make(chan struct{})}
+	a.RegisterService(&genInventoryV1.InventoryService_ServiceDesc, server,
+		genInventoryV1.RegisterInventoryServiceHandlerFromEndpoint)
+
+	// Задача завершается не сразу, но укладывается в свой таймаут
+	taskStopped := make(chan struct{})
+	a.Go(func(ctx context.Context) {
+		<-ctx.Done()
+		time.Sleep(shutdownTimeout / 2)
+		close(taskStopped)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	runErr := make(chan error, 1)
+	go func() { runErr <- a.Run(ctx) }()
+	waitReady(t, fmt.Sprintf("http://localhost:%d", httpPort))
+
+	// Незавершенный вызов задерживает остановку gRPC сервера на весь таймаут
+	conn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", grpcPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = conn.Close() }()
+	go func() {
+		_, _ = genInventoryV1.NewInventoryServiceClient(conn).GetPart(context.Background(), &genInventoryV1.GetPartRequest{Uuid: partUUID})
+	}()
+	<-server.started
+
+	cancel()
+	select {
+	case err := <-runErr:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("service did not stop")
+	}
+	select {
+	case <-taskStopped:
+	default:
+		t.Fatal("background task had no time to stop after slow gRPC shutdown")
+	}
+}
+
+func TestRunPortInUse(t *testing.T) {
+	lis, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = lis.Close() }()
+
+	a := New(Config{GRPCPort: lis.Addr().(*net.TCPAddr).Port, HTTPPort: freePort(t), ShutdownTimeout: time.Second})
+	if err = a.Run(context.Background()); err == nil {
+		t.Fatal("expected error for busy port")
+	}
+}
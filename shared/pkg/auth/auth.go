@@ -0,0 +1,148 @@
+// Package auth проверяет JWT вызывающего и передает его личность через контекст.
+//
+// Токен подписывается HMAC-секретом (HS256, HS384, HS512) или ключом из JWKS-файла
+// (RS*, PS*, ES*). UUID пользователя берется из claim sub, роли - из claim roles,
+// срок действия exp обязателен.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// RoleAdmin - роль администратора, которому доступны данные всех пользователей
+const RoleAdmin = "admin"
+
+var (
+	ErrMissingToken = errors.New("missing bearer token")
+	ErrInvalidToken = errors.New("invalid token")
+)
+
+// Identity - личность вызывающего, подтвержденная токеном
+type Identity struct {
+	UserUUID uuid.UUID
+	Roles    []string
+	// Token - исходный токен, чтобы передать его дальше в другие сервисы
+	Token string
+}
+
+// HasRole сообщает, есть ли у вызывающего роль role
+func (i Identity) HasRole(role string) bool {
+	return slices.Contains(i.Roles, role)
+}
+
+// claims - claims токена, которые читает Verifier
+type claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles,omitempty"`
+}
+
+// Config - настройки проверки токенов. Должен быть задан ровно один
+// из ключей: Secret или JWKSFile. Пустые Issuer и Audience не проверяются.
+type Config struct {
+	Secret   string
+	JWKSFile string
+	Issuer   string
+	Audience string
+}
+
+// Verifier проверяет подпись и claims токенов
+type Verifier struct {
+	parser  *jwt.Parser
+	keyfunc jwt.Keyfunc
+}
+
+func NewVerifier(cfg Config) (*Verifier, error) {
+	var (
+		methods []string
+		keyfunc jwt.Keyfunc
+	)
+	switch {
+	case cfg.Secret != "" && cfg.JWKSFile != "":
+		return nil, errors.New("only one of JWT secret and JWKS file can be set")
+	case cfg.Secret != "":
+		methods = []string{"HS256", "HS384", "HS512"}
+		secret := []byte(cfg.Secret)
+		keyfunc = func(*jwt.Token) (any, error) { return secret, nil }
+	case cfg.JWKSFile != "":
+		keys, err := ReadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		methods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+		keyfunc = keys.keyfunc
+	default:
+		return nil, errors.New("JWT secret or JWKS file is required")
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	return &Verifier{
+		parser:  jwt.NewParser(opts...),
+		keyfunc: keyfunc,
+	}, nil
+}
+
+// Verify проверяет токен и возвращает личность из его claims
+func (v *Verifier) Verify(token string) (Identity, error) {
+	if token == "" {
+		return Identity{}, ErrMissingToken
+	}
+
+	var claims claims
+	_, err := v.parser.ParseWithClaims(token, &claims, v.keyfunc)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	userUUID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: sub is not a user UUID", ErrInvalidToken)
+	}
+
+	return Identity{
+		UserUUID: userUUID,
+		Roles:    claims.Roles,
+		Token:    token,
+	}, nil
+}
+
+// BearerToken извлекает токен из значения заголовка Authorization
+func BearerToken(header string) (string, error) {
+	if header == "" {
+		return "", ErrMissingToken
+	}
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", fmt.Errorf("%w: expected Authorization: Bearer <token>", ErrInvalidToken)
+	}
+	return strings.TrimSpace(token), nil
+}
+
+type identityKey struct{}
+
+// ContextWithIdentity сохраняет личность вызывающего в контексте
+func ContextWithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext возвращает личность вызывающего, если запрос аутентифицирован
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
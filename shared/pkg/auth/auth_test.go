@@ -0,0 +1,223 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const testSecret = "test-secret"
+
+func signToken(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func validClaims(sub string) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Subject:   sub,
+		Issuer:    "rsf",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func TestVerifyHMAC(t *testing.T) {
+	verifier, err := NewVerifier(Config{Secret: testSecret, Issuer: "rsf"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	userUUID := uuid.New()
+
+	t.Run("Valid token", func(t *testing.T) {
+		token := signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims(userUUID.String()))
+
+		identity, err := verifier.Verify(token)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if identity.UserUUID != userUUID || identity.Token != token || identity.HasRole(RoleAdmin) {
+			t.Fatalf("unexpected identity: %+v", identity)
+		}
+	})
+
+	t.Run("Token with roles", func(t *testing.T) {
+		token := signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", claims{
+			RegisteredClaims: validClaims(userUUID.String()),
+			Roles:            []string{RoleAdmin},
+		})
+
+		identity, err := verifier.Verify(token)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !identity.HasRole(RoleAdmin) {
+			t.Fatalf("expected admin role: %+v", identity)
+		}
+	})
+
+	expired := validClaims(userUUID.String())
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	noExpiry := validClaims(userUUID.String())
+	noExpiry.ExpiresAt = nil
+	otherIssuer := validClaims(userUUID.String())
+	otherIssuer.Issuer = "other"
+
+	for _, tt := range []struct {
+		name  string
+		token string
+	}{
+		{"Empty token", ""},
+		{"Garbage", "not-a-jwt"},
+		{"Wrong secret", signToken(t, jwt.SigningMethodHS256, []byte("other"), "", validClaims(userUUID.String()))},
+		{"Expired", signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", expired)},
+		{"Without expiry", signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", noExpiry)},
+		{"Other issuer", signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", otherIssuer)},
+		{"Subject is not a UUID", signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims("admin"))},
+		{"Unsigned", signToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", validClaims(userUUID.String()))},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := verifier.Verify(tt.token)
+			if !errors.Is(err, ErrInvalidToken) && !errors.Is(err, ErrMissingToken) {
+				t.Fatalf("expected token error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyJWKS(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encode := func(n *big.Int) string { return base64.RawURLEncoding.EncodeToString(n.Bytes()) }
+	jwks, err := json.Marshal(map[string]any{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "rsa-1", "n": encode(rsaKey.N), "e": encode(big.NewInt(int64(rsaKey.E)))},
+		{"kty": "EC", "kid": "ec-1", "crv": "P-256", "x": encode(ecKey.X), "y": encode(ecKey.Y)},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err = os.WriteFile(path, jwks, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	verifier, err := NewVerifier(Config{JWKSFile: path})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	userUUID := uuid.New()
+
+	t.Run("RSA key", func(t *testing.T) {
+		token := signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa-1", validClaims(userUUID.String()))
+		identity, err := verifier.Verify(token)
+		if err != nil || identity.UserUUID != userUUID {
+			t.Fatalf("unexpected result: %+v, %v", identity, err)
+		}
+	})
+
+	t.Run("EC key", func(t *testing.T) {
+		token := signToken(t, jwt.SigningMethodES256, ecKey, "ec-1", validClaims(userUUID.String()))
+		identity, err := verifier.Verify(token)
+		if err != nil || identity.UserUUID != userUUID {
+			t.Fatalf("unexpected result: %+v, %v", identity, err)
+		}
+	})
+
+	t.Run("Unknown key id", func(t *testing.T) {
+		token := signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa-2", validClaims(userUUID.String()))
+		if _, err := verifier.Verify(token); !errors.Is(err, ErrInvalidToken) {
+			t.Fatalf("expected ErrInvalidToken, got %v", err)
+		}
+	})
+
+	t.Run("HMAC token is rejected", func(t *testing.T) {
+		token := signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "rsa-1", validClaims(userUUID.String()))
+		if _, err := verifier.Verify(token); !errors.Is(err, ErrInvalidToken) {
+			t.Fatalf("expected ErrInvalidToken, got %v", err)
+		}
+	})
+}
+
+func TestNewVerifierConfig(t *testing.T) {
+	if _, err := NewVerifier(Config{}); err == nil {
+		t.Fatal("expected error without keys")
+	}
+	if _, err := NewVerifier(Config{Secret: testSecret, JWKSFile: "jwks.json"}); err == nil {
+		t.Fatal("expected error with both keys")
+	}
+	if _, err := ParseJWKS([]byte(`{"keys": [{"kty": "oct", "kid": "1"}]}`)); err == nil {
+		t.Fatal("expected error for unsupported key type")
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	verifier, err := NewVerifier(Config{Secret: testSecret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	userUUID := uuid.New()
+
+	handler := Middleware(verifier)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity, ok := IdentityFromContext(r.Context())
+		if !ok || identity.UserUUID != userUUID {
+			t.Errorf("unexpected identity: %+v", identity)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	for _, tt := range []struct {
+		name       string
+		header     string
+		wantStatus int
+	}{
+		{"Valid token", "Bearer " + signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims(userUUID.String())), http.StatusNoContent},
+		{"Without header", "", http.StatusUnauthorized},
+		{"Other scheme", "Basic dXNlcjpwYXNz", http.StatusUnauthorized},
+		{"Invalid token", "Bearer not-a-jwt", http.StatusUnauthorized},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/orders", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+			if tt.wantStatus == http.StatusUnauthorized && rec.Header().Get("WWW-Authenticate") == "" {
+				t.Fatal("expected WWW-Authenticate header")
+			}
+		})
+	}
+}
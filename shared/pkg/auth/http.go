@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Middleware пропускает дальше только запросы с действительным токеном
+// в заголовке Authorization и сохраняет личность вызывающего в контексте.
+// Остальным отвечает 401 с телом {"code": 401, "message": "..."}.
+func Middleware(verifier *Verifier) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, err := BearerToken(r.Header.Get("Authorization"))
+			if err != nil {
+				writeUnauthorized(w, err)
+				return
+			}
+			identity, err := verifier.Verify(token)
+			if err != nil {
+				writeUnauthorized(w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ContextWithIdentity(r.Context(), identity)))
+		})
+	}
+}
+
+func writeUnauthorized(w http.ResponseWriter, err error) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	_ = json.NewEncoder(w).Encode(struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}{
+		Code:    http.StatusUnauthorized,
+		Message: err.Error(),
+	})
+}
@@ -0,0 +1,206 @@
+// Package config загружает типизированные настройки сервисов из переменных
+// окружения и необязательного файла в формате .env.
+//
+// Поля структуры настроек описываются тегами:
+//
+//	env      - имя переменной окружения;
+//	default  - значение, если переменная не задана или пуста;
+//	required - "true", если значение обязательно;
+//	oneof    - допустимые значения строки через пробел;
+//	min, max - границы для целых чисел и длительностей.
+//
+// Поддерживаются string, bool, int, int64, float64, time.Duration и []string
+// (значения через запятую). Вложенные структуры без тега env обходятся рекурсивно.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FileEnv - переменная окружения с путем к необязательному файлу настроек.
+// Переменные окружения имеют приоритет над значениями из файла.
+const FileEnv = "CONFIG_FILE"
+
+// Validator реализуется настройками с проверками, которые нельзя описать
+// тегами, например зависимостью одного поля от другого
+type Validator interface {
+	Validate() error
+}
+
+// LookupFunc возвращает значение переменной и признак того, что она задана
+type LookupFunc func(key string) (string, bool)
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// Load заполняет cfg (указатель на структуру) из переменных окружения и файла
+// из CONFIG_FILE. Возвращает все найденные ошибки сразу, каждую с именем переменной.
+func Load(cfg any) error {
+	lookup := LookupFunc(os.LookupEnv)
+	if path := os.Getenv(FileEnv); path != "" {
+		values, err := ReadFile(path)
+		if err != nil {
+			return err
+		}
+		lookup = func(key string) (string, bool) {
+			if value, ok := os.LookupEnv(key); ok {
+				return value, true
+			}
+			value, ok := values[key]
+			return value, ok
+		}
+	}
+	return LoadFrom(cfg, lookup)
+}
+
+// LoadFrom заполняет cfg значениями из lookup
+func LoadFrom(cfg any, lookup LookupFunc) error {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config: expected pointer to struct, got %T", cfg)
+	}
+
+	var errs []error
+	loadStruct(v.Elem(), lookup, &errs)
+	if len(errs) == 0 {
+		if validator, ok := cfg.(Validator); ok {
+			err := validator.Validate()
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func loadStruct(v reflect.Value, lookup LookupFunc, errs *[]error) {
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fv := v.Field(i)
+
+		key, ok := field.Tag.Lookup("env")
+		if !ok {
+			if field.Type.Kind() == reflect.Struct {
+				loadStruct(fv, lookup, errs)
+			}
+			continue
+		}
+
+		value, _ := lookup(key)
+		if value == "" {
+			value = field.Tag.Get("default")
+		}
+		if value == "" {
+			if field.Tag.Get("required") == "true" {
+				*errs = append(*errs, fmt.Errorf("%s is required", key))
+			}
+			continue
+		}
+
+		err := setValue(fv, value)
+		if err != nil {
+			*errs = append(*errs, fmt.Errorf("%s: invalid value %q: %w", key, value, err))
+			continue
+		}
+		err = validateField(fv, field.Tag)
+		if err != nil {
+			*errs = append(*errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+}
+
+func setValue(v reflect.Value, value string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return errors.New("expected duration like 30s or 15m")
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return errors.New("expected true or false")
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return errors.New("expected integer")
+		}
+		v.SetInt(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return errors.New("expected number")
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s", v.Type())
+		}
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items).Convert(v.Type()))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+func validateField(v reflect.Value, tag reflect.StructTag) error {
+	if oneof, ok := tag.Lookup("oneof"); ok && v.Kind() == reflect.String {
+		allowed := strings.Fields(oneof)
+		if !slices.Contains(allowed, v.String()) {
+			return fmt.Errorf("must be one of %s, got %q", strings.Join(allowed, ", "), v.String())
+		}
+	}
+
+	if v.Kind() != reflect.Int && v.Kind() != reflect.Int64 {
+		return nil
+	}
+	for _, bound := range []string{"min", "max"} {
+		raw, ok := tag.Lookup(bound)
+		if !ok {
+			continue
+		}
+		limit := reflect.New(v.Type()).Elem()
+		err := setValue(limit, raw)
+		if err != nil {
+			return fmt.Errorf("invalid %s tag %q: %w", bound, raw, err)
+		}
+		if bound == "min" && v.Int() < limit.Int() {
+			return fmt.Errorf("must be at least %s, got %s", raw, format(v))
+		}
+		if bound == "max" && v.Int() > limit.Int() {
+			return fmt.Errorf("must be at most %s, got %s", raw, format(v))
+		}
+	}
+	return nil
+}
+
+func format(v reflect.Value) string {
+	if v.Type() == durationType {
+		return time.Duration(v.Int()).String()
+	}
+	return strconv.FormatInt(v.Int(), 10)
+}
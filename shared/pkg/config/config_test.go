@@ -0,0 +1,152 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testConfig struct {
+	Port     int           `env:"TEST_PORT" default:"8080" min:"1" max:"65535"`
+	Storage  string        `env:"TEST_STORAGE" default:"memory" oneof:"memory postgres"`
+	DSN      string        `env:"TEST_DSN"`
+	Timeout  time.Duration `env:"TEST_TIMEOUT" default:"15m" min:"1s"`
+	Debug    bool          `env:"TEST_DEBUG"`
+	Brokers  []string      `env:"TEST_BROKERS"`
+	Required string        `env:"TEST_REQUIRED" required:"true"`
+	Nested   struct {
+		Retries int64 `env:"TEST_RETRIES" default:"3"`
+	}
+}
+
+func (c testConfig) Validate() error {
+	if c.Storage == "postgres" && c.DSN == "" {
+		return errors.New("TEST_DSN is required for postgres storage")
+	}
+	return nil
+}
+
+func lookupFrom(values map[string]string) LookupFunc {
+	return func(key string) (string, bool) {
+		value, ok := values[key]
+		return value, ok
+	}
+}
+
+func TestLoadFrom(t *testing.T) {
+	t.Run("Defaults and values", func(t *testing.T) {
+		var cfg testConfig
+		err := LoadFrom(&cfg, lookupFrom(map[string]string{
+			"TEST_REQUIRED": "yes",
+			"TEST_DEBUG":    "true",
+			"TEST_BROKERS":  "kafka-1:9092, kafka-2:9092,",
+			"TEST_TIMEOUT":  "", // пустое значение заменяется значением по умолчанию
+			"TEST_RETRIES":  "5",
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if cfg.Port != 8080 || cfg.Storage != "memory" || cfg.Timeout != 15*time.Minute {
+			t.Fatalf("defaults not applied: %+v", cfg)
+		}
+		if !cfg.Debug || cfg.Required != "yes" || cfg.Nested.Retries != 5 {
+			t.Fatalf("values not applied: %+v", cfg)
+		}
+		if !reflect.DeepEqual(cfg.Brokers, []string{"kafka-1:9092", "kafka-2:9092"}) {
+			t.Fatalf("unexpected brokers: %v", cfg.Brokers)
+		}
+	})
+
+	t.Run("All errors reported", func(t *testing.T) {
+		var cfg testConfig
+		err := LoadFrom(&cfg, lookupFrom(map[string]string{
+			"TEST_PORT":    "70000",
+			"TEST_STORAGE": "mysql",
+			"TEST_TIMEOUT": "10",
+			"TEST_DEBUG":   "maybe",
+		}))
+		if err == nil {
+			t.Fatal("expected error")
+		}
+
+		for _, expected := range []string{
+			"TEST_PORT: must be at most 65535, got 70000",
+			"TEST_STORAGE: must be one of memory, postgres, got \"mysql\"",
+			"TEST_TIMEOUT: invalid value \"10\"",
+			"TEST_DEBUG: invalid value \"maybe\"",
+			"TEST_REQUIRED is required",
+		} {
+			if !strings.Contains(err.Error(), expected) {
+				t.Errorf("error %q does not contain %q", err, expected)
+			}
+		}
+	})
+
+	t.Run("Duration below minimum", func(t *testing.T) {
+		var cfg testConfig
+		err := LoadFrom(&cfg, lookupFrom(map[string]string{"TEST_REQUIRED": "yes", "TEST_TIMEOUT": "10ms"}))
+		if err == nil || !strings.Contains(err.Error(), "TEST_TIMEOUT: must be at least 1s, got 10ms") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Validate called after tags", func(t *testing.T) {
+		var cfg testConfig
+		err := LoadFrom(&cfg, lookupFrom(map[string]string{"TEST_REQUIRED": "yes", "TEST_STORAGE": "postgres"}))
+		if err == nil || err.Error() != "TEST_DSN is required for postgres storage" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Not a pointer to struct", func(t *testing.T) {
+		if err := LoadFrom(testConfig{}, lookupFrom(nil)); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.env")
+	content := `# комментарий
+TEST_REQUIRED=from-file
+export TEST_STORAGE="postgres"
+TEST_DSN='postgres://localhost/test'
+TEST_PORT=9090
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(FileEnv, path)
+	// Переменная окружения важнее значения из файла
+	t.Setenv("TEST_PORT", "9191")
+
+	var cfg testConfig
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Required != "from-file" || cfg.Storage != "postgres" || cfg.DSN != "postgres://localhost/test" || cfg.Port != 9191 {
+		t.Fatalf("unexpected config: %+v", cfg)
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "invalid.env")
+	if err := os.WriteFile(path, []byte("TEST_PORT=1\nnot a pair\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := ReadFile(path)
+	if err == nil || !strings.Contains(err.Error(), "invalid.env:2: expected KEY=VALUE") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = ReadFile(filepath.Join(t.TempDir(), "missing.env"))
+	if err == nil {
+		t.Fatal("expected error for missing file")
+	}
+}
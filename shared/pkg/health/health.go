@@ -0,0 +1,161 @@
+// Package health реализует проверки живости и готовности сервиса:
+// HTTP-эндпоинты /healthz и /readyz и стандартный сервис grpc.health.v1.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	grpcHealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	StatusServing    = "SERVING"
+	StatusNotServing = "NOT_SERVING"
+
+	// checkTimeout ограничивает каждую проверку готовности
+	checkTimeout = 2 * time.Second
+)
+
+// Check проверяет зависимость сервиса и возвращает ошибку, если она недоступна
+type Check func(ctx context.Context) error
+
+// Response - ответ /healthz и /readyz
+type Response struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Checker собирает проверки зависимостей сервиса. После Shutdown сервис
+// сообщает, что не готов принимать запросы, независимо от зависимостей.
+type Checker struct {
+	mu       sync.Mutex
+	checks   map[string]Check
+	grpc     *grpcHealth.Server
+	stopping atomic.Bool
+}
+
+// NewChecker создает Checker без проверок
+func NewChecker() *Checker {
+	return &Checker{checks: make(map[string]Check)}
+}
+
+// Add добавляет проверку зависимости name
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks[name] = check
+}
+
+// RegisterGRPC регистрирует на server сервис grpc.health.v1, который сообщает
+// SERVING для сервера в целом и для services, пока не вызван Shutdown
+func (c *Checker) RegisterGRPC(server *grpc.Server, services ...string) {
+	c.grpc = grpcHealth.NewServer()
+	for _, service := range services {
+		c.grpc.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(server, c.grpc)
+}
+
+// Shutdown переводит сервис в NOT_SERVING. Вызывается в начале остановки,
+// чтобы балансировщики перестали направлять в него запросы.
+func (c *Checker) Shutdown() {
+	c.stopping.Store(true)
+	if c.grpc != nil {
+		c.grpc.Shutdown()
+	}
+}
+
+// Ready выполняет все проверки параллельно и возвращает их результаты
+func (c *Checker) Ready(ctx context.Context) Response {
+	if c.stopping.Load() {
+		return Response{Status: StatusNotServing, Checks: map[string]string{"shutdown": "service is shutting down"}}
+	}
+
+	c.mu.Lock()
+	checks := make(map[string]Check, len(c.checks))
+	for name, check := range c.checks {
+		checks[name] = check
+	}
+	c.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	response := Response{Status: StatusServing, Checks: make(map[string]string, len(checks))}
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := "ok"
+			if err := check(ctx); err != nil {
+				result = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			response.Checks[name] = result
+			if result != "ok" {
+				response.Status = StatusNotServing
+			}
+		}()
+	}
+	wg.Wait()
+
+	return response
+}
+
+// LivenessHandler отвечает 200, пока процесс способен обрабатывать запросы
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeResponse(w, http.StatusOK, Response{Status: StatusServing})
+	})
+}
+
+// ReadinessHandler отвечает 200, если все зависимости доступны,
+// иначе и во время остановки - 503 с результатами проверок
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := c.Ready(r.Context())
+		code := http.StatusOK
+		if response.Status != StatusServing {
+			code = http.StatusServiceUnavailable
+		}
+		writeResponse(w, code, response)
+	})
+}
+
+// GRPCCheck проверяет сервис service зависимости через ее grpc.health.v1
+func GRPCCheck(client healthpb.HealthClient, service string) Check {
+	return func(ctx context.Context) error {
+		res, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			return err
+		}
+		if res.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("status %s", res.GetStatus())
+		}
+		return nil
+	}
+}
+
+func writeResponse(w http.ResponseWriter, code int, response Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		slog.Error("failed to write health response", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,89 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testService = "test.v1.TestService"
+
+func serve(t *testing.T, handler http.Handler) (int, Response) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	var response Response
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("response is not JSON: %v", err)
+	}
+	return rec.Code, response
+}
+
+func TestChecker(t *testing.T) {
+	checker := NewChecker()
+	checker.Add("postgres", func(context.Context) error { return nil })
+
+	code, response := serve(t, checker.ReadinessHandler())
+	if code != http.StatusOK || response.Status != StatusServing || response.Checks["postgres"] != "ok" {
+		t.Fatalf("expected ready, got %d %+v", code, response)
+	}
+
+	// Недоступная зависимость делает сервис неготовым, но живым
+	checker.Add("inventory", func(context.Context) error { return errors.New("connection refused") })
+	code, response = serve(t, checker.ReadinessHandler())
+	if code != http.StatusServiceUnavailable || response.Status != StatusNotServing ||
+		response.Checks["inventory"] != "connection refused" || response.Checks["postgres"] != "ok" {
+		t.Fatalf("expected not ready, got %d %+v", code, response)
+	}
+	if code, _ = serve(t, checker.LivenessHandler()); code != http.StatusOK {
+		t.Fatalf("expected alive, got %d", code)
+	}
+
+	checker.Shutdown()
+	code, response = serve(t, checker.ReadinessHandler())
+	if code != http.StatusServiceUnavailable || response.Status != StatusNotServing {
+		t.Fatalf("expected not ready during shutdown, got %d %+v", code, response)
+	}
+}
+
+func TestGRPC(t *testing.T) {
+	// gRPC сервер и клиент, соединенные через память
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	checker := NewChecker()
+	checker.RegisterGRPC(server, testService)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	check := GRPCCheck(healthpb.NewHealthClient(conn), testService)
+
+	if err = check(context.Background()); err != nil {
+		t.Fatalf("expected SERVING, got %v", err)
+	}
+	if err = GRPCCheck(healthpb.NewHealthClient(conn), "unknown.Service")(context.Background()); err == nil {
+		t.Fatal("expected error for unknown service")
+	}
+
+	checker.Shutdown()
+	if err = check(context.Background()); err == nil || err.Error() != "status NOT_SERVING" {
+		t.Fatalf("expected NOT_SERVING after shutdown, got %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/xgmsx/rsf/shared/pkg/auth"
+)
+
+const (
+	authorizationKey = "authorization"
+	// healthMethodPrefix - методы grpc.health.v1, которые вызываются без токена
+	healthMethodPrefix = "/grpc.health.v1.Health/"
+)
+
+// AuthInterceptor создает серверный унарный интерцептор, который проверяет
+// токен из метаданных authorization ("Bearer <token>") и сохраняет личность
+// вызывающего в контексте. Без действительного токена вызов завершается
+// с кодом Unauthenticated. Проверки здоровья grpc.health.v1 токена не требуют.
+func AuthInterceptor(verifier *auth.Verifier) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, healthMethodPrefix) {
+			return handler(ctx, req)
+		}
+
+		var header string
+		if values := metadata.ValueFromIncomingContext(ctx, authorizationKey); len(values) > 0 {
+			header = values[0]
+		}
+
+		token, err := auth.BearerToken(header)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		identity, err := verifier.Verify(token)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(auth.ContextWithIdentity(ctx, identity), req)
+	}
+}
+
+// ForwardAuthInterceptor создает клиентский унарный интерцептор, который
+// передает в вызов токен пользователя из контекста, а если запрос сделан
+// не от имени пользователя (например, фоновой задачей) - serviceToken.
+// Пустой serviceToken означает, что такие вызовы идут без токена.
+func ForwardAuthInterceptor(serviceToken string) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		token := serviceToken
+		if identity, ok := auth.IdentityFromContext(ctx); ok {
+			token = identity.Token
+		}
+		if token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, authorizationKey, "Bearer "+token)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
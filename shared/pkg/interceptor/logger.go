@@ -2,7 +2,7 @@ package interceptor
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"path"
 	"time"
 
@@ -11,7 +11,8 @@ import (
 )
 
 // LoggerInterceptor создает серверный унарный интерцептор, который логирует
-// информацию о времени выполнения методов gRPC сервера.
+// информацию о времени выполнения методов gRPC сервера. Записи делаются
+// с контекстом вызова, поэтому содержат его request_id.
 func LoggerInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -23,7 +24,7 @@ func LoggerInterceptor() grpc.UnaryServerInterceptor {
 		method := path.Base(info.FullMethod)
 
 		// Логируем начало вызова метода
-		log.Printf("🚀 Started gRPC method %s\n", method)
+		slog.DebugContext(ctx, "grpc call started", slog.String("method", method))
 
 		// Засекаем время начала выполнения
 		startTime := time.Now()
@@ -34,12 +35,20 @@ func LoggerInterceptor() grpc.UnaryServerInterceptor {
 		// Вычисляем длительность выполнения
 		duration := time.Since(startTime)
 
-		// Форматируем сообщение в зависимости от результата
+		// Уровень записи зависит от результата
 		if err != nil {
-			st, _ := status.FromError(err)
-			log.Printf("❌ Finished gRPC method %s with code %s: %v (took: %v)\n", method, st.Code(), err, duration)
+			slog.ErrorContext(ctx, "grpc call failed",
+				slog.String("method", method),
+				slog.String("code", status.Code(err).String()),
+				slog.Duration("duration", duration),
+				slog.Any("error", err),
+			)
 		} else {
-			log.Printf("✅ Finished gRPC method %s successfully (took: %v)\n", method, duration)
+			slog.InfoContext(ctx, "grpc call finished",
+				slog.String("method", method),
+				slog.String("code", status.Code(err).String()),
+				slog.Duration("duration", duration),
+			)
 		}
 
 		return resp, err
@@ -0,0 +1,44 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_requests_total",
+		Help: "Number of handled gRPC requests by method and status code.",
+	}, []string{"method", "code"})
+	grpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_request_duration_seconds",
+		Help:    "Duration of handled gRPC requests by method and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "code"})
+)
+
+// MetricsInterceptor создает серверный унарный интерцептор, который считает
+// вызовы методов gRPC сервера и время их выполнения по методу и коду ответа.
+func MetricsInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		startTime := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err).String()
+		grpcRequests.WithLabelValues(info.FullMethod, code).Inc()
+		grpcDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(startTime).Seconds())
+
+		return resp, err
+	}
+}
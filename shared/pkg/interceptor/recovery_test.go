@@ -0,0 +1,28 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.v1.TestService/Get"}
+
+	resp, err := RecoveryInterceptor()(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		panic("boom")
+	})
+	if resp != nil || status.Code(err) != codes.Internal {
+		t.Fatalf("expected Internal, got %v %v", resp, err)
+	}
+
+	resp, err = RecoveryInterceptor()(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return "ok", nil
+	})
+	if resp != "ok" || err != nil {
+		t.Fatalf("unexpected result %v %v", resp, err)
+	}
+}
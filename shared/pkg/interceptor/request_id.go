@@ -0,0 +1,49 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/xgmsx/rsf/shared/pkg/logger"
+)
+
+// RequestIDInterceptor создает серверный унарный интерцептор, который берет
+// идентификатор запроса из метаданных x-request-id (или создает новый, если
+// его нет) и сохраняет его в контексте, чтобы он попадал во все записи лога.
+// Он должен стоять в цепочке раньше LoggerInterceptor.
+func RequestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		var received string
+		if values := metadata.ValueFromIncomingContext(ctx, logger.RequestIDMetadataKey); len(values) > 0 {
+			received = values[0]
+		}
+
+		return handler(logger.ContextWithRequestID(ctx, logger.RequestID(received)), req)
+	}
+}
+
+// ForwardRequestIDInterceptor создает клиентский унарный интерцептор, который
+// передает идентификатор запроса из контекста в метаданных x-request-id.
+func ForwardRequestIDInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if requestID := logger.RequestIDFromContext(ctx); requestID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, logger.RequestIDMetadataKey, requestID)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,50 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/xgmsx/rsf/shared/pkg/logger"
+)
+
+func TestRequestIDPropagation(t *testing.T) {
+	// Клиент передает идентификатор запроса из контекста в метаданных
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	ctx := logger.ContextWithRequestID(context.Background(), "req-1")
+	if err := ForwardRequestIDInterceptor()(ctx, "/test.v1.TestService/Get", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Сервер берет его из метаданных, а без них создает новый
+	for _, tt := range []struct {
+		name string
+		md   metadata.MD
+		want string
+	}{
+		{"From metadata", outgoing, "req-1"},
+		{"Generated", metadata.MD{}, ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			handler := func(ctx context.Context, _ any) (any, error) {
+				got = logger.RequestIDFromContext(ctx)
+				return nil, nil
+			}
+			_, err := RequestIDInterceptor()(metadata.NewIncomingContext(context.Background(), tt.md), nil,
+				&grpc.UnaryServerInfo{FullMethod: "/test.v1.TestService/Get"}, handler)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got == "" || (tt.want != "" && got != tt.want) {
+				t.Fatalf("unexpected request id %q", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validatorAll - запрос с правилами protoc-gen-validate
+type validatorAll interface {
+	ValidateAll() error
+}
+
+// fieldError - ошибка проверки поля, сгенерированная protoc-gen-validate
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError - все ошибки проверки сообщения, сгенерированные protoc-gen-validate
+type multiError interface {
+	AllErrors() []error
+}
+
+// ValidateInterceptor создает серверный унарный интерцептор, который
+// проверяет запросы с правилами protoc-gen-validate до вызова обработчика.
+// Неверный запрос завершается с кодом InvalidArgument и деталями
+// google.rpc.BadRequest со списком нарушений по полям.
+func ValidateInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if v, ok := req.(validatorAll); ok {
+			if err := v.ValidateAll(); err != nil {
+				return nil, ValidationError(err)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// InvokeValidated вызывает обработчик method через ValidateInterceptor, как
+// gRPC сервер приложения. Тесты обработчиков проверяют через него и
+// отклонение запросов по правилам protoc-gen-validate.
+func InvokeValidated[Req, Resp any](ctx context.Context, req Req, method func(context.Context, Req) (Resp, error)) (Resp, error) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return method(ctx, req.(Req))
+	}
+	resp, err := ValidateInterceptor()(ctx, req, &grpc.UnaryServerInfo{}, handler)
+	typed, _ := resp.(Resp)
+	return typed, err
+}
+
+// ValidationError преобразует ошибку ValidateAll в статус InvalidArgument
+// с деталями google.rpc.BadRequest
+func ValidationError(err error) error {
+	st := status.New(codes.InvalidArgument, "validate error: "+err.Error())
+	details, detailsErr := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: fieldViolations("", err),
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return details.Err()
+}
+
+// fieldViolations раскрывает ошибки вложенных сообщений в нарушения с полным
+// путем поля в именах proto, например filter.uuids[0]
+func fieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	var multi multiError
+	if errors.As(err, &multi) {
+		var violations []*errdetails.BadRequest_FieldViolation
+		for _, e := range multi.AllErrors() {
+			violations = append(violations, fieldViolations(prefix, e)...)
+		}
+		return violations
+	}
+
+	var fe fieldError
+	if !errors.As(err, &fe) {
+		return []*errdetails.BadRequest_FieldViolation{{Field: prefix, Description: err.Error()}}
+	}
+
+	field := protoFieldName(fe.Field())
+	if prefix != "" {
+		field = prefix + "." + field
+	}
+	if cause := fe.Cause(); cause != nil {
+		var nested fieldError
+		if errors.As(cause, &nested) || errors.As(cause, new(multiError)) {
+			return fieldViolations(field, cause)
+		}
+	}
+	return []*errdetails.BadRequest_FieldViolation{{Field: field, Description: fe.Reason()}}
+}
+
+// protoFieldName переводит имя поля Go из protoc-gen-validate в имя proto:
+// ReservationId -> reservation_id, Uuids[0] -> uuids[0]
+func protoFieldName(field string) string {
+	var b strings.Builder
+	for i, r := range field {
+		if unicode.IsUpper(r) {
+			if i > 0 && field[i-1] != '[' {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
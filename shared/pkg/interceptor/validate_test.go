@@ -0,0 +1,134 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	genInventoryV1 "github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1"
+)
+
+func TestValidateInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/inventory.v1.InventoryService/ListParts"}
+
+	testCases := []struct {
+		name       string
+		req        any
+		violations map[string]bool
+	}{
+		{
+			name: "Valid request",
+			req:  &genInventoryV1.GetPartRequest{Uuid: "1f0d6a8e-7a4c-4d4e-9a7b-2f6b0c1d2e3f"},
+		},
+		{
+			name: "Request without rules",
+			req:  struct{}{},
+		},
+		{
+			name:       "Field violation",
+			req:        &genInventoryV1.GetPartRequest{Uuid: "42"},
+			violations: map[string]bool{"uuid": true},
+		},
+		{
+			name: "Nested violations",
+			req: &genInventoryV1.ListPartsRequest{Filter: &genInventoryV1.PartsFilter{
+				Uuids: []string{"1f0d6a8e-7a4c-4d4e-9a7b-2f6b0c1d2e3f", "bad", "also bad"},
+			}},
+			violations: map[string]bool{"filter.uuids[1]": true, "filter.uuids[2]": true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// arrange
+			called := false
+			handler := func(context.Context, any) (any, error) {
+				called = true
+				return "ok", nil
+			}
+
+			// act
+			_, err := ValidateInterceptor()(context.Background(), tc.req, info, handler)
+
+			// assert
+			if tc.violations == nil {
+				if err != nil || !called {
+					t.Fatalf("expected handler call, got %v", err)
+				}
+				return
+			}
+			if called {
+				t.Fatal("handler called for invalid request")
+			}
+			st := status.Convert(err)
+			if st.Code() != codes.InvalidArgument {
+				t.Fatalf("expected InvalidArgument, got %v", err)
+			}
+			if len(st.Details()) != 1 {
+				t.Fatalf("expected BadRequest details, got %v", st.Details())
+			}
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			if !ok {
+				t.Fatalf("unexpected details %T", st.Details()[0])
+			}
+			got := make(map[string]bool)
+			for _, v := range badRequest.GetFieldViolations() {
+				if v.GetDescription() == "" {
+					t.Errorf("empty description for %s", v.GetField())
+				}
+				got[v.GetField()] = true
+			}
+			if len(got) != len(tc.violations) {
+				t.Fatalf("expected violations %v, got %v", tc.violations, badRequest.GetFieldViolations())
+			}
+			for field := range tc.violations {
+				if !got[field] {
+					t.Fatalf("expected violations %v, got %v", tc.violations, badRequest.GetFieldViolations())
+				}
+			}
+		})
+	}
+}
+
+func TestProtoFieldName(t *testing.T) {
+	for field, want := range map[string]string{
+		"Uuid":          "uuid",
+		"ReservationId": "reservation_id",
+		"Uuids[0]":      "uuids[0]",
+		"Parts[3]":      "parts[3]",
+	} {
+		if got := protoFieldName(field); got != want {
+			t.Errorf("%s: expected %s, got %s", field, want, got)
+		}
+	}
+}
+
+func TestInvokeValidated(t *testing.T) {
+	method := func(_ context.Context, req *genInventoryV1.GetPartRequest) (*genInventoryV1.GetPartResponse, error) {
+		return &genInventoryV1.GetPartResponse{Part: &genInventoryV1.Part{Uuid: req.GetUuid()}}, nil
+	}
+
+	t.Run("Valid request", func(t *testing.T) {
+		// act
+		resp, err := InvokeValidated(context.Background(), &genInventoryV1.GetPartRequest{Uuid: "1f0d6a8e-7a4c-4d4e-9a7b-2f6b0c1d2e3f"}, method)
+
+		// assert
+		if err != nil || resp.GetPart().GetUuid() != "1f0d6a8e-7a4c-4d4e-9a7b-2f6b0c1d2e3f" {
+			t.Fatalf("unexpected result: %v, %v", resp, err)
+		}
+	})
+
+	t.Run("Invalid request", func(t *testing.T) {
+		// act
+		resp, err := InvokeValidated(context.Background(), &genInventoryV1.GetPartRequest{Uuid: "42"}, method)
+
+		// assert
+		if resp != nil || status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v, %v", resp, err)
+		}
+	})
+}
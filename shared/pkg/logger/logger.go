@@ -0,0 +1,66 @@
+// Package logger настраивает структурированное логирование log/slog в формате JSON.
+// Каждая запись содержит имя сервиса, а записи, сделанные с контекстом
+// запроса, - его request_id и trace_id.
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Init делает логгер сервиса service с уровнем level ("debug", "info", "warn"
+// или "error") логгером по умолчанию. Пакет log также пишет через него.
+func Init(service, level string) error {
+	logger, err := New(os.Stdout, service, level)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(logger)
+	return nil
+}
+
+// New создает логгер, который пишет JSON в w
+func New(w io.Writer, service, level string) (*slog.Logger, error) {
+	var lvl slog.Level
+	err := lvl.UnmarshalText([]byte(level))
+	if err != nil {
+		return nil, fmt.Errorf("invalid log level %q", level)
+	}
+
+	handler := slog.NewJSONHandler(w, &slog.HandlerOptions{Level: lvl})
+	return slog.New(contextHandler{handler}).With(slog.String("service", service)), nil
+}
+
+// Fatal пишет ошибку и завершает процесс, как log.Fatal
+func Fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+// contextHandler добавляет к записи идентификаторы запроса и трассировки из контекста
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		r.AddAttrs(slog.String("request_id", requestID))
+	}
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		r.AddAttrs(slog.String("trace_id", span.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(&buf, "api-test", "warn")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx := ContextWithRequestID(context.Background(), "req-1")
+	logger.InfoContext(ctx, "skipped")
+	logger.WarnContext(ctx, "written", slog.String("order_uuid", "42"))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 record, got %d: %s", len(lines), buf.String())
+	}
+	var record map[string]any
+	if err = json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatalf("record is not JSON: %v", err)
+	}
+	for key, want := range map[string]string{
+		"level":      "WARN",
+		"msg":        "written",
+		"service":    "api-test",
+		"request_id": "req-1",
+		"order_uuid": "42",
+	} {
+		if record[key] != want {
+			t.Errorf("%s: expected %q, got %v", key, want, record[key])
+		}
+	}
+
+	if _, err = New(&buf, "api-test", "verbose"); err == nil {
+		t.Fatal("expected error for unknown level")
+	}
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	var got string
+	handler := RequestIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = RequestIDFromContext(r.Context())
+	}))
+
+	for _, tt := range []struct {
+		name     string
+		received string
+		keep     bool
+	}{
+		{"Accepted from client", "client-request-1", true},
+		{"Generated when missing", "", false},
+		{"Generated when invalid", "bad id\n", false},
+		{"Generated when too long", strings.Repeat("a", maxRequestIDLength+1), false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.received != "" {
+				req.Header.Set(RequestIDHeader, tt.received)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if got == "" || rec.Header().Get(RequestIDHeader) != got {
+				t.Fatalf("request id %q not returned in response: %q", got, rec.Header().Get(RequestIDHeader))
+			}
+			if (got == tt.received) != tt.keep {
+				t.Fatalf("unexpected request id %q for %q", got, tt.received)
+			}
+		})
+	}
+}
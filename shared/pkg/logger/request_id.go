@@ -0,0 +1,85 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/felixge/httpsnoop"
+	"github.com/google/uuid"
+)
+
+const (
+	// RequestIDHeader - HTTP-заголовок с идентификатором запроса
+	RequestIDHeader = "X-Request-ID"
+	// RequestIDMetadataKey - ключ метаданных gRPC с идентификатором запроса
+	RequestIDMetadataKey = "x-request-id"
+
+	maxRequestIDLength = 128
+)
+
+type requestIDKey struct{}
+
+// ContextWithRequestID сохраняет идентификатор запроса в контексте
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext возвращает идентификатор запроса или пустую строку
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// RequestID возвращает присланный клиентом идентификатор запроса, если он
+// допустим, иначе создает новый
+func RequestID(received string) string {
+	if ValidRequestID(received) {
+		return received
+	}
+	return uuid.NewString()
+}
+
+// ValidRequestID сообщает, можно ли принять идентификатор запроса от клиента:
+// непустой, не длиннее 128 символов, только печатные ASCII без пробелов
+func ValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] <= ' ' || requestID[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// RequestIDMiddleware принимает идентификатор запроса из заголовка X-Request-ID
+// или создает новый, сохраняет его в контексте и возвращает в ответе
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := RequestID(r.Header.Get(RequestIDHeader))
+		w.Header().Set(RequestIDHeader, requestID)
+		next.ServeHTTP(w, r.WithContext(ContextWithRequestID(r.Context(), requestID)))
+	})
+}
+
+// AccessLogMiddleware пишет запись о каждом обработанном HTTP-запросе
+func AccessLogMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m := httpsnoop.CaptureMetrics(next, w, r)
+
+		level := slog.LevelInfo
+		if m.Code >= http.StatusInternalServerError {
+			level = slog.LevelError
+		}
+		slog.Log(r.Context(), level, "http request",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.Int("status", m.Code),
+			slog.Int64("bytes", m.Written),
+			slog.Duration("duration", m.Duration),
+			slog.String("remote_addr", r.RemoteAddr),
+		)
+	})
+}
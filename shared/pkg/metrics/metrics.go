@@ -0,0 +1,52 @@
+// Package metrics отдает метрики сервисов в формате Prometheus
+// и считает HTTP-запросы.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/felixge/httpsnoop"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// unknownRoute - значение метки route для запросов вне известных маршрутов,
+// чтобы произвольные пути не создавали новые временные ряды
+const unknownRoute = "unknown"
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_server_requests_total",
+		Help: "Number of handled HTTP requests by method, route and status code.",
+	}, []string{"method", "route", "code"})
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_server_request_duration_seconds",
+		Help:    "Duration of handled HTTP requests by method, route and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "code"})
+)
+
+// Handler отдает метрики для /metrics
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// HTTPMiddleware считает HTTP-запросы и время их обработки. route возвращает
+// шаблон маршрута запроса после его обработки, например "/api/v1/orders/{order_uuid}".
+func HTTPMiddleware(route func(*http.Request) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			m := httpsnoop.CaptureMetrics(next, w, r)
+
+			pattern := route(r)
+			if pattern == "" {
+				pattern = unknownRoute
+			}
+			code := strconv.Itoa(m.Code)
+			httpRequests.WithLabelValues(r.Method, pattern, code).Inc()
+			httpDuration.WithLabelValues(r.Method, pattern, code).Observe(m.Duration.Seconds())
+		})
+	}
+}
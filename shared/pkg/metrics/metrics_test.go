@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestHTTPMiddleware(t *testing.T) {
+	handler := HTTPMiddleware(func(r *http.Request) string {
+		if strings.HasPrefix(r.URL.Path, "/orders/") {
+			return "/orders/{order_uuid}"
+		}
+		return ""
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/orders/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, path := range []string{"/orders/1", "/orders/2", "/orders/missing", "/random"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	for _, tt := range []struct {
+		route string
+		code  string
+		want  float64
+	}{
+		{"/orders/{order_uuid}", "200", 2},
+		{"/orders/{order_uuid}", "404", 1},
+		{unknownRoute, "200", 1},
+	} {
+		got := testutil.ToFloat64(httpRequests.WithLabelValues(http.MethodGet, tt.route, tt.code))
+		if got != tt.want {
+			t.Fatalf("route %s code %s: expected %v requests, got %v", tt.route, tt.code, tt.want, got)
+		}
+	}
+	if n := testutil.CollectAndCount(httpDuration); n != 3 {
+		t.Fatalf("expected 3 latency histograms, got %d", n)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "go_goroutines") {
+		t.Fatalf("unexpected /metrics response: %d", rec.Code)
+	}
+}
@@ -0,0 +1,163 @@
+// Package money содержит точное представление денежных сумм в минимальных
+// единицах валюты (копейках, центах) вместо чисел с плавающей точкой.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency - валюта каталога и заказов, если она не указана явно
+const DefaultCurrency = "RUB"
+
+var (
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	ErrInvalidAmount    = errors.New("invalid money amount")
+	ErrOverflow         = errors.New("money amount overflow")
+)
+
+// minorUnits - число знаков после запятой для валют, у которых оно отличается от 2
+var minorUnits = map[string]int{
+	"JPY": 0,
+	"KRW": 0,
+	"BHD": 3,
+	"KWD": 3,
+}
+
+// Money - сумма в минимальных единицах валюты с ISO 4217 кодом валюты
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Zero возвращает нулевую сумму в валюте currency
+func Zero(currency string) Money {
+	return Money{Currency: currency}
+}
+
+// MinorUnits возвращает число знаков после запятой для валюты
+func MinorUnits(currency string) int {
+	if units, ok := minorUnits[currency]; ok {
+		return units
+	}
+	return 2
+}
+
+// Parse разбирает десятичную запись суммы, например "123.45". Лишние знаки
+// после запятой округляются до минимальных единиц половиной от нуля.
+func Parse(s, currency string) (Money, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return Money{}, fmt.Errorf("%q: %w", s, ErrInvalidAmount)
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(MinorUnits(currency))), nil)
+	r.Mul(r, new(big.Rat).SetInt(scale))
+
+	// Округление половиной от нуля: (|num| * 2 + den) / (2 * den)
+	num, den := new(big.Int).Abs(r.Num()), r.Denom()
+	q := new(big.Int).Mul(num, big.NewInt(2))
+	q.Add(q, den)
+	q.Quo(q, new(big.Int).Mul(den, big.NewInt(2)))
+	if r.Sign() < 0 {
+		q.Neg(q)
+	}
+
+	if !q.IsInt64() {
+		return Money{}, fmt.Errorf("%q: %w", s, ErrOverflow)
+	}
+	return Money{Amount: q.Int64(), Currency: currency}, nil
+}
+
+// FromFloat переводит сумму из числа с плавающей точкой. Используется только
+// для совместимости со старыми клиентами: округляется кратчайшая десятичная
+// запись числа, поэтому 11.725 дает 1173, а не 1172.
+func FromFloat(v float64, currency string) (Money, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return Money{}, fmt.Errorf("%v: %w", v, ErrInvalidAmount)
+	}
+	return Parse(strconv.FormatFloat(v, 'f', -1, 64), currency)
+}
+
+// Float64 возвращает приближенное значение суммы в основных единицах валюты
+// для устаревших полей API
+func (m Money) Float64() float64 {
+	v, _ := strconv.ParseFloat(m.Decimal(), 64)
+	return v
+}
+
+// Decimal возвращает точную десятичную запись суммы, например "123.45"
+func (m Money) Decimal() string {
+	units := MinorUnits(m.Currency)
+	if units == 0 {
+		return strconv.FormatInt(m.Amount, 10)
+	}
+
+	sign, abs := "", new(big.Int).SetInt64(m.Amount)
+	if m.Amount < 0 {
+		sign = "-"
+		abs.Neg(abs)
+	}
+	digits := abs.String()
+	if len(digits) <= units {
+		digits = strings.Repeat("0", units-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-units] + "." + digits[len(digits)-units:]
+}
+
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Add складывает суммы в одной валюте. Нулевая сумма без валюты
+// считается нейтральной, поэтому с нее удобно начинать подсчет итога.
+func (m Money) Add(other Money) (Money, error) {
+	switch {
+	case m.Currency == "" && m.Amount == 0:
+		m.Currency = other.Currency
+	case other.Currency == "" && other.Amount == 0:
+		other.Currency = m.Currency
+	}
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%s and %s: %w", m.Currency, other.Currency, ErrCurrencyMismatch)
+	}
+
+	sum := m.Amount + other.Amount
+	if (other.Amount > 0 && sum < m.Amount) || (other.Amount < 0 && sum > m.Amount) {
+		return Money{}, ErrOverflow
+	}
+	return Money{Amount: sum, Currency: m.Currency}, nil
+}
+
+// Sub вычитает сумму в той же валюте по тем же правилам, что и Add
+func (m Money) Sub(other Money) (Money, error) {
+	if other.Amount == math.MinInt64 {
+		return Money{}, ErrOverflow
+	}
+	return m.Add(Money{Amount: -other.Amount, Currency: other.Currency})
+}
+
+// Mul умножает сумму на целое количество
+func (m Money) Mul(quantity int64) (Money, error) {
+	if m.Amount == 0 || quantity == 0 {
+		return Money{Currency: m.Currency}, nil
+	}
+
+	product := m.Amount * quantity
+	if product/quantity != m.Amount || (m.Amount == -1 && quantity == math.MinInt64) {
+		return Money{}, ErrOverflow
+	}
+	return Money{Amount: product, Currency: m.Currency}, nil
+}
@@ -0,0 +1,121 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		in       string
+		currency string
+		expected int64
+		err      error
+	}{
+		{name: "Whole amount", in: "450000", currency: "RUB", expected: 45000000},
+		{name: "Cents", in: "123.45", currency: "RUB", expected: 12345},
+		{name: "Round half up", in: "11.725", currency: "RUB", expected: 1173},
+		{name: "Round half away from zero", in: "-11.725", currency: "RUB", expected: -1173},
+		{name: "Round down", in: "0.004", currency: "USD", expected: 0},
+		{name: "Zero minor units", in: "1500.5", currency: "JPY", expected: 1501},
+		{name: "Three minor units", in: "1.2345", currency: "KWD", expected: 1235},
+		{name: "Invalid amount", in: "12,5", currency: "RUB", err: ErrInvalidAmount},
+		{name: "Overflow", in: "100000000000000000000", currency: "RUB", err: ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := Parse(tc.in, tc.currency)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("expected error %v, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if m != New(tc.expected, tc.currency) {
+				t.Fatalf("expected %d %s, got %+v", tc.expected, tc.currency, m)
+			}
+		})
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	m, err := FromFloat(11.725, "RUB")
+	if err != nil || m.Amount != 1173 {
+		t.Fatalf("expected 1173, got %+v (%v)", m, err)
+	}
+
+	// float32 теряет копейки уже на таких суммах
+	m, err = FromFloat(12345678.91, "RUB")
+	if err != nil || m.Amount != 1234567891 {
+		t.Fatalf("expected 1234567891, got %+v (%v)", m, err)
+	}
+
+	_, err = FromFloat(math.NaN(), "RUB")
+	if !errors.Is(err, ErrInvalidAmount) {
+		t.Fatalf("expected ErrInvalidAmount, got %v", err)
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	testCases := map[string]Money{
+		"123.45":  New(12345, "RUB"),
+		"0.05":    New(5, "RUB"),
+		"-0.05":   New(-5, "RUB"),
+		"1500":    New(1500, "JPY"),
+		"1.235":   New(1235, "KWD"),
+		"4500.00": New(450000, "USD"),
+	}
+	for expected, m := range testCases {
+		if got := m.Decimal(); got != expected {
+			t.Errorf("expected %s, got %s", expected, got)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	total, err := Zero("").Add(New(10000, "RUB"))
+	if err != nil || total != New(10000, "RUB") {
+		t.Fatalf("unexpected total %+v (%v)", total, err)
+	}
+
+	line, err := New(1173, "RUB").Mul(3)
+	if err != nil || line != New(3519, "RUB") {
+		t.Fatalf("unexpected line total %+v (%v)", line, err)
+	}
+
+	_, err = total.Add(New(1, "USD"))
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+
+	_, err = New(math.MaxInt64, "RUB").Add(New(1, "RUB"))
+	if !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected ErrOverflow on add, got %v", err)
+	}
+
+	rest, err := total.Sub(New(2500, "RUB"))
+	if err != nil || rest != New(7500, "RUB") {
+		t.Fatalf("unexpected difference %+v (%v)", rest, err)
+	}
+
+	_, err = total.Sub(New(1, "USD"))
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("expected ErrCurrencyMismatch on sub, got %v", err)
+	}
+
+	_, err = New(math.MinInt64, "RUB").Sub(New(1, "RUB"))
+	if !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected ErrOverflow on sub, got %v", err)
+	}
+
+	_, err = New(math.MaxInt64/2+1, "RUB").Mul(2)
+	if !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected ErrOverflow on mul, got %v", err)
+	}
+}
@@ -9,10 +9,54 @@ type CreateOrderRes interface {
 	createOrderRes()
 }
 
+type CreateWebhookRes interface {
+	createWebhookRes()
+}
+
+type DeleteWebhookRes interface {
+	deleteWebhookRes()
+}
+
+type GetOrderHistoryRes interface {
+	getOrderHistoryRes()
+}
+
 type GetOrderRes interface {
 	getOrderRes()
 }
 
+type GetWebhookRes interface {
+	getWebhookRes()
+}
+
+type ListOrdersRes interface {
+	listOrdersRes()
+}
+
+type ListWebhookDeadLettersRes interface {
+	listWebhookDeadLettersRes()
+}
+
+type ListWebhooksRes interface {
+	listWebhooksRes()
+}
+
 type PayOrderRes interface {
 	payOrderRes()
 }
+
+type RefundOrderRes interface {
+	refundOrderRes()
+}
+
+type ReplayWebhookDeadLetterRes interface {
+	replayWebhookDeadLetterRes()
+}
+
+type RotateWebhookSecretRes interface {
+	rotateWebhookSecretRes()
+}
+
+type UpdateWebhookRes interface {
+	updateWebhookRes()
+}
@@ -6,8 +6,19 @@ package order_v1
 type OperationName = string
 
 const (
-	CancelOrderOperation OperationName = "CancelOrder"
-	CreateOrderOperation OperationName = "CreateOrder"
-	GetOrderOperation    OperationName = "GetOrder"
-	PayOrderOperation    OperationName = "PayOrder"
+	CancelOrderOperation             OperationName = "CancelOrder"
+	CreateOrderOperation             OperationName = "CreateOrder"
+	CreateWebhookOperation           OperationName = "CreateWebhook"
+	DeleteWebhookOperation           OperationName = "DeleteWebhook"
+	GetOrderOperation                OperationName = "GetOrder"
+	GetOrderHistoryOperation         OperationName = "GetOrderHistory"
+	GetWebhookOperation              OperationName = "GetWebhook"
+	ListOrdersOperation              OperationName = "ListOrders"
+	ListWebhookDeadLettersOperation  OperationName = "ListWebhookDeadLetters"
+	ListWebhooksOperation            OperationName = "ListWebhooks"
+	PayOrderOperation                OperationName = "PayOrder"
+	RefundOrderOperation             OperationName = "RefundOrder"
+	ReplayWebhookDeadLetterOperation OperationName = "ReplayWebhookDeadLetter"
+	RotateWebhookSecretOperation     OperationName = "RotateWebhookSecret"
+	UpdateWebhookOperation           OperationName = "UpdateWebhook"
 )
@@ -4,6 +4,7 @@ package order_v1
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
@@ -41,9 +42,38 @@ func (s *BadRequestError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*BadRequestError) cancelOrderRes() {}
-func (*BadRequestError) createOrderRes() {}
-func (*BadRequestError) payOrderRes()    {}
+func (*BadRequestError) cancelOrderRes()   {}
+func (*BadRequestError) createOrderRes()   {}
+func (*BadRequestError) createWebhookRes() {}
+func (*BadRequestError) listOrdersRes()    {}
+func (*BadRequestError) payOrderRes()      {}
+func (*BadRequestError) refundOrderRes()   {}
+func (*BadRequestError) updateWebhookRes() {}
+
+type BearerAuth struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
+}
 
 // CancelOrderNoContent is response for CancelOrder operation.
 type CancelOrderNoContent struct{}
@@ -78,42 +108,66 @@ func (s *ConflictError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*ConflictError) cancelOrderRes() {}
+func (*ConflictError) cancelOrderRes()             {}
+func (*ConflictError) createOrderRes()             {}
+func (*ConflictError) payOrderRes()                {}
+func (*ConflictError) refundOrderRes()             {}
+func (*ConflictError) replayWebhookDeadLetterRes() {}
 
 // Ref: #
-type CreateOrderRequest struct {
-	// UUID пользователя, создающего заказ.
-	UserUUID uuid.UUID `json:"user_uuid"`
-	// Массив UUID выбранных деталей.
-	PartUuids []uuid.UUID `json:"part_uuids"`
+type CreateOrderItem struct {
+	// UUID детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Количество деталей.
+	Quantity int64 `json:"quantity"`
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *CreateOrderRequest) GetUserUUID() uuid.UUID {
-	return s.UserUUID
+// GetPartUUID returns the value of PartUUID.
+func (s *CreateOrderItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
 }
 
-// GetPartUuids returns the value of PartUuids.
-func (s *CreateOrderRequest) GetPartUuids() []uuid.UUID {
-	return s.PartUuids
+// GetQuantity returns the value of Quantity.
+func (s *CreateOrderItem) GetQuantity() int64 {
+	return s.Quantity
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *CreateOrderRequest) SetUserUUID(val uuid.UUID) {
-	s.UserUUID = val
+// SetPartUUID sets the value of PartUUID.
+func (s *CreateOrderItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
 }
 
-// SetPartUuids sets the value of PartUuids.
-func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
-	s.PartUuids = val
+// SetQuantity sets the value of Quantity.
+func (s *CreateOrderItem) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// Ref: #
+type CreateOrderRequest struct {
+	// Позиции заказа.
+	Items []CreateOrderItem `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *CreateOrderRequest) GetItems() []CreateOrderItem {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *CreateOrderRequest) SetItems(val []CreateOrderItem) {
+	s.Items = val
 }
 
 // Ref: #
 type CreateOrderResponse struct {
 	// UUID созданного заказа.
 	OrderUUID uuid.UUID `json:"order_uuid"`
-	// Итоговая стоимость заказа.
-	TotalPrice float32 `json:"total_price"`
+	// Итоговая стоимость заказа в основных единицах валюты.
+	//  Используйте total_price_money.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	TotalPrice      float64 `json:"total_price"`
+	TotalPriceMoney Money   `json:"total_price_money"`
 }
 
 // GetOrderUUID returns the value of OrderUUID.
@@ -122,22 +176,37 @@ func (s *CreateOrderResponse) GetOrderUUID() uuid.UUID {
 }
 
 // GetTotalPrice returns the value of TotalPrice.
-func (s *CreateOrderResponse) GetTotalPrice() float32 {
+func (s *CreateOrderResponse) GetTotalPrice() float64 {
 	return s.TotalPrice
 }
 
+// GetTotalPriceMoney returns the value of TotalPriceMoney.
+func (s *CreateOrderResponse) GetTotalPriceMoney() Money {
+	return s.TotalPriceMoney
+}
+
 // SetOrderUUID sets the value of OrderUUID.
 func (s *CreateOrderResponse) SetOrderUUID(val uuid.UUID) {
 	s.OrderUUID = val
 }
 
 // SetTotalPrice sets the value of TotalPrice.
-func (s *CreateOrderResponse) SetTotalPrice(val float32) {
+func (s *CreateOrderResponse) SetTotalPrice(val float64) {
 	s.TotalPrice = val
 }
 
+// SetTotalPriceMoney sets the value of TotalPriceMoney.
+func (s *CreateOrderResponse) SetTotalPriceMoney(val Money) {
+	s.TotalPriceMoney = val
+}
+
 func (*CreateOrderResponse) createOrderRes() {}
 
+// DeleteWebhookNoContent is response for DeleteWebhook operation.
+type DeleteWebhookNoContent struct{}
+
+func (*DeleteWebhookNoContent) deleteWebhookRes() {}
+
 // Ref: #
 type GenericError struct {
 	// HTTP-код ошибки.
@@ -220,10 +289,158 @@ func (s *InternalServerError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*InternalServerError) cancelOrderRes() {}
-func (*InternalServerError) createOrderRes() {}
-func (*InternalServerError) getOrderRes()    {}
-func (*InternalServerError) payOrderRes()    {}
+func (*InternalServerError) cancelOrderRes()             {}
+func (*InternalServerError) createOrderRes()             {}
+func (*InternalServerError) createWebhookRes()           {}
+func (*InternalServerError) deleteWebhookRes()           {}
+func (*InternalServerError) getOrderHistoryRes()         {}
+func (*InternalServerError) getOrderRes()                {}
+func (*InternalServerError) getWebhookRes()              {}
+func (*InternalServerError) listOrdersRes()              {}
+func (*InternalServerError) listWebhookDeadLettersRes()  {}
+func (*InternalServerError) listWebhooksRes()            {}
+func (*InternalServerError) payOrderRes()                {}
+func (*InternalServerError) refundOrderRes()             {}
+func (*InternalServerError) replayWebhookDeadLetterRes() {}
+func (*InternalServerError) rotateWebhookSecretRes()     {}
+func (*InternalServerError) updateWebhookRes()           {}
+
+// Ref: #
+type ListOrdersResponse struct {
+	// Заказы на странице.
+	Orders []Order `json:"orders"`
+	// Курсор следующей страницы, отсутствует на последней
+	// странице.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetOrders returns the value of Orders.
+func (s *ListOrdersResponse) GetOrders() []Order {
+	return s.Orders
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListOrdersResponse) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetOrders sets the value of Orders.
+func (s *ListOrdersResponse) SetOrders(val []Order) {
+	s.Orders = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListOrdersResponse) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*ListOrdersResponse) listOrdersRes() {}
+
+type ListOrdersSort string
+
+const (
+	ListOrdersSortCreatedAtAsc  ListOrdersSort = "created_at_asc"
+	ListOrdersSortCreatedAtDesc ListOrdersSort = "created_at_desc"
+)
+
+// AllValues returns all ListOrdersSort values.
+func (ListOrdersSort) AllValues() []ListOrdersSort {
+	return []ListOrdersSort{
+		ListOrdersSortCreatedAtAsc,
+		ListOrdersSortCreatedAtDesc,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListOrdersSort) MarshalText() ([]byte, error) {
+	switch s {
+	case ListOrdersSortCreatedAtAsc:
+		return []byte(s), nil
+	case ListOrdersSortCreatedAtDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListOrdersSort) UnmarshalText(data []byte) error {
+	switch ListOrdersSort(data) {
+	case ListOrdersSortCreatedAtAsc:
+		*s = ListOrdersSortCreatedAtAsc
+		return nil
+	case ListOrdersSortCreatedAtDesc:
+		*s = ListOrdersSortCreatedAtDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #
+type ListWebhookDeliveriesResponse struct {
+	// Доставки в порядке создания.
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+// GetDeliveries returns the value of Deliveries.
+func (s *ListWebhookDeliveriesResponse) GetDeliveries() []WebhookDelivery {
+	return s.Deliveries
+}
+
+// SetDeliveries sets the value of Deliveries.
+func (s *ListWebhookDeliveriesResponse) SetDeliveries(val []WebhookDelivery) {
+	s.Deliveries = val
+}
+
+func (*ListWebhookDeliveriesResponse) listWebhookDeadLettersRes() {}
+
+// Ref: #
+type ListWebhooksResponse struct {
+	// Подписки в порядке создания.
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// GetWebhooks returns the value of Webhooks.
+func (s *ListWebhooksResponse) GetWebhooks() []Webhook {
+	return s.Webhooks
+}
+
+// SetWebhooks sets the value of Webhooks.
+func (s *ListWebhooksResponse) SetWebhooks(val []Webhook) {
+	s.Webhooks = val
+}
+
+func (*ListWebhooksResponse) listWebhooksRes() {}
+
+// Денежная сумма в минимальных единицах валюты.
+// Ref: #
+type Money struct {
+	// Сумма в минимальных единицах валюты (копейках, центах).
+	Amount int64 `json:"amount"`
+	// Код валюты ISO 4217.
+	Currency string `json:"currency"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *Money) GetAmount() int64 {
+	return s.Amount
+}
+
+// GetCurrency returns the value of Currency.
+func (s *Money) GetCurrency() string {
+	return s.Currency
+}
+
+// SetAmount sets the value of Amount.
+func (s *Money) SetAmount(val int64) {
+	s.Amount = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *Money) SetCurrency(val string) {
+	s.Currency = val
+}
 
 // Ref: #
 type NotFoundError struct {
@@ -253,9 +470,65 @@ func (s *NotFoundError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*NotFoundError) cancelOrderRes() {}
-func (*NotFoundError) getOrderRes()    {}
-func (*NotFoundError) payOrderRes()    {}
+func (*NotFoundError) cancelOrderRes()             {}
+func (*NotFoundError) createWebhookRes()           {}
+func (*NotFoundError) deleteWebhookRes()           {}
+func (*NotFoundError) getOrderHistoryRes()         {}
+func (*NotFoundError) getOrderRes()                {}
+func (*NotFoundError) getWebhookRes()              {}
+func (*NotFoundError) listWebhookDeadLettersRes()  {}
+func (*NotFoundError) listWebhooksRes()            {}
+func (*NotFoundError) payOrderRes()                {}
+func (*NotFoundError) refundOrderRes()             {}
+func (*NotFoundError) replayWebhookDeadLetterRes() {}
+func (*NotFoundError) rotateWebhookSecretRes()     {}
+func (*NotFoundError) updateWebhookRes()           {}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
 // NewOptInt returns new OptInt with value set to v.
 func NewOptInt(v int) OptInt {
@@ -303,6 +576,98 @@ func (o OptInt) Or(d int) int {
 	return d
 }
 
+// NewOptListOrdersSort returns new OptListOrdersSort with value set to v.
+func NewOptListOrdersSort(v ListOrdersSort) OptListOrdersSort {
+	return OptListOrdersSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListOrdersSort is optional ListOrdersSort.
+type OptListOrdersSort struct {
+	Value ListOrdersSort
+	Set   bool
+}
+
+// IsSet returns true if OptListOrdersSort was set.
+func (o OptListOrdersSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListOrdersSort) Reset() {
+	var v ListOrdersSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListOrdersSort) SetTo(v ListOrdersSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListOrdersSort) Get() (v ListOrdersSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListOrdersSort) Or(d ListOrdersSort) ListOrdersSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMoney returns new OptMoney with value set to v.
+func NewOptMoney(v Money) OptMoney {
+	return OptMoney{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMoney is optional Money.
+type OptMoney struct {
+	Value Money
+	Set   bool
+}
+
+// IsSet returns true if OptMoney was set.
+func (o OptMoney) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMoney) Reset() {
+	var v Money
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMoney) SetTo(v Money) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMoney) Get() (v Money, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMoney) Or(d Money) Money {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptNilOrderPaymentMethod returns new OptNilOrderPaymentMethod with value set to v.
 func NewOptNilOrderPaymentMethod(v OrderPaymentMethod) OptNilOrderPaymentMethod {
 	return OptNilOrderPaymentMethod{
@@ -429,6 +794,52 @@ func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
 	return d
 }
 
+// NewOptOrderStatus returns new OptOrderStatus with value set to v.
+func NewOptOrderStatus(v OrderStatus) OptOrderStatus {
+	return OptOrderStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrderStatus is optional OrderStatus.
+type OptOrderStatus struct {
+	Value OrderStatus
+	Set   bool
+}
+
+// IsSet returns true if OptOrderStatus was set.
+func (o OptOrderStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrderStatus) Reset() {
+	var v OrderStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrderStatus) SetTo(v OrderStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrderStatus) Get() (v OrderStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrderStatus) Or(d OrderStatus) OrderStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptString returns new OptString with value set to v.
 func NewOptString(v string) OptString {
 	return OptString{
@@ -475,42 +886,102 @@ func (o OptString) Or(d string) string {
 	return d
 }
 
-// Ref: #
-type Order struct {
-	// Уникальный идентификатор заказа.
-	OrderUUID uuid.UUID `json:"order_uuid"`
-	// UUID пользователя.
-	UserUUID uuid.UUID `json:"user_uuid"`
-	// Список UUID деталей.
-	PartUuids []uuid.UUID `json:"part_uuids"`
-	// Итоговая стоимость.
-	TotalPrice float64 `json:"total_price"`
-	// UUID транзакции (если оплачен).
-	TransactionUUID OptNilUUID `json:"transaction_uuid"`
-	// Способ оплаты.
-	PaymentMethod OptNilOrderPaymentMethod `json:"payment_method"`
-	// Статус заказа.
-	Status OrderStatus `json:"status"`
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetOrderUUID returns the value of OrderUUID.
-func (s *Order) GetOrderUUID() uuid.UUID {
-	return s.OrderUUID
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *Order) GetUserUUID() uuid.UUID {
-	return s.UserUUID
-}
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
 
-// GetPartUuids returns the value of PartUuids.
-func (s *Order) GetPartUuids() []uuid.UUID {
-	return s.PartUuids
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
 }
 
-// GetTotalPrice returns the value of TotalPrice.
-func (s *Order) GetTotalPrice() float64 {
-	return s.TotalPrice
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #
+type Order struct {
+	// Уникальный идентификатор заказа.
+	OrderUUID uuid.UUID `json:"order_uuid"`
+	// UUID пользователя.
+	UserUUID uuid.UUID `json:"user_uuid"`
+	// Позиции заказа с ценами на момент его создания.
+	Items []OrderItem `json:"items"`
+	// Итоговая стоимость в основных единицах валюты.
+	// Используйте total_price_money.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	TotalPrice      float64 `json:"total_price"`
+	TotalPriceMoney Money   `json:"total_price_money"`
+	// UUID транзакции (если оплачен).
+	TransactionUUID OptNilUUID `json:"transaction_uuid"`
+	// Способ оплаты.
+	PaymentMethod OptNilOrderPaymentMethod `json:"payment_method"`
+	Status        OrderStatus              `json:"status"`
+	// Дата и время создания заказа.
+	CreatedAt time.Time `json:"created_at"`
+	// Крайний срок оплаты, после которого неоплаченный
+	// заказ переходит в статус EXPIRED.
+	PaymentDeadline time.Time `json:"payment_deadline"`
+	RefundedMoney   Money     `json:"refunded_money"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *Order) GetOrderUUID() uuid.UUID {
+	return s.OrderUUID
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *Order) GetUserUUID() uuid.UUID {
+	return s.UserUUID
+}
+
+// GetItems returns the value of Items.
+func (s *Order) GetItems() []OrderItem {
+	return s.Items
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *Order) GetTotalPrice() float64 {
+	return s.TotalPrice
+}
+
+// GetTotalPriceMoney returns the value of TotalPriceMoney.
+func (s *Order) GetTotalPriceMoney() Money {
+	return s.TotalPriceMoney
 }
 
 // GetTransactionUUID returns the value of TransactionUUID.
@@ -528,6 +999,21 @@ func (s *Order) GetStatus() OrderStatus {
 	return s.Status
 }
 
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Order) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetPaymentDeadline returns the value of PaymentDeadline.
+func (s *Order) GetPaymentDeadline() time.Time {
+	return s.PaymentDeadline
+}
+
+// GetRefundedMoney returns the value of RefundedMoney.
+func (s *Order) GetRefundedMoney() Money {
+	return s.RefundedMoney
+}
+
 // SetOrderUUID sets the value of OrderUUID.
 func (s *Order) SetOrderUUID(val uuid.UUID) {
 	s.OrderUUID = val
@@ -538,9 +1024,9 @@ func (s *Order) SetUserUUID(val uuid.UUID) {
 	s.UserUUID = val
 }
 
-// SetPartUuids sets the value of PartUuids.
-func (s *Order) SetPartUuids(val []uuid.UUID) {
-	s.PartUuids = val
+// SetItems sets the value of Items.
+func (s *Order) SetItems(val []OrderItem) {
+	s.Items = val
 }
 
 // SetTotalPrice sets the value of TotalPrice.
@@ -548,6 +1034,11 @@ func (s *Order) SetTotalPrice(val float64) {
 	s.TotalPrice = val
 }
 
+// SetTotalPriceMoney sets the value of TotalPriceMoney.
+func (s *Order) SetTotalPriceMoney(val Money) {
+	s.TotalPriceMoney = val
+}
+
 // SetTransactionUUID sets the value of TransactionUUID.
 func (s *Order) SetTransactionUUID(val OptNilUUID) {
 	s.TransactionUUID = val
@@ -563,8 +1054,118 @@ func (s *Order) SetStatus(val OrderStatus) {
 	s.Status = val
 }
 
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Order) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetPaymentDeadline sets the value of PaymentDeadline.
+func (s *Order) SetPaymentDeadline(val time.Time) {
+	s.PaymentDeadline = val
+}
+
+// SetRefundedMoney sets the value of RefundedMoney.
+func (s *Order) SetRefundedMoney(val Money) {
+	s.RefundedMoney = val
+}
+
 func (*Order) getOrderRes() {}
 
+// Ref: #
+type OrderHistoryResponse struct {
+	// Изменения статуса в хронологическом порядке.
+	History []OrderStatusChange `json:"history"`
+}
+
+// GetHistory returns the value of History.
+func (s *OrderHistoryResponse) GetHistory() []OrderStatusChange {
+	return s.History
+}
+
+// SetHistory sets the value of History.
+func (s *OrderHistoryResponse) SetHistory(val []OrderStatusChange) {
+	s.History = val
+}
+
+func (*OrderHistoryResponse) getOrderHistoryRes() {}
+
+// Ref: #
+type OrderItem struct {
+	// UUID детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Количество деталей.
+	Quantity int64 `json:"quantity"`
+	// Цена за единицу в основных единицах валюты.
+	// Используйте unit_price_money.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	UnitPrice      float64 `json:"unit_price"`
+	UnitPriceMoney Money   `json:"unit_price_money"`
+	// Название детали на момент создания заказа.
+	Name     string       `json:"name"`
+	Category PartCategory `json:"category"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItem) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *OrderItem) GetUnitPrice() float64 {
+	return s.UnitPrice
+}
+
+// GetUnitPriceMoney returns the value of UnitPriceMoney.
+func (s *OrderItem) GetUnitPriceMoney() Money {
+	return s.UnitPriceMoney
+}
+
+// GetName returns the value of Name.
+func (s *OrderItem) GetName() string {
+	return s.Name
+}
+
+// GetCategory returns the value of Category.
+func (s *OrderItem) GetCategory() PartCategory {
+	return s.Category
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItem) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *OrderItem) SetUnitPrice(val float64) {
+	s.UnitPrice = val
+}
+
+// SetUnitPriceMoney sets the value of UnitPriceMoney.
+func (s *OrderItem) SetUnitPriceMoney(val Money) {
+	s.UnitPriceMoney = val
+}
+
+// SetName sets the value of Name.
+func (s *OrderItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetCategory sets the value of Category.
+func (s *OrderItem) SetCategory(val PartCategory) {
+	s.Category = val
+}
+
 // Способ оплаты.
 type OrderPaymentMethod string
 
@@ -629,12 +1230,16 @@ func (s *OrderPaymentMethod) UnmarshalText(data []byte) error {
 }
 
 // Статус заказа.
+// Ref: #
 type OrderStatus string
 
 const (
-	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
-	OrderStatusPAID           OrderStatus = "PAID"
-	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
+	OrderStatusPENDINGPAYMENT    OrderStatus = "PENDING_PAYMENT"
+	OrderStatusPAID              OrderStatus = "PAID"
+	OrderStatusCANCELLED         OrderStatus = "CANCELLED"
+	OrderStatusEXPIRED           OrderStatus = "EXPIRED"
+	OrderStatusPARTIALLYREFUNDED OrderStatus = "PARTIALLY_REFUNDED"
+	OrderStatusREFUNDED          OrderStatus = "REFUNDED"
 )
 
 // AllValues returns all OrderStatus values.
@@ -643,6 +1248,9 @@ func (OrderStatus) AllValues() []OrderStatus {
 		OrderStatusPENDINGPAYMENT,
 		OrderStatusPAID,
 		OrderStatusCANCELLED,
+		OrderStatusEXPIRED,
+		OrderStatusPARTIALLYREFUNDED,
+		OrderStatusREFUNDED,
 	}
 }
 
@@ -655,6 +1263,12 @@ func (s OrderStatus) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case OrderStatusCANCELLED:
 		return []byte(s), nil
+	case OrderStatusEXPIRED:
+		return []byte(s), nil
+	case OrderStatusPARTIALLYREFUNDED:
+		return []byte(s), nil
+	case OrderStatusREFUNDED:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -672,6 +1286,136 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 	case OrderStatusCANCELLED:
 		*s = OrderStatusCANCELLED
 		return nil
+	case OrderStatusEXPIRED:
+		*s = OrderStatusEXPIRED
+		return nil
+	case OrderStatusPARTIALLYREFUNDED:
+		*s = OrderStatusPARTIALLYREFUNDED
+		return nil
+	case OrderStatusREFUNDED:
+		*s = OrderStatusREFUNDED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #
+type OrderStatusChange struct {
+	FromStatus OptOrderStatus `json:"from_status"`
+	ToStatus   OrderStatus    `json:"to_status"`
+	// Причина изменения статуса.
+	Reason string `json:"reason"`
+	// Дата и время изменения статуса.
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// GetFromStatus returns the value of FromStatus.
+func (s *OrderStatusChange) GetFromStatus() OptOrderStatus {
+	return s.FromStatus
+}
+
+// GetToStatus returns the value of ToStatus.
+func (s *OrderStatusChange) GetToStatus() OrderStatus {
+	return s.ToStatus
+}
+
+// GetReason returns the value of Reason.
+func (s *OrderStatusChange) GetReason() string {
+	return s.Reason
+}
+
+// GetChangedAt returns the value of ChangedAt.
+func (s *OrderStatusChange) GetChangedAt() time.Time {
+	return s.ChangedAt
+}
+
+// SetFromStatus sets the value of FromStatus.
+func (s *OrderStatusChange) SetFromStatus(val OptOrderStatus) {
+	s.FromStatus = val
+}
+
+// SetToStatus sets the value of ToStatus.
+func (s *OrderStatusChange) SetToStatus(val OrderStatus) {
+	s.ToStatus = val
+}
+
+// SetReason sets the value of Reason.
+func (s *OrderStatusChange) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetChangedAt sets the value of ChangedAt.
+func (s *OrderStatusChange) SetChangedAt(val time.Time) {
+	s.ChangedAt = val
+}
+
+// Категория детали.
+// Ref: #
+type PartCategory string
+
+const (
+	PartCategoryUNSPECIFIED PartCategory = "UNSPECIFIED"
+	PartCategoryENGINE      PartCategory = "ENGINE"
+	PartCategoryFUEL        PartCategory = "FUEL"
+	PartCategoryPORTHOLE    PartCategory = "PORTHOLE"
+	PartCategoryWING        PartCategory = "WING"
+	PartCategorySHIELD      PartCategory = "SHIELD"
+)
+
+// AllValues returns all PartCategory values.
+func (PartCategory) AllValues() []PartCategory {
+	return []PartCategory{
+		PartCategoryUNSPECIFIED,
+		PartCategoryENGINE,
+		PartCategoryFUEL,
+		PartCategoryPORTHOLE,
+		PartCategoryWING,
+		PartCategorySHIELD,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PartCategory) MarshalText() ([]byte, error) {
+	switch s {
+	case PartCategoryUNSPECIFIED:
+		return []byte(s), nil
+	case PartCategoryENGINE:
+		return []byte(s), nil
+	case PartCategoryFUEL:
+		return []byte(s), nil
+	case PartCategoryPORTHOLE:
+		return []byte(s), nil
+	case PartCategoryWING:
+		return []byte(s), nil
+	case PartCategorySHIELD:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PartCategory) UnmarshalText(data []byte) error {
+	switch PartCategory(data) {
+	case PartCategoryUNSPECIFIED:
+		*s = PartCategoryUNSPECIFIED
+		return nil
+	case PartCategoryENGINE:
+		*s = PartCategoryENGINE
+		return nil
+	case PartCategoryFUEL:
+		*s = PartCategoryFUEL
+		return nil
+	case PartCategoryPORTHOLE:
+		*s = PartCategoryPORTHOLE
+		return nil
+	case PartCategoryWING:
+		*s = PartCategoryWING
+		return nil
+	case PartCategorySHIELD:
+		*s = PartCategorySHIELD
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
@@ -766,3 +1510,506 @@ func (s *PayOrderResponse) SetTransactionUUID(val uuid.UUID) {
 }
 
 func (*PayOrderResponse) payOrderRes() {}
+
+// Способ оплаты.
+// Ref: #
+type PaymentMethod string
+
+const (
+	PaymentMethodCARD          PaymentMethod = "CARD"
+	PaymentMethodSBP           PaymentMethod = "SBP"
+	PaymentMethodCREDITCARD    PaymentMethod = "CREDIT_CARD"
+	PaymentMethodINVESTORMONEY PaymentMethod = "INVESTOR_MONEY"
+)
+
+// AllValues returns all PaymentMethod values.
+func (PaymentMethod) AllValues() []PaymentMethod {
+	return []PaymentMethod{
+		PaymentMethodCARD,
+		PaymentMethodSBP,
+		PaymentMethodCREDITCARD,
+		PaymentMethodINVESTORMONEY,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PaymentMethod) MarshalText() ([]byte, error) {
+	switch s {
+	case PaymentMethodCARD:
+		return []byte(s), nil
+	case PaymentMethodSBP:
+		return []byte(s), nil
+	case PaymentMethodCREDITCARD:
+		return []byte(s), nil
+	case PaymentMethodINVESTORMONEY:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PaymentMethod) UnmarshalText(data []byte) error {
+	switch PaymentMethod(data) {
+	case PaymentMethodCARD:
+		*s = PaymentMethodCARD
+		return nil
+	case PaymentMethodSBP:
+		*s = PaymentMethodSBP
+		return nil
+	case PaymentMethodCREDITCARD:
+		*s = PaymentMethodCREDITCARD
+		return nil
+	case PaymentMethodINVESTORMONEY:
+		*s = PaymentMethodINVESTORMONEY
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #
+type RefundOrderRequest struct {
+	Amount OptMoney `json:"amount"`
+	// Причина возврата.
+	Reason OptString `json:"reason"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *RefundOrderRequest) GetAmount() OptMoney {
+	return s.Amount
+}
+
+// GetReason returns the value of Reason.
+func (s *RefundOrderRequest) GetReason() OptString {
+	return s.Reason
+}
+
+// SetAmount sets the value of Amount.
+func (s *RefundOrderRequest) SetAmount(val OptMoney) {
+	s.Amount = val
+}
+
+// SetReason sets the value of Reason.
+func (s *RefundOrderRequest) SetReason(val OptString) {
+	s.Reason = val
+}
+
+// Ref: #
+type RefundOrderResponse struct {
+	// UUID возврата в платежном сервисе.
+	RefundUUID         uuid.UUID   `json:"refund_uuid"`
+	RefundedMoney      Money       `json:"refunded_money"`
+	TotalRefundedMoney Money       `json:"total_refunded_money"`
+	Status             OrderStatus `json:"status"`
+}
+
+// GetRefundUUID returns the value of RefundUUID.
+func (s *RefundOrderResponse) GetRefundUUID() uuid.UUID {
+	return s.RefundUUID
+}
+
+// GetRefundedMoney returns the value of RefundedMoney.
+func (s *RefundOrderResponse) GetRefundedMoney() Money {
+	return s.RefundedMoney
+}
+
+// GetTotalRefundedMoney returns the value of TotalRefundedMoney.
+func (s *RefundOrderResponse) GetTotalRefundedMoney() Money {
+	return s.TotalRefundedMoney
+}
+
+// GetStatus returns the value of Status.
+func (s *RefundOrderResponse) GetStatus() OrderStatus {
+	return s.Status
+}
+
+// SetRefundUUID sets the value of RefundUUID.
+func (s *RefundOrderResponse) SetRefundUUID(val uuid.UUID) {
+	s.RefundUUID = val
+}
+
+// SetRefundedMoney sets the value of RefundedMoney.
+func (s *RefundOrderResponse) SetRefundedMoney(val Money) {
+	s.RefundedMoney = val
+}
+
+// SetTotalRefundedMoney sets the value of TotalRefundedMoney.
+func (s *RefundOrderResponse) SetTotalRefundedMoney(val Money) {
+	s.TotalRefundedMoney = val
+}
+
+// SetStatus sets the value of Status.
+func (s *RefundOrderResponse) SetStatus(val OrderStatus) {
+	s.Status = val
+}
+
+func (*RefundOrderResponse) refundOrderRes() {}
+
+// Ref: #
+type UnprocessableEntityError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Описание ошибки.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *UnprocessableEntityError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *UnprocessableEntityError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *UnprocessableEntityError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *UnprocessableEntityError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*UnprocessableEntityError) createOrderRes() {}
+func (*UnprocessableEntityError) payOrderRes()    {}
+func (*UnprocessableEntityError) refundOrderRes() {}
+
+// Ref: #
+type Webhook struct {
+	// Уникальный идентификатор подписки.
+	WebhookUUID uuid.UUID `json:"webhook_uuid"`
+	// Адрес, на который отправляются события.
+	URL string `json:"url"`
+	// Типы событий, на которые оформлена подписка.
+	EventTypes []WebhookEventType `json:"event_types"`
+	// Секрет HMAC-SHA256 подписи доставок. Возвращается только
+	// при создании подписки и ротации секрета.
+	Secret OptString `json:"secret"`
+	// Дата и время создания подписки.
+	CreatedAt time.Time `json:"created_at"`
+	// Дата и время последнего изменения подписки.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetWebhookUUID returns the value of WebhookUUID.
+func (s *Webhook) GetWebhookUUID() uuid.UUID {
+	return s.WebhookUUID
+}
+
+// GetURL returns the value of URL.
+func (s *Webhook) GetURL() string {
+	return s.URL
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *Webhook) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// GetSecret returns the value of Secret.
+func (s *Webhook) GetSecret() OptString {
+	return s.Secret
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Webhook) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Webhook) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetWebhookUUID sets the value of WebhookUUID.
+func (s *Webhook) SetWebhookUUID(val uuid.UUID) {
+	s.WebhookUUID = val
+}
+
+// SetURL sets the value of URL.
+func (s *Webhook) SetURL(val string) {
+	s.URL = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *Webhook) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *Webhook) SetSecret(val OptString) {
+	s.Secret = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Webhook) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Webhook) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*Webhook) createWebhookRes()       {}
+func (*Webhook) getWebhookRes()          {}
+func (*Webhook) rotateWebhookSecretRes() {}
+func (*Webhook) updateWebhookRes()       {}
+
+// Ref: #
+type WebhookDelivery struct {
+	// Уникальный идентификатор доставки.
+	DeliveryUUID uuid.UUID `json:"delivery_uuid"`
+	// UUID подписки.
+	WebhookUUID uuid.UUID `json:"webhook_uuid"`
+	// UUID события, передается получателю в заголовке X-Webhook-Id.
+	EventUUID uuid.UUID             `json:"event_uuid"`
+	EventType WebhookEventType      `json:"event_type"`
+	Status    WebhookDeliveryStatus `json:"status"`
+	// Количество выполненных попыток.
+	Attempts int `json:"attempts"`
+	// Время следующей попытки.
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	// Ошибка последней неудачной попытки.
+	LastError OptString `json:"last_error"`
+	// Дата и время создания доставки.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetDeliveryUUID returns the value of DeliveryUUID.
+func (s *WebhookDelivery) GetDeliveryUUID() uuid.UUID {
+	return s.DeliveryUUID
+}
+
+// GetWebhookUUID returns the value of WebhookUUID.
+func (s *WebhookDelivery) GetWebhookUUID() uuid.UUID {
+	return s.WebhookUUID
+}
+
+// GetEventUUID returns the value of EventUUID.
+func (s *WebhookDelivery) GetEventUUID() uuid.UUID {
+	return s.EventUUID
+}
+
+// GetEventType returns the value of EventType.
+func (s *WebhookDelivery) GetEventType() WebhookEventType {
+	return s.EventType
+}
+
+// GetStatus returns the value of Status.
+func (s *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	return s.Status
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *WebhookDelivery) GetAttempts() int {
+	return s.Attempts
+}
+
+// GetNextAttemptAt returns the value of NextAttemptAt.
+func (s *WebhookDelivery) GetNextAttemptAt() time.Time {
+	return s.NextAttemptAt
+}
+
+// GetLastError returns the value of LastError.
+func (s *WebhookDelivery) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WebhookDelivery) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetDeliveryUUID sets the value of DeliveryUUID.
+func (s *WebhookDelivery) SetDeliveryUUID(val uuid.UUID) {
+	s.DeliveryUUID = val
+}
+
+// SetWebhookUUID sets the value of WebhookUUID.
+func (s *WebhookDelivery) SetWebhookUUID(val uuid.UUID) {
+	s.WebhookUUID = val
+}
+
+// SetEventUUID sets the value of EventUUID.
+func (s *WebhookDelivery) SetEventUUID(val uuid.UUID) {
+	s.EventUUID = val
+}
+
+// SetEventType sets the value of EventType.
+func (s *WebhookDelivery) SetEventType(val WebhookEventType) {
+	s.EventType = val
+}
+
+// SetStatus sets the value of Status.
+func (s *WebhookDelivery) SetStatus(val WebhookDeliveryStatus) {
+	s.Status = val
+}
+
+// SetAttempts sets the value of Attempts.
+func (s *WebhookDelivery) SetAttempts(val int) {
+	s.Attempts = val
+}
+
+// SetNextAttemptAt sets the value of NextAttemptAt.
+func (s *WebhookDelivery) SetNextAttemptAt(val time.Time) {
+	s.NextAttemptAt = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *WebhookDelivery) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WebhookDelivery) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*WebhookDelivery) replayWebhookDeadLetterRes() {}
+
+// Статус доставки вебхука.
+// Ref: #
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusDELIVERED WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryStatusDEAD      WebhookDeliveryStatus = "DEAD"
+)
+
+// AllValues returns all WebhookDeliveryStatus values.
+func (WebhookDeliveryStatus) AllValues() []WebhookDeliveryStatus {
+	return []WebhookDeliveryStatus{
+		WebhookDeliveryStatusPENDING,
+		WebhookDeliveryStatusDELIVERED,
+		WebhookDeliveryStatusDEAD,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookDeliveryStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookDeliveryStatusPENDING:
+		return []byte(s), nil
+	case WebhookDeliveryStatusDELIVERED:
+		return []byte(s), nil
+	case WebhookDeliveryStatusDEAD:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookDeliveryStatus) UnmarshalText(data []byte) error {
+	switch WebhookDeliveryStatus(data) {
+	case WebhookDeliveryStatusPENDING:
+		*s = WebhookDeliveryStatusPENDING
+		return nil
+	case WebhookDeliveryStatusDELIVERED:
+		*s = WebhookDeliveryStatusDELIVERED
+		return nil
+	case WebhookDeliveryStatusDEAD:
+		*s = WebhookDeliveryStatusDEAD
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Тип события заказа, отправляемого вебхуком.
+// Ref: #
+type WebhookEventType string
+
+const (
+	WebhookEventTypeOrderCreated   WebhookEventType = "order.created"
+	WebhookEventTypeOrderPaid      WebhookEventType = "order.paid"
+	WebhookEventTypeOrderCancelled WebhookEventType = "order.cancelled"
+	WebhookEventTypeOrderRefunded  WebhookEventType = "order.refunded"
+	WebhookEventTypeOrderExpired   WebhookEventType = "order.expired"
+)
+
+// AllValues returns all WebhookEventType values.
+func (WebhookEventType) AllValues() []WebhookEventType {
+	return []WebhookEventType{
+		WebhookEventTypeOrderCreated,
+		WebhookEventTypeOrderPaid,
+		WebhookEventTypeOrderCancelled,
+		WebhookEventTypeOrderRefunded,
+		WebhookEventTypeOrderExpired,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookEventType) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookEventTypeOrderCreated:
+		return []byte(s), nil
+	case WebhookEventTypeOrderPaid:
+		return []byte(s), nil
+	case WebhookEventTypeOrderCancelled:
+		return []byte(s), nil
+	case WebhookEventTypeOrderRefunded:
+		return []byte(s), nil
+	case WebhookEventTypeOrderExpired:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookEventType) UnmarshalText(data []byte) error {
+	switch WebhookEventType(data) {
+	case WebhookEventTypeOrderCreated:
+		*s = WebhookEventTypeOrderCreated
+		return nil
+	case WebhookEventTypeOrderPaid:
+		*s = WebhookEventTypeOrderPaid
+		return nil
+	case WebhookEventTypeOrderCancelled:
+		*s = WebhookEventTypeOrderCancelled
+		return nil
+	case WebhookEventTypeOrderRefunded:
+		*s = WebhookEventTypeOrderRefunded
+		return nil
+	case WebhookEventTypeOrderExpired:
+		*s = WebhookEventTypeOrderExpired
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #
+type WebhookRequest struct {
+	// Абсолютный http или https адрес получателя.
+	URL string `json:"url"`
+	// Типы событий, на которые оформляется подписка.
+	EventTypes []WebhookEventType `json:"event_types"`
+}
+
+// GetURL returns the value of URL.
+func (s *WebhookRequest) GetURL() string {
+	return s.URL
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *WebhookRequest) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// SetURL sets the value of URL.
+func (s *WebhookRequest) SetURL(val string) {
+	s.URL = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *WebhookRequest) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
@@ -19,19 +19,96 @@ type Handler interface {
 	// Создание заказа.
 	//
 	// POST /api/v1/orders
-	CreateOrder(ctx context.Context, req *CreateOrderRequest) (CreateOrderRes, error)
+	CreateOrder(ctx context.Context, req *CreateOrderRequest, params CreateOrderParams) (CreateOrderRes, error)
+	// CreateWebhook implements CreateWebhook operation.
+	//
+	// Каждая доставка подписывается заголовком X-Webhook-Signature
+	// вида sha256=<hex>, где hex - HMAC-SHA256 от строки "<X-Webhook-Timestamp>.<тело
+	// запроса>" на секрете подписки. Неудачные доставки
+	// повторяются с экспоненциальной задержкой, после
+	// исчерпания попыток попадают в список недоставленных.
+	//
+	// POST /api/v1/webhooks
+	CreateWebhook(ctx context.Context, req *WebhookRequest) (CreateWebhookRes, error)
+	// DeleteWebhook implements DeleteWebhook operation.
+	//
+	// Удаление подписки вместе с ее доставками.
+	//
+	// DELETE /api/v1/webhooks/{webhook_uuid}
+	DeleteWebhook(ctx context.Context, params DeleteWebhookParams) (DeleteWebhookRes, error)
 	// GetOrder implements GetOrder operation.
 	//
 	// Получение заказа.
 	//
 	// GET /api/v1/orders/{order_uuid}
 	GetOrder(ctx context.Context, params GetOrderParams) (GetOrderRes, error)
+	// GetOrderHistory implements GetOrderHistory operation.
+	//
+	// История статусов заказа.
+	//
+	// GET /api/v1/orders/{order_uuid}/history
+	GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (GetOrderHistoryRes, error)
+	// GetWebhook implements GetWebhook operation.
+	//
+	// Получение подписки на вебхуки.
+	//
+	// GET /api/v1/webhooks/{webhook_uuid}
+	GetWebhook(ctx context.Context, params GetWebhookParams) (GetWebhookRes, error)
+	// ListOrders implements ListOrders operation.
+	//
+	// Получение списка заказов.
+	//
+	// GET /api/v1/orders
+	ListOrders(ctx context.Context, params ListOrdersParams) (ListOrdersRes, error)
+	// ListWebhookDeadLetters implements ListWebhookDeadLetters operation.
+	//
+	// Получение списка недоставленных событий подписки.
+	//
+	// GET /api/v1/webhooks/{webhook_uuid}/dead-letters
+	ListWebhookDeadLetters(ctx context.Context, params ListWebhookDeadLettersParams) (ListWebhookDeadLettersRes, error)
+	// ListWebhooks implements ListWebhooks operation.
+	//
+	// Получение списка подписок на вебхуки.
+	//
+	// GET /api/v1/webhooks
+	ListWebhooks(ctx context.Context) (ListWebhooksRes, error)
 	// PayOrder implements PayOrder operation.
 	//
 	// Оплата заказа.
 	//
 	// POST /api/v1/orders/{order_uuid}/pay
 	PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (PayOrderRes, error)
+	// RefundOrder implements RefundOrder operation.
+	//
+	// Возвращает указанную сумму или, если amount не передан,
+	// весь остаток оплаты.
+	// Частичный возврат переводит заказ в статус PARTIALLY_REFUNDED,
+	//  возврат
+	// всего остатка - в статус REFUNDED с возвратом деталей на
+	// склад.
+	//
+	// POST /api/v1/orders/{order_uuid}/refund
+	RefundOrder(ctx context.Context, req *RefundOrderRequest, params RefundOrderParams) (RefundOrderRes, error)
+	// ReplayWebhookDeadLetter implements ReplayWebhookDeadLetter operation.
+	//
+	// Доставка возвращается в очередь отправки с новым
+	// набором попыток.
+	//
+	// POST /api/v1/webhooks/{webhook_uuid}/dead-letters/{delivery_uuid}/replay
+	ReplayWebhookDeadLetter(ctx context.Context, params ReplayWebhookDeadLetterParams) (ReplayWebhookDeadLetterRes, error)
+	// RotateWebhookSecret implements RotateWebhookSecret operation.
+	//
+	// Доставки, отправленные после ротации, подписываются
+	// новым секретом.
+	//
+	// POST /api/v1/webhooks/{webhook_uuid}/rotate-secret
+	RotateWebhookSecret(ctx context.Context, params RotateWebhookSecretParams) (RotateWebhookSecretRes, error)
+	// UpdateWebhook implements UpdateWebhook operation.
+	//
+	// Изменение адреса и типов событий подписки.
+	//
+	// PUT /api/v1/webhooks/{webhook_uuid}
+	UpdateWebhook(ctx context.Context, req *WebhookRequest, params UpdateWebhookParams) (UpdateWebhookRes, error)
 	// NewError creates *GenericErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
@@ -41,18 +118,20 @@ type Handler interface {
 // Server implements http server based on OpenAPI v3 specification and
 // calls Handler to handle requests.
 type Server struct {
-	h Handler
+	h   Handler
+	sec SecurityHandler
 	baseServer
 }
 
 // NewServer creates new Server.
-func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
 	s, err := newServerConfig(opts...).baseServer()
 	if err != nil {
 		return nil, err
 	}
 	return &Server{
 		h:          h,
+		sec:        sec,
 		baseServer: s,
 	}, nil
 }
@@ -27,7 +27,29 @@ func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderP
 // Создание заказа.
 //
 // POST /api/v1/orders
-func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderRequest) (r CreateOrderRes, _ error) {
+func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderRequest, params CreateOrderParams) (r CreateOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateWebhook implements CreateWebhook operation.
+//
+// Каждая доставка подписывается заголовком X-Webhook-Signature
+// вида sha256=<hex>, где hex - HMAC-SHA256 от строки "<X-Webhook-Timestamp>.<тело
+// запроса>" на секрете подписки. Неудачные доставки
+// повторяются с экспоненциальной задержкой, после
+// исчерпания попыток попадают в список недоставленных.
+//
+// POST /api/v1/webhooks
+func (UnimplementedHandler) CreateWebhook(ctx context.Context, req *WebhookRequest) (r CreateWebhookRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteWebhook implements DeleteWebhook operation.
+//
+// Удаление подписки вместе с ее доставками.
+//
+// DELETE /api/v1/webhooks/{webhook_uuid}
+func (UnimplementedHandler) DeleteWebhook(ctx context.Context, params DeleteWebhookParams) (r DeleteWebhookRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -40,6 +62,51 @@ func (UnimplementedHandler) GetOrder(ctx context.Context, params GetOrderParams)
 	return r, ht.ErrNotImplemented
 }
 
+// GetOrderHistory implements GetOrderHistory operation.
+//
+// История статусов заказа.
+//
+// GET /api/v1/orders/{order_uuid}/history
+func (UnimplementedHandler) GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (r GetOrderHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetWebhook implements GetWebhook operation.
+//
+// Получение подписки на вебхуки.
+//
+// GET /api/v1/webhooks/{webhook_uuid}
+func (UnimplementedHandler) GetWebhook(ctx context.Context, params GetWebhookParams) (r GetWebhookRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListOrders implements ListOrders operation.
+//
+// Получение списка заказов.
+//
+// GET /api/v1/orders
+func (UnimplementedHandler) ListOrders(ctx context.Context, params ListOrdersParams) (r ListOrdersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListWebhookDeadLetters implements ListWebhookDeadLetters operation.
+//
+// Получение списка недоставленных событий подписки.
+//
+// GET /api/v1/webhooks/{webhook_uuid}/dead-letters
+func (UnimplementedHandler) ListWebhookDeadLetters(ctx context.Context, params ListWebhookDeadLettersParams) (r ListWebhookDeadLettersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListWebhooks implements ListWebhooks operation.
+//
+// Получение списка подписок на вебхуки.
+//
+// GET /api/v1/webhooks
+func (UnimplementedHandler) ListWebhooks(ctx context.Context) (r ListWebhooksRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PayOrder implements PayOrder operation.
 //
 // Оплата заказа.
@@ -49,6 +116,51 @@ func (UnimplementedHandler) PayOrder(ctx context.Context, req *PayOrderRequest,
 	return r, ht.ErrNotImplemented
 }
 
+// RefundOrder implements RefundOrder operation.
+//
+// Возвращает указанную сумму или, если amount не передан,
+// весь остаток оплаты.
+// Частичный возврат переводит заказ в статус PARTIALLY_REFUNDED,
+//
+//	возврат
+//
+// всего остатка - в статус REFUNDED с возвратом деталей на
+// склад.
+//
+// POST /api/v1/orders/{order_uuid}/refund
+func (UnimplementedHandler) RefundOrder(ctx context.Context, req *RefundOrderRequest, params RefundOrderParams) (r RefundOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReplayWebhookDeadLetter implements ReplayWebhookDeadLetter operation.
+//
+// Доставка возвращается в очередь отправки с новым
+// набором попыток.
+//
+// POST /api/v1/webhooks/{webhook_uuid}/dead-letters/{delivery_uuid}/replay
+func (UnimplementedHandler) ReplayWebhookDeadLetter(ctx context.Context, params ReplayWebhookDeadLetterParams) (r ReplayWebhookDeadLetterRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RotateWebhookSecret implements RotateWebhookSecret operation.
+//
+// Доставки, отправленные после ротации, подписываются
+// новым секретом.
+//
+// POST /api/v1/webhooks/{webhook_uuid}/rotate-secret
+func (UnimplementedHandler) RotateWebhookSecret(ctx context.Context, params RotateWebhookSecretParams) (r RotateWebhookSecretRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateWebhook implements UpdateWebhook operation.
+//
+// Изменение адреса и типов событий подписки.
+//
+// PUT /api/v1/webhooks/{webhook_uuid}
+func (UnimplementedHandler) UpdateWebhook(ctx context.Context, req *WebhookRequest, params UpdateWebhookParams) (r UpdateWebhookRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *GenericErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
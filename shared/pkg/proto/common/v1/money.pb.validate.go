@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: common/v1/money.proto
+
+package common_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Money) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MoneyMultiError, or nil if none found.
+func (m *Money) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Money) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Amount
+
+	if utf8.RuneCountInString(m.GetCurrency()) != 3 {
+		err := MoneyValidationError{
+			field:  "Currency",
+			reason: "value length must be 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return MoneyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoneyMultiError is an error wrapping multiple validation errors returned by
+// Money.ValidateAll() if the designated constraints aren't met.
+type MoneyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoneyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoneyMultiError) AllErrors() []error { return m }
+
+// MoneyValidationError is the validation error returned by Money.Validate if
+// the designated constraints aren't met.
+type MoneyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoneyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoneyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoneyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoneyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoneyValidationError) ErrorName() string { return "MoneyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoneyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoney.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoneyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoneyValidationError{}
@@ -10,9 +10,11 @@ package inventory_v1
 
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	v1 "github.com/xgmsx/rsf/shared/pkg/proto/common/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -26,6 +28,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Статус резерва
+type ReservationStatus int32
+
+const (
+	ReservationStatus_RESERVATION_STATUS_UNSPECIFIED ReservationStatus = 0
+	ReservationStatus_RESERVATION_STATUS_PENDING     ReservationStatus = 1
+	ReservationStatus_RESERVATION_STATUS_COMMITTED   ReservationStatus = 2
+	ReservationStatus_RESERVATION_STATUS_RELEASED    ReservationStatus = 3
+	ReservationStatus_RESERVATION_STATUS_EXPIRED     ReservationStatus = 4
+)
+
+// Enum value maps for ReservationStatus.
+var (
+	ReservationStatus_name = map[int32]string{
+		0: "RESERVATION_STATUS_UNSPECIFIED",
+		1: "RESERVATION_STATUS_PENDING",
+		2: "RESERVATION_STATUS_COMMITTED",
+		3: "RESERVATION_STATUS_RELEASED",
+		4: "RESERVATION_STATUS_EXPIRED",
+	}
+	ReservationStatus_value = map[string]int32{
+		"RESERVATION_STATUS_UNSPECIFIED": 0,
+		"RESERVATION_STATUS_PENDING":     1,
+		"RESERVATION_STATUS_COMMITTED":   2,
+		"RESERVATION_STATUS_RELEASED":    3,
+		"RESERVATION_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x ReservationStatus) Enum() *ReservationStatus {
+	p := new(ReservationStatus)
+	*p = x
+	return p
+}
+
+func (x ReservationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReservationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (ReservationStatus) Type() protoreflect.EnumType {
+	return &file_v1_inventory_proto_enumTypes[0]
+}
+
+func (x ReservationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReservationStatus.Descriptor instead.
+func (ReservationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
 // Категория детали
 type Category int32
 
@@ -69,11 +127,11 @@ func (x Category) String() string {
 }
 
 func (Category) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_inventory_proto_enumTypes[0].Descriptor()
+	return file_v1_inventory_proto_enumTypes[1].Descriptor()
 }
 
 func (Category) Type() protoreflect.EnumType {
-	return &file_v1_inventory_proto_enumTypes[0]
+	return &file_v1_inventory_proto_enumTypes[1]
 }
 
 func (x Category) Number() protoreflect.EnumNumber {
@@ -82,7 +140,7 @@ func (x Category) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Category.Descriptor instead.
 func (Category) EnumDescriptor() ([]byte, []int) {
-	return file_v1_inventory_proto_rawDescGZIP(), []int{0}
+	return file_v1_inventory_proto_rawDescGZIP(), []int{1}
 }
 
 // Запрос на получение данных детали по UUID
@@ -265,6 +323,424 @@ func (x *ListPartsResponse) GetParts() []*Part {
 	return nil
 }
 
+// Запрос на резервирование деталей. Повторный запрос с тем же reservation_id
+// и тем же составом возвращает уже созданный резерв
+type ReservePartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Items         []*ReservationItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// Время жизни неподтвержденного резерва, по умолчанию 15 минут
+	Ttl           *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservePartsRequest) Reset() {
+	*x = ReservePartsRequest{}
+	mi := &file_v1_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservePartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservePartsRequest) ProtoMessage() {}
+
+func (x *ReservePartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservePartsRequest.ProtoReflect.Descriptor instead.
+func (*ReservePartsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReservePartsRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReservePartsRequest) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReservePartsRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// Ответ на запрос резервирования деталей
+type ReservePartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservePartsResponse) Reset() {
+	*x = ReservePartsResponse{}
+	mi := &file_v1_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservePartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservePartsResponse) ProtoMessage() {}
+
+func (x *ReservePartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservePartsResponse.ProtoReflect.Descriptor instead.
+func (*ReservePartsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReservePartsResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+// Запрос на подтверждение резерва
+type CommitReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitReservationRequest) Reset() {
+	*x = CommitReservationRequest{}
+	mi := &file_v1_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationRequest) ProtoMessage() {}
+
+func (x *CommitReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationRequest.ProtoReflect.Descriptor instead.
+func (*CommitReservationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CommitReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+// Ответ на запрос подтверждения резерва
+type CommitReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitReservationResponse) Reset() {
+	*x = CommitReservationResponse{}
+	mi := &file_v1_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationResponse) ProtoMessage() {}
+
+func (x *CommitReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationResponse.ProtoReflect.Descriptor instead.
+func (*CommitReservationResponse) Descriptor() ([]byte, []int) {
+	return file_v1_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CommitReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+// Запрос на снятие резерва
+type ReleaseReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationRequest) Reset() {
+	*x = ReleaseReservationRequest{}
+	mi := &file_v1_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationRequest) ProtoMessage() {}
+
+func (x *ReleaseReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReleaseReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+// Ответ на запрос снятия резерва
+type ReleaseReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationResponse) Reset() {
+	*x = ReleaseReservationResponse{}
+	mi := &file_v1_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationResponse) ProtoMessage() {}
+
+func (x *ReleaseReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationResponse) Descriptor() ([]byte, []int) {
+	return file_v1_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReleaseReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+// Резерв деталей
+type Reservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Items         []*ReservationItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Status        ReservationStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=inventory.v1.ReservationStatus" json:"status,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	mi := &file_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Reservation) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *Reservation) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Reservation) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+func (x *Reservation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Зарезервированное количество одной детали
+type ReservationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationItem) Reset() {
+	*x = ReservationItem{}
+	mi := &file_v1_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationItem) ProtoMessage() {}
+
+func (x *ReservationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationItem.ProtoReflect.Descriptor instead.
+func (*ReservationItem) Descriptor() ([]byte, []int) {
+	return file_v1_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReservationItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ReservationItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 // Фильтр для поиска деталей
 type PartsFilter struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
@@ -279,7 +755,7 @@ type PartsFilter struct {
 
 func (x *PartsFilter) Reset() {
 	*x = PartsFilter{}
-	mi := &file_v1_inventory_proto_msgTypes[4]
+	mi := &file_v1_inventory_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -291,7 +767,7 @@ func (x *PartsFilter) String() string {
 func (*PartsFilter) ProtoMessage() {}
 
 func (x *PartsFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_inventory_proto_msgTypes[4]
+	mi := &file_v1_inventory_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +780,7 @@ func (x *PartsFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
 func (*PartsFilter) Descriptor() ([]byte, []int) {
-	return file_v1_inventory_proto_rawDescGZIP(), []int{4}
+	return file_v1_inventory_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PartsFilter) GetUuids() []string {
@@ -344,10 +820,13 @@ func (x *PartsFilter) GetTags() []string {
 
 // Структура представляющая собой деталь
 type Part struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Uuid        string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Устаревшее поле, цена в основных единицах валюты. Используйте price_money
+	//
+	// Deprecated: Marked as deprecated in v1/inventory.proto.
 	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
 	StockQuantity int64                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
 	Category      Category               `protobuf:"varint,6,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
@@ -357,13 +836,14 @@ type Part struct {
 	Metadata      map[string]*Value      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PriceMoney    *v1.Money              `protobuf:"bytes,13,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Part) Reset() {
 	*x = Part{}
-	mi := &file_v1_inventory_proto_msgTypes[5]
+	mi := &file_v1_inventory_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -375,7 +855,7 @@ func (x *Part) String() string {
 func (*Part) ProtoMessage() {}
 
 func (x *Part) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_inventory_proto_msgTypes[5]
+	mi := &file_v1_inventory_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,7 +868,7 @@ func (x *Part) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Part.ProtoReflect.Descriptor instead.
 func (*Part) Descriptor() ([]byte, []int) {
-	return file_v1_inventory_proto_rawDescGZIP(), []int{5}
+	return file_v1_inventory_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Part) GetUuid() string {
@@ -412,6 +892,7 @@ func (x *Part) GetDescription() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in v1/inventory.proto.
 func (x *Part) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -475,6 +956,13 @@ func (x *Part) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Part) GetPriceMoney() *v1.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
 // Размеры детали
 type Dimensions struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -488,7 +976,7 @@ type Dimensions struct {
 
 func (x *Dimensions) Reset() {
 	*x = Dimensions{}
-	mi := &file_v1_inventory_proto_msgTypes[6]
+	mi := &file_v1_inventory_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -500,7 +988,7 @@ func (x *Dimensions) String() string {
 func (*Dimensions) ProtoMessage() {}
 
 func (x *Dimensions) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_inventory_proto_msgTypes[6]
+	mi := &file_v1_inventory_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -513,7 +1001,7 @@ func (x *Dimensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
 func (*Dimensions) Descriptor() ([]byte, []int) {
-	return file_v1_inventory_proto_rawDescGZIP(), []int{6}
+	return file_v1_inventory_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Dimensions) GetLength() float64 {
@@ -556,7 +1044,7 @@ type Manufacturer struct {
 
 func (x *Manufacturer) Reset() {
 	*x = Manufacturer{}
-	mi := &file_v1_inventory_proto_msgTypes[7]
+	mi := &file_v1_inventory_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -568,7 +1056,7 @@ func (x *Manufacturer) String() string {
 func (*Manufacturer) ProtoMessage() {}
 
 func (x *Manufacturer) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_inventory_proto_msgTypes[7]
+	mi := &file_v1_inventory_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +1069,7 @@ func (x *Manufacturer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
 func (*Manufacturer) Descriptor() ([]byte, []int) {
-	return file_v1_inventory_proto_rawDescGZIP(), []int{7}
+	return file_v1_inventory_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Manufacturer) GetName() string {
@@ -621,7 +1109,7 @@ type Value struct {
 
 func (x *Value) Reset() {
 	*x = Value{}
-	mi := &file_v1_inventory_proto_msgTypes[8]
+	mi := &file_v1_inventory_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -633,7 +1121,7 @@ func (x *Value) String() string {
 func (*Value) ProtoMessage() {}
 
 func (x *Value) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_inventory_proto_msgTypes[8]
+	mi := &file_v1_inventory_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +1134,7 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Value.ProtoReflect.Descriptor instead.
 func (*Value) Descriptor() ([]byte, []int) {
-	return file_v1_inventory_proto_rawDescGZIP(), []int{8}
+	return file_v1_inventory_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Value) GetKind() isValue_Kind {
@@ -724,7 +1212,7 @@ var File_v1_inventory_proto protoreflect.FileDescriptor
 
 const file_v1_inventory_proto_rawDesc = "" +
 	"\n" +
-	"\x12v1/inventory.proto\x12\finventory.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\".\n" +
+	"\x12v1/inventory.proto\x12\finventory.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x15common/v1/money.proto\".\n" +
 	"\x0eGetPartRequest\x12\x1c\n" +
 	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\x04uuid\"9\n" +
 	"\x0fGetPartResponse\x12&\n" +
@@ -732,7 +1220,32 @@ const file_v1_inventory_proto_rawDesc = "" +
 	"\x10ListPartsRequest\x121\n" +
 	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"=\n" +
 	"\x11ListPartsResponse\x12(\n" +
-	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"\xcb\x01\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"\xc2\x01\n" +
+	"\x13ReservePartsRequest\x12/\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\rreservationId\x12=\n" +
+	"\x05items\x18\x02 \x03(\v2\x1d.inventory.v1.ReservationItemB\b\xfaB\x05\x92\x01\x02\b\x01R\x05items\x12;\n" +
+	"\x03ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationB\x0e\xfaB\v\xaa\x01\b\"\x04\b\x80\xa3\x052\x00R\x03ttl\"S\n" +
+	"\x14ReservePartsResponse\x12;\n" +
+	"\vreservation\x18\x01 \x01(\v2\x19.inventory.v1.ReservationR\vreservation\"K\n" +
+	"\x18CommitReservationRequest\x12/\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\rreservationId\"X\n" +
+	"\x19CommitReservationResponse\x12;\n" +
+	"\vreservation\x18\x01 \x01(\v2\x19.inventory.v1.ReservationR\vreservation\"L\n" +
+	"\x19ReleaseReservationRequest\x12/\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\rreservationId\"Y\n" +
+	"\x1aReleaseReservationResponse\x12;\n" +
+	"\vreservation\x18\x01 \x01(\v2\x19.inventory.v1.ReservationR\vreservation\"\x98\x02\n" +
+	"\vReservation\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x123\n" +
+	"\x05items\x18\x02 \x03(\v2\x1d.inventory.v1.ReservationItemR\x05items\x127\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1f.inventory.v1.ReservationStatusR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"]\n" +
+	"\x0fReservationItem\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\bpartUuid\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bquantity\"\xcb\x01\n" +
 	"\vPartsFilter\x12#\n" +
 	"\x05uuids\x18\x01 \x03(\tB\r\xfaB\n" +
 	"\x92\x01\a\"\x05r\x03\x98\x01$R\x05uuids\x12\x14\n" +
@@ -741,12 +1254,12 @@ const file_v1_inventory_proto_rawDesc = "" +
 	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
 	"categories\x125\n" +
 	"\x16manufacturer_countries\x18\x04 \x03(\tR\x15manufacturerCountries\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"\xdf\x04\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\"\x96\x05\n" +
 	"\x04Part\x12\x1c\n" +
 	"\x04uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01$R\x04uuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\x05price\x18\x04 \x01(\x01B\x02\x18\x01R\x05price\x12%\n" +
 	"\x0estock_quantity\x18\x05 \x01(\x03R\rstockQuantity\x122\n" +
 	"\bcategory\x18\x06 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x128\n" +
 	"\n" +
@@ -759,7 +1272,9 @@ const file_v1_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aP\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x121\n" +
+	"\vprice_money\x18\r \x01(\v2\x10.common.v1.MoneyR\n" +
+	"priceMoney\x1aP\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
 	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"j\n" +
@@ -780,17 +1295,26 @@ const file_v1_inventory_proto_rawDesc = "" +
 	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
 	"\n" +
 	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\x06\n" +
-	"\x04kind*\x8b\x01\n" +
+	"\x04kind*\xba\x01\n" +
+	"\x11ReservationStatus\x12\"\n" +
+	"\x1eRESERVATION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aRESERVATION_STATUS_PENDING\x10\x01\x12 \n" +
+	"\x1cRESERVATION_STATUS_COMMITTED\x10\x02\x12\x1f\n" +
+	"\x1bRESERVATION_STATUS_RELEASED\x10\x03\x12\x1e\n" +
+	"\x1aRESERVATION_STATUS_EXPIRED\x10\x04*\x8b\x01\n" +
 	"\bCategory\x12\x18\n" +
 	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
 	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
 	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
 	"\rCATEGORY_WING\x10\x04\x12\x13\n" +
-	"\x0fCATEGORY_SHIELD\x10\x052\xdb\x01\n" +
+	"\x0fCATEGORY_SHIELD\x10\x052\x91\x05\n" +
 	"\x10InventoryService\x12c\n" +
 	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/part/{uuid}\x12b\n" +
-	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/partBAZ?github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
+	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/part\x12v\n" +
+	"\fReserveParts\x12!.inventory.v1.ReservePartsRequest\x1a\".inventory.v1.ReservePartsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/reservations\x12\x9a\x01\n" +
+	"\x11CommitReservation\x12&.inventory.v1.CommitReservationRequest\x1a'.inventory.v1.CommitReservationResponse\"4\x82\xd3\xe4\x93\x02.\",/api/v1/reservations/{reservation_id}/commit\x12\x9e\x01\n" +
+	"\x12ReleaseReservation\x12'.inventory.v1.ReleaseReservationRequest\x1a(.inventory.v1.ReleaseReservationResponse\"5\x82\xd3\xe4\x93\x02/\"-/api/v1/reservations/{reservation_id}/releaseBAZ?github.com/xgmsx/rsf/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
 
 var (
 	file_v1_inventory_proto_rawDescOnce sync.Once
@@ -804,43 +1328,70 @@ func file_v1_inventory_proto_rawDescGZIP() []byte {
 	return file_v1_inventory_proto_rawDescData
 }
 
-var file_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_v1_inventory_proto_goTypes = []any{
-	(Category)(0),                 // 0: inventory.v1.Category
-	(*GetPartRequest)(nil),        // 1: inventory.v1.GetPartRequest
-	(*GetPartResponse)(nil),       // 2: inventory.v1.GetPartResponse
-	(*ListPartsRequest)(nil),      // 3: inventory.v1.ListPartsRequest
-	(*ListPartsResponse)(nil),     // 4: inventory.v1.ListPartsResponse
-	(*PartsFilter)(nil),           // 5: inventory.v1.PartsFilter
-	(*Part)(nil),                  // 6: inventory.v1.Part
-	(*Dimensions)(nil),            // 7: inventory.v1.Dimensions
-	(*Manufacturer)(nil),          // 8: inventory.v1.Manufacturer
-	(*Value)(nil),                 // 9: inventory.v1.Value
-	nil,                           // 10: inventory.v1.Part.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(ReservationStatus)(0),             // 0: inventory.v1.ReservationStatus
+	(Category)(0),                      // 1: inventory.v1.Category
+	(*GetPartRequest)(nil),             // 2: inventory.v1.GetPartRequest
+	(*GetPartResponse)(nil),            // 3: inventory.v1.GetPartResponse
+	(*ListPartsRequest)(nil),           // 4: inventory.v1.ListPartsRequest
+	(*ListPartsResponse)(nil),          // 5: inventory.v1.ListPartsResponse
+	(*ReservePartsRequest)(nil),        // 6: inventory.v1.ReservePartsRequest
+	(*ReservePartsResponse)(nil),       // 7: inventory.v1.ReservePartsResponse
+	(*CommitReservationRequest)(nil),   // 8: inventory.v1.CommitReservationRequest
+	(*CommitReservationResponse)(nil),  // 9: inventory.v1.CommitReservationResponse
+	(*ReleaseReservationRequest)(nil),  // 10: inventory.v1.ReleaseReservationRequest
+	(*ReleaseReservationResponse)(nil), // 11: inventory.v1.ReleaseReservationResponse
+	(*Reservation)(nil),                // 12: inventory.v1.Reservation
+	(*ReservationItem)(nil),            // 13: inventory.v1.ReservationItem
+	(*PartsFilter)(nil),                // 14: inventory.v1.PartsFilter
+	(*Part)(nil),                       // 15: inventory.v1.Part
+	(*Dimensions)(nil),                 // 16: inventory.v1.Dimensions
+	(*Manufacturer)(nil),               // 17: inventory.v1.Manufacturer
+	(*Value)(nil),                      // 18: inventory.v1.Value
+	nil,                                // 19: inventory.v1.Part.MetadataEntry
+	(*durationpb.Duration)(nil),        // 20: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),      // 21: google.protobuf.Timestamp
+	(*v1.Money)(nil),                   // 22: common.v1.Money
 }
 var file_v1_inventory_proto_depIdxs = []int32{
-	6,  // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
-	5,  // 1: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
-	6,  // 2: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
-	0,  // 3: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
-	0,  // 4: inventory.v1.Part.category:type_name -> inventory.v1.Category
-	7,  // 5: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
-	8,  // 6: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
-	10, // 7: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
-	11, // 8: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
-	11, // 9: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 10: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
-	1,  // 11: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
-	3,  // 12: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
-	2,  // 13: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
-	4,  // 14: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	15, // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
+	14, // 1: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	15, // 2: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
+	13, // 3: inventory.v1.ReservePartsRequest.items:type_name -> inventory.v1.ReservationItem
+	20, // 4: inventory.v1.ReservePartsRequest.ttl:type_name -> google.protobuf.Duration
+	12, // 5: inventory.v1.ReservePartsResponse.reservation:type_name -> inventory.v1.Reservation
+	12, // 6: inventory.v1.CommitReservationResponse.reservation:type_name -> inventory.v1.Reservation
+	12, // 7: inventory.v1.ReleaseReservationResponse.reservation:type_name -> inventory.v1.Reservation
+	13, // 8: inventory.v1.Reservation.items:type_name -> inventory.v1.ReservationItem
+	0,  // 9: inventory.v1.Reservation.status:type_name -> inventory.v1.ReservationStatus
+	21, // 10: inventory.v1.Reservation.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 11: inventory.v1.Reservation.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 12: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
+	1,  // 13: inventory.v1.Part.category:type_name -> inventory.v1.Category
+	16, // 14: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
+	17, // 15: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
+	19, // 16: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
+	21, // 17: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
+	21, // 18: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
+	22, // 19: inventory.v1.Part.price_money:type_name -> common.v1.Money
+	18, // 20: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
+	2,  // 21: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
+	4,  // 22: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
+	6,  // 23: inventory.v1.InventoryService.ReserveParts:input_type -> inventory.v1.ReservePartsRequest
+	8,  // 24: inventory.v1.InventoryService.CommitReservation:input_type -> inventory.v1.CommitReservationRequest
+	10, // 25: inventory.v1.InventoryService.ReleaseReservation:input_type -> inventory.v1.ReleaseReservationRequest
+	3,  // 26: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
+	5,  // 27: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
+	7,  // 28: inventory.v1.InventoryService.ReserveParts:output_type -> inventory.v1.ReservePartsResponse
+	9,  // 29: inventory.v1.InventoryService.CommitReservation:output_type -> inventory.v1.CommitReservationResponse
+	11, // 30: inventory.v1.InventoryService.ReleaseReservation:output_type -> inventory.v1.ReleaseReservationResponse
+	26, // [26:31] is the sub-list for method output_type
+	21, // [21:26] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_v1_inventory_proto_init() }
@@ -848,7 +1399,7 @@ func file_v1_inventory_proto_init() {
 	if File_v1_inventory_proto != nil {
 		return
 	}
-	file_v1_inventory_proto_msgTypes[8].OneofWrappers = []any{
+	file_v1_inventory_proto_msgTypes[16].OneofWrappers = []any{
 		(*Value_StringValue)(nil),
 		(*Value_Int64Value)(nil),
 		(*Value_DoubleValue)(nil),
@@ -859,8 +1410,8 @@ func file_v1_inventory_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_inventory_proto_rawDesc), len(file_v1_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
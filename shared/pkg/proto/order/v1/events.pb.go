@@ -0,0 +1,801 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: v1/events.proto
+
+// Package order.v1 содержит события жизненного цикла заказа,
+// которые сервис Order публикует в брокер сообщений
+
+package order_v1
+
+import (
+	v1 "github.com/xgmsx/rsf/shared/pkg/proto/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Способ оплаты
+type PaymentMethod int32
+
+const (
+	PaymentMethod_PAYMENT_METHOD_UNSPECIFIED    PaymentMethod = 0
+	PaymentMethod_PAYMENT_METHOD_CARD           PaymentMethod = 1
+	PaymentMethod_PAYMENT_METHOD_SBP            PaymentMethod = 2
+	PaymentMethod_PAYMENT_METHOD_CREDIT_CARD    PaymentMethod = 3
+	PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY PaymentMethod = 4
+)
+
+// Enum value maps for PaymentMethod.
+var (
+	PaymentMethod_name = map[int32]string{
+		0: "PAYMENT_METHOD_UNSPECIFIED",
+		1: "PAYMENT_METHOD_CARD",
+		2: "PAYMENT_METHOD_SBP",
+		3: "PAYMENT_METHOD_CREDIT_CARD",
+		4: "PAYMENT_METHOD_INVESTOR_MONEY",
+	}
+	PaymentMethod_value = map[string]int32{
+		"PAYMENT_METHOD_UNSPECIFIED":    0,
+		"PAYMENT_METHOD_CARD":           1,
+		"PAYMENT_METHOD_SBP":            2,
+		"PAYMENT_METHOD_CREDIT_CARD":    3,
+		"PAYMENT_METHOD_INVESTOR_MONEY": 4,
+	}
+)
+
+func (x PaymentMethod) Enum() *PaymentMethod {
+	p := new(PaymentMethod)
+	*p = x
+	return p
+}
+
+func (x PaymentMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_events_proto_enumTypes[0].Descriptor()
+}
+
+func (PaymentMethod) Type() protoreflect.EnumType {
+	return &file_v1_events_proto_enumTypes[0]
+}
+
+func (x PaymentMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentMethod.Descriptor instead.
+func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
+	return file_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+// Конверт события заказа. Доставка выполняется по схеме at-least-once,
+// поэтому потребители должны отбрасывать повторы по event_uuid
+type OrderEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор события
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	// UUID заказа, используется как ключ сообщения
+	OrderUuid string `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	// Время, когда произошло событие
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*OrderEvent_OrderCreated
+	//	*OrderEvent_OrderPaid
+	//	*OrderEvent_OrderCancelled
+	//	*OrderEvent_OrderRefunded
+	//	*OrderEvent_OrderExpired
+	Payload       isOrderEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	mi := &file_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEvent) ProtoMessage() {}
+
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrderEvent) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetPayload() isOrderEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetOrderCreated() *OrderCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*OrderEvent_OrderCreated); ok {
+			return x.OrderCreated
+		}
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetOrderPaid() *OrderPaid {
+	if x != nil {
+		if x, ok := x.Payload.(*OrderEvent_OrderPaid); ok {
+			return x.OrderPaid
+		}
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetOrderCancelled() *OrderCancelled {
+	if x != nil {
+		if x, ok := x.Payload.(*OrderEvent_OrderCancelled); ok {
+			return x.OrderCancelled
+		}
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetOrderRefunded() *OrderRefunded {
+	if x != nil {
+		if x, ok := x.Payload.(*OrderEvent_OrderRefunded); ok {
+			return x.OrderRefunded
+		}
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetOrderExpired() *OrderExpired {
+	if x != nil {
+		if x, ok := x.Payload.(*OrderEvent_OrderExpired); ok {
+			return x.OrderExpired
+		}
+	}
+	return nil
+}
+
+type isOrderEvent_Payload interface {
+	isOrderEvent_Payload()
+}
+
+type OrderEvent_OrderCreated struct {
+	OrderCreated *OrderCreated `protobuf:"bytes,10,opt,name=order_created,json=orderCreated,proto3,oneof"`
+}
+
+type OrderEvent_OrderPaid struct {
+	OrderPaid *OrderPaid `protobuf:"bytes,11,opt,name=order_paid,json=orderPaid,proto3,oneof"`
+}
+
+type OrderEvent_OrderCancelled struct {
+	OrderCancelled *OrderCancelled `protobuf:"bytes,12,opt,name=order_cancelled,json=orderCancelled,proto3,oneof"`
+}
+
+type OrderEvent_OrderRefunded struct {
+	OrderRefunded *OrderRefunded `protobuf:"bytes,13,opt,name=order_refunded,json=orderRefunded,proto3,oneof"`
+}
+
+type OrderEvent_OrderExpired struct {
+	OrderExpired *OrderExpired `protobuf:"bytes,14,opt,name=order_expired,json=orderExpired,proto3,oneof"`
+}
+
+func (*OrderEvent_OrderCreated) isOrderEvent_Payload() {}
+
+func (*OrderEvent_OrderPaid) isOrderEvent_Payload() {}
+
+func (*OrderEvent_OrderCancelled) isOrderEvent_Payload() {}
+
+func (*OrderEvent_OrderRefunded) isOrderEvent_Payload() {}
+
+func (*OrderEvent_OrderExpired) isOrderEvent_Payload() {}
+
+// Заказ создан и ожидает оплаты
+type OrderCreated struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid        string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Items           []*OrderItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	TotalPrice      *v1.Money              `protobuf:"bytes,3,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	PaymentDeadline *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=payment_deadline,json=paymentDeadline,proto3" json:"payment_deadline,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrderCreated) Reset() {
+	*x = OrderCreated{}
+	mi := &file_v1_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCreated) ProtoMessage() {}
+
+func (x *OrderCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCreated.ProtoReflect.Descriptor instead.
+func (*OrderCreated) Descriptor() ([]byte, []int) {
+	return file_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderCreated) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderCreated) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OrderCreated) GetTotalPrice() *v1.Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+func (x *OrderCreated) GetPaymentDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaymentDeadline
+	}
+	return nil
+}
+
+// Заказ оплачен
+type OrderPaid struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid        string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	TransactionUuid string                 `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	PaymentMethod   PaymentMethod          `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=order.v1.PaymentMethod" json:"payment_method,omitempty"`
+	Amount          *v1.Money              `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrderPaid) Reset() {
+	*x = OrderPaid{}
+	mi := &file_v1_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderPaid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderPaid) ProtoMessage() {}
+
+func (x *OrderPaid) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderPaid.ProtoReflect.Descriptor instead.
+func (*OrderPaid) Descriptor() ([]byte, []int) {
+	return file_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OrderPaid) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderPaid) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *OrderPaid) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *OrderPaid) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// Заказ отменен пользователем
+type OrderCancelled struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderCancelled) Reset() {
+	*x = OrderCancelled{}
+	mi := &file_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderCancelled) ProtoMessage() {}
+
+func (x *OrderCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderCancelled.ProtoReflect.Descriptor instead.
+func (*OrderCancelled) Descriptor() ([]byte, []int) {
+	return file_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderCancelled) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderCancelled) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Заказ не оплачен до крайнего срока и истек, резерв деталей снят
+type OrderExpired struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid        string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PaymentDeadline *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=payment_deadline,json=paymentDeadline,proto3" json:"payment_deadline,omitempty"`
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrderExpired) Reset() {
+	*x = OrderExpired{}
+	mi := &file_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderExpired) ProtoMessage() {}
+
+func (x *OrderExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderExpired.ProtoReflect.Descriptor instead.
+func (*OrderExpired) Descriptor() ([]byte, []int) {
+	return file_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderExpired) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderExpired) GetPaymentDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaymentDeadline
+	}
+	return nil
+}
+
+func (x *OrderExpired) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// По оплаченному заказу проведен возврат
+type OrderRefunded struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid        string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	TransactionUuid string                 `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	RefundUuid      string                 `protobuf:"bytes,3,opt,name=refund_uuid,json=refundUuid,proto3" json:"refund_uuid,omitempty"`
+	// Сумма этого возврата
+	Amount *v1.Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Сумма всех возвратов по заказу
+	TotalRefunded *v1.Money `protobuf:"bytes,5,opt,name=total_refunded,json=totalRefunded,proto3" json:"total_refunded,omitempty"`
+	// Возвращена вся сумма оплаты, детали вернулись на склад
+	FullyRefunded bool   `protobuf:"varint,6,opt,name=fully_refunded,json=fullyRefunded,proto3" json:"fully_refunded,omitempty"`
+	Reason        string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderRefunded) Reset() {
+	*x = OrderRefunded{}
+	mi := &file_v1_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderRefunded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderRefunded) ProtoMessage() {}
+
+func (x *OrderRefunded) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderRefunded.ProtoReflect.Descriptor instead.
+func (*OrderRefunded) Descriptor() ([]byte, []int) {
+	return file_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OrderRefunded) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *OrderRefunded) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *OrderRefunded) GetRefundUuid() string {
+	if x != nil {
+		return x.RefundUuid
+	}
+	return ""
+}
+
+func (x *OrderRefunded) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *OrderRefunded) GetTotalRefunded() *v1.Money {
+	if x != nil {
+		return x.TotalRefunded
+	}
+	return nil
+}
+
+func (x *OrderRefunded) GetFullyRefunded() bool {
+	if x != nil {
+		return x.FullyRefunded
+	}
+	return false
+}
+
+func (x *OrderRefunded) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Позиция заказа
+type OrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     *v1.Money              `protobuf:"bytes,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_v1_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OrderItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetUnitPrice() *v1.Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *OrderItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_v1_events_proto protoreflect.FileDescriptor
+
+const file_v1_events_proto_rawDesc = "" +
+	"\n" +
+	"\x0fv1/events.proto\x12\border.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15common/v1/money.proto\"\xcd\x03\n" +
+	"\n" +
+	"OrderEvent\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12=\n" +
+	"\rorder_created\x18\n" +
+	" \x01(\v2\x16.order.v1.OrderCreatedH\x00R\forderCreated\x124\n" +
+	"\n" +
+	"order_paid\x18\v \x01(\v2\x13.order.v1.OrderPaidH\x00R\torderPaid\x12C\n" +
+	"\x0forder_cancelled\x18\f \x01(\v2\x18.order.v1.OrderCancelledH\x00R\x0eorderCancelled\x12@\n" +
+	"\x0eorder_refunded\x18\r \x01(\v2\x17.order.v1.OrderRefundedH\x00R\rorderRefunded\x12=\n" +
+	"\rorder_expired\x18\x0e \x01(\v2\x16.order.v1.OrderExpiredH\x00R\forderExpiredB\t\n" +
+	"\apayload\"\xd0\x01\n" +
+	"\fOrderCreated\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12)\n" +
+	"\x05items\x18\x02 \x03(\v2\x13.order.v1.OrderItemR\x05items\x121\n" +
+	"\vtotal_price\x18\x03 \x01(\v2\x10.common.v1.MoneyR\n" +
+	"totalPrice\x12E\n" +
+	"\x10payment_deadline\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0fpaymentDeadline\"\xbd\x01\n" +
+	"\tOrderPaid\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tR\x0ftransactionUuid\x12>\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x17.order.v1.PaymentMethodR\rpaymentMethod\x12(\n" +
+	"\x06amount\x18\x04 \x01(\v2\x10.common.v1.MoneyR\x06amount\"E\n" +
+	"\x0eOrderCancelled\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x8a\x01\n" +
+	"\fOrderExpired\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12E\n" +
+	"\x10payment_deadline\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0fpaymentDeadline\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9a\x02\n" +
+	"\rOrderRefunded\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tR\x0ftransactionUuid\x12\x1f\n" +
+	"\vrefund_uuid\x18\x03 \x01(\tR\n" +
+	"refundUuid\x12(\n" +
+	"\x06amount\x18\x04 \x01(\v2\x10.common.v1.MoneyR\x06amount\x127\n" +
+	"\x0etotal_refunded\x18\x05 \x01(\v2\x10.common.v1.MoneyR\rtotalRefunded\x12%\n" +
+	"\x0efully_refunded\x18\x06 \x01(\bR\rfullyRefunded\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"\x89\x01\n" +
+	"\tOrderItem\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12/\n" +
+	"\n" +
+	"unit_price\x18\x03 \x01(\v2\x10.common.v1.MoneyR\tunitPrice\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name*\xa3\x01\n" +
+	"\rPaymentMethod\x12\x1e\n" +
+	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13PAYMENT_METHOD_CARD\x10\x01\x12\x16\n" +
+	"\x12PAYMENT_METHOD_SBP\x10\x02\x12\x1e\n" +
+	"\x1aPAYMENT_METHOD_CREDIT_CARD\x10\x03\x12!\n" +
+	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x04B9Z7github.com/xgmsx/rsf/shared/pkg/proto/order/v1;order_v1b\x06proto3"
+
+var (
+	file_v1_events_proto_rawDescOnce sync.Once
+	file_v1_events_proto_rawDescData []byte
+)
+
+func file_v1_events_proto_rawDescGZIP() []byte {
+	file_v1_events_proto_rawDescOnce.Do(func() {
+		file_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_events_proto_rawDesc), len(file_v1_events_proto_rawDesc)))
+	})
+	return file_v1_events_proto_rawDescData
+}
+
+var file_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_v1_events_proto_goTypes = []any{
+	(PaymentMethod)(0),            // 0: order.v1.PaymentMethod
+	(*OrderEvent)(nil),            // 1: order.v1.OrderEvent
+	(*OrderCreated)(nil),          // 2: order.v1.OrderCreated
+	(*OrderPaid)(nil),             // 3: order.v1.OrderPaid
+	(*OrderCancelled)(nil),        // 4: order.v1.OrderCancelled
+	(*OrderExpired)(nil),          // 5: order.v1.OrderExpired
+	(*OrderRefunded)(nil),         // 6: order.v1.OrderRefunded
+	(*OrderItem)(nil),             // 7: order.v1.OrderItem
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*v1.Money)(nil),              // 9: common.v1.Money
+}
+var file_v1_events_proto_depIdxs = []int32{
+	8,  // 0: order.v1.OrderEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	2,  // 1: order.v1.OrderEvent.order_created:type_name -> order.v1.OrderCreated
+	3,  // 2: order.v1.OrderEvent.order_paid:type_name -> order.v1.OrderPaid
+	4,  // 3: order.v1.OrderEvent.order_cancelled:type_name -> order.v1.OrderCancelled
+	6,  // 4: order.v1.OrderEvent.order_refunded:type_name -> order.v1.OrderRefunded
+	5,  // 5: order.v1.OrderEvent.order_expired:type_name -> order.v1.OrderExpired
+	7,  // 6: order.v1.OrderCreated.items:type_name -> order.v1.OrderItem
+	9,  // 7: order.v1.OrderCreated.total_price:type_name -> common.v1.Money
+	8,  // 8: order.v1.OrderCreated.payment_deadline:type_name -> google.protobuf.Timestamp
+	0,  // 9: order.v1.OrderPaid.payment_method:type_name -> order.v1.PaymentMethod
+	9,  // 10: order.v1.OrderPaid.amount:type_name -> common.v1.Money
+	8,  // 11: order.v1.OrderExpired.payment_deadline:type_name -> google.protobuf.Timestamp
+	9,  // 12: order.v1.OrderRefunded.amount:type_name -> common.v1.Money
+	9,  // 13: order.v1.OrderRefunded.total_refunded:type_name -> common.v1.Money
+	9,  // 14: order.v1.OrderItem.unit_price:type_name -> common.v1.Money
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_v1_events_proto_init() }
+func file_v1_events_proto_init() {
+	if File_v1_events_proto != nil {
+		return
+	}
+	file_v1_events_proto_msgTypes[0].OneofWrappers = []any{
+		(*OrderEvent_OrderCreated)(nil),
+		(*OrderEvent_OrderPaid)(nil),
+		(*OrderEvent_OrderCancelled)(nil),
+		(*OrderEvent_OrderRefunded)(nil),
+		(*OrderEvent_OrderExpired)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_events_proto_rawDesc), len(file_v1_events_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_events_proto_goTypes,
+		DependencyIndexes: file_v1_events_proto_depIdxs,
+		EnumInfos:         file_v1_events_proto_enumTypes,
+		MessageInfos:      file_v1_events_proto_msgTypes,
+	}.Build()
+	File_v1_events_proto = out.File
+	file_v1_events_proto_goTypes = nil
+	file_v1_events_proto_depIdxs = nil
+}
@@ -0,0 +1,1243 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: v1/events.proto
+
+package order_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on OrderEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderEventMultiError, or
+// nil if none found.
+func (m *OrderEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventUuid
+
+	// no validation rules for OrderUuid
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Payload.(type) {
+	case *OrderEvent_OrderCreated:
+		if v == nil {
+			err := OrderEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOrderCreated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "OrderCreated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "OrderCreated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOrderCreated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderEventValidationError{
+					field:  "OrderCreated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *OrderEvent_OrderPaid:
+		if v == nil {
+			err := OrderEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOrderPaid()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "OrderPaid",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "OrderPaid",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOrderPaid()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderEventValidationError{
+					field:  "OrderPaid",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *OrderEvent_OrderCancelled:
+		if v == nil {
+			err := OrderEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOrderCancelled()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "OrderCancelled",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "OrderCancelled",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOrderCancelled()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderEventValidationError{
+					field:  "OrderCancelled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *OrderEvent_OrderRefunded:
+		if v == nil {
+			err := OrderEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOrderRefunded()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "OrderRefunded",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "OrderRefunded",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOrderRefunded()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderEventValidationError{
+					field:  "OrderRefunded",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *OrderEvent_OrderExpired:
+		if v == nil {
+			err := OrderEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOrderExpired()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "OrderExpired",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "OrderExpired",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOrderExpired()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderEventValidationError{
+					field:  "OrderExpired",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return OrderEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderEventMultiError is an error wrapping multiple validation errors
+// returned by OrderEvent.ValidateAll() if the designated constraints aren't met.
+type OrderEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderEventMultiError) AllErrors() []error { return m }
+
+// OrderEventValidationError is the validation error returned by
+// OrderEvent.Validate if the designated constraints aren't met.
+type OrderEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderEventValidationError) ErrorName() string { return "OrderEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderEventValidationError{}
+
+// Validate checks the field values on OrderCreated with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderCreated) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCreated with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderCreatedMultiError, or
+// nil if none found.
+func (m *OrderCreated) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCreated) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserUuid
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderCreatedValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderCreatedValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderCreatedValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotalPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderCreatedValidationError{
+					field:  "TotalPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderCreatedValidationError{
+					field:  "TotalPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotalPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderCreatedValidationError{
+				field:  "TotalPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPaymentDeadline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderCreatedValidationError{
+					field:  "PaymentDeadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderCreatedValidationError{
+					field:  "PaymentDeadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPaymentDeadline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderCreatedValidationError{
+				field:  "PaymentDeadline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderCreatedMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderCreatedMultiError is an error wrapping multiple validation errors
+// returned by OrderCreated.ValidateAll() if the designated constraints aren't met.
+type OrderCreatedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCreatedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCreatedMultiError) AllErrors() []error { return m }
+
+// OrderCreatedValidationError is the validation error returned by
+// OrderCreated.Validate if the designated constraints aren't met.
+type OrderCreatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCreatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCreatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCreatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCreatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCreatedValidationError) ErrorName() string { return "OrderCreatedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderCreatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCreated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCreatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCreatedValidationError{}
+
+// Validate checks the field values on OrderPaid with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderPaid) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderPaid with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderPaidMultiError, or nil
+// if none found.
+func (m *OrderPaid) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderPaid) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserUuid
+
+	// no validation rules for TransactionUuid
+
+	// no validation rules for PaymentMethod
+
+	if all {
+		switch v := interface{}(m.GetAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderPaidValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderPaidValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderPaidValidationError{
+				field:  "Amount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderPaidMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderPaidMultiError is an error wrapping multiple validation errors returned
+// by OrderPaid.ValidateAll() if the designated constraints aren't met.
+type OrderPaidMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderPaidMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderPaidMultiError) AllErrors() []error { return m }
+
+// OrderPaidValidationError is the validation error returned by
+// OrderPaid.Validate if the designated constraints aren't met.
+type OrderPaidValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderPaidValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderPaidValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderPaidValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderPaidValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderPaidValidationError) ErrorName() string { return "OrderPaidValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderPaidValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderPaid.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderPaidValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderPaidValidationError{}
+
+// Validate checks the field values on OrderCancelled with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderCancelled) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderCancelled with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderCancelledMultiError,
+// or nil if none found.
+func (m *OrderCancelled) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderCancelled) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserUuid
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return OrderCancelledMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderCancelledMultiError is an error wrapping multiple validation errors
+// returned by OrderCancelled.ValidateAll() if the designated constraints
+// aren't met.
+type OrderCancelledMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderCancelledMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderCancelledMultiError) AllErrors() []error { return m }
+
+// OrderCancelledValidationError is the validation error returned by
+// OrderCancelled.Validate if the designated constraints aren't met.
+type OrderCancelledValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderCancelledValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderCancelledValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderCancelledValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderCancelledValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderCancelledValidationError) ErrorName() string { return "OrderCancelledValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderCancelledValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderCancelled.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderCancelledValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderCancelledValidationError{}
+
+// Validate checks the field values on OrderExpired with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderExpired) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderExpired with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderExpiredMultiError, or
+// nil if none found.
+func (m *OrderExpired) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderExpired) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserUuid
+
+	if all {
+		switch v := interface{}(m.GetPaymentDeadline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderExpiredValidationError{
+					field:  "PaymentDeadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderExpiredValidationError{
+					field:  "PaymentDeadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPaymentDeadline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderExpiredValidationError{
+				field:  "PaymentDeadline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return OrderExpiredMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderExpiredMultiError is an error wrapping multiple validation errors
+// returned by OrderExpired.ValidateAll() if the designated constraints aren't met.
+type OrderExpiredMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderExpiredMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderExpiredMultiError) AllErrors() []error { return m }
+
+// OrderExpiredValidationError is the validation error returned by
+// OrderExpired.Validate if the designated constraints aren't met.
+type OrderExpiredValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderExpiredValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderExpiredValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderExpiredValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderExpiredValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderExpiredValidationError) ErrorName() string { return "OrderExpiredValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderExpiredValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderExpired.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderExpiredValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderExpiredValidationError{}
+
+// Validate checks the field values on OrderRefunded with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderRefunded) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderRefunded with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderRefundedMultiError, or
+// nil if none found.
+func (m *OrderRefunded) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderRefunded) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserUuid
+
+	// no validation rules for TransactionUuid
+
+	// no validation rules for RefundUuid
+
+	if all {
+		switch v := interface{}(m.GetAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderRefundedValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderRefundedValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderRefundedValidationError{
+				field:  "Amount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotalRefunded()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderRefundedValidationError{
+					field:  "TotalRefunded",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderRefundedValidationError{
+					field:  "TotalRefunded",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotalRefunded()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderRefundedValidationError{
+				field:  "TotalRefunded",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FullyRefunded
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return OrderRefundedMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderRefundedMultiError is an error wrapping multiple validation errors
+// returned by OrderRefunded.ValidateAll() if the designated constraints
+// aren't met.
+type OrderRefundedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderRefundedMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderRefundedMultiError) AllErrors() []error { return m }
+
+// OrderRefundedValidationError is the validation error returned by
+// OrderRefunded.Validate if the designated constraints aren't met.
+type OrderRefundedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderRefundedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderRefundedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderRefundedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderRefundedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderRefundedValidationError) ErrorName() string { return "OrderRefundedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderRefundedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderRefunded.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderRefundedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderRefundedValidationError{}
+
+// Validate checks the field values on OrderItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderItemMultiError, or nil
+// if none found.
+func (m *OrderItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartUuid
+
+	// no validation rules for Quantity
+
+	if all {
+		switch v := interface{}(m.GetUnitPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderItemValidationError{
+					field:  "UnitPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderItemValidationError{
+					field:  "UnitPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUnitPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderItemValidationError{
+				field:  "UnitPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return OrderItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderItemMultiError is an error wrapping multiple validation errors returned
+// by OrderItem.ValidateAll() if the designated constraints aren't met.
+type OrderItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderItemMultiError) AllErrors() []error { return m }
+
+// OrderItemValidationError is the validation error returned by
+// OrderItem.Validate if the designated constraints aren't met.
+type OrderItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderItemValidationError) ErrorName() string { return "OrderItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderItemValidationError{}
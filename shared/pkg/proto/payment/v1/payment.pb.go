@@ -9,6 +9,8 @@
 package payment_v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	v1 "github.com/xgmsx/rsf/shared/pkg/proto/common/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -82,10 +84,13 @@ func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
 
 // Запрос на оплату заказа
 type PayOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
-	UserUuid      string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
-	PaymentMethod PaymentMethod          `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid  string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Метод оплаты, PAYMENT_METHOD_UNSPECIFIED не допускается
+	PaymentMethod PaymentMethod `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Сумма к оплате. Старые клиенты ее не передают
+	Amount        *v1.Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -141,6 +146,13 @@ func (x *PayOrderRequest) GetPaymentMethod() PaymentMethod {
 	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
 }
 
+func (x *PayOrderRequest) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
 // Ответ на запрос оплаты заказа
 type PayOrderResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -186,27 +198,178 @@ func (x *PayOrderResponse) GetTransactionUuid() string {
 	return ""
 }
 
+// Запрос на возврат средств
+type RefundPaymentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// Сумма возврата. Если не указана, возвращается весь остаток платежа
+	Amount *v1.Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Причина возврата
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Идентификатор возврата, выбранный клиентом. Повторный запрос с тем же
+	// идентификатором возвращает уже проведенный возврат. Если не указан,
+	// идентификатор генерирует payment
+	RefundUuid    string `protobuf:"bytes,4,opt,name=refund_uuid,json=refundUuid,proto3" json:"refund_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_v1_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RefundPaymentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RefundPaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetRefundUuid() string {
+	if x != nil {
+		return x.RefundUuid
+	}
+	return ""
+}
+
+// Ответ на запрос возврата средств
+type RefundPaymentResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	RefundUuid string                 `protobuf:"bytes,1,opt,name=refund_uuid,json=refundUuid,proto3" json:"refund_uuid,omitempty"`
+	// Сумма проведенного возврата
+	RefundedAmount *v1.Money `protobuf:"bytes,2,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	// Остаток платежа, который еще можно вернуть
+	RemainingAmount *v1.Money `protobuf:"bytes,3,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RefundPaymentResponse) Reset() {
+	*x = RefundPaymentResponse{}
+	mi := &file_v1_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentResponse) ProtoMessage() {}
+
+func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_v1_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RefundPaymentResponse) GetRefundUuid() string {
+	if x != nil {
+		return x.RefundUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentResponse) GetRefundedAmount() *v1.Money {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return nil
+}
+
+func (x *RefundPaymentResponse) GetRemainingAmount() *v1.Money {
+	if x != nil {
+		return x.RemainingAmount
+	}
+	return nil
+}
+
 var File_v1_payment_proto protoreflect.FileDescriptor
 
 const file_v1_payment_proto_rawDesc = "" +
 	"\n" +
 	"\x10v1/payment.proto\x12\n" +
-	"payment.v1\x1a\x1cgoogle/api/annotations.proto\"\x8f\x01\n" +
-	"\x0fPayOrderRequest\x12\x1d\n" +
+	"payment.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x15common/v1/money.proto\"\xd9\x01\n" +
+	"\x0fPayOrderRequest\x12'\n" +
 	"\n" +
-	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
-	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
-	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\"=\n" +
+	"order_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\torderUuid\x12%\n" +
+	"\tuser_uuid\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\buserUuid\x12L\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\rpaymentMethod\x12(\n" +
+	"\x06amount\x18\x04 \x01(\v2\x10.common.v1.MoneyR\x06amount\"=\n" +
 	"\x10PayOrderResponse\x12)\n" +
-	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid*\xa3\x01\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\"\xbb\x01\n" +
+	"\x14RefundPaymentRequest\x123\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x0ftransactionUuid\x12(\n" +
+	"\x06amount\x18\x02 \x01(\v2\x10.common.v1.MoneyR\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12,\n" +
+	"\vrefund_uuid\x18\x04 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\n" +
+	"refundUuid\"\xb0\x01\n" +
+	"\x15RefundPaymentResponse\x12\x1f\n" +
+	"\vrefund_uuid\x18\x01 \x01(\tR\n" +
+	"refundUuid\x129\n" +
+	"\x0frefunded_amount\x18\x02 \x01(\v2\x10.common.v1.MoneyR\x0erefundedAmount\x12;\n" +
+	"\x10remaining_amount\x18\x03 \x01(\v2\x10.common.v1.MoneyR\x0fremainingAmount*\xa3\x01\n" +
 	"\rPaymentMethod\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13PAYMENT_METHOD_CARD\x10\x01\x12\x16\n" +
 	"\x12PAYMENT_METHOD_SBP\x10\x02\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_CREDIT_CARD\x10\x03\x12!\n" +
-	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x042r\n" +
+	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x042\xe6\x01\n" +
 	"\x0ePaymentService\x12`\n" +
-	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponse\"\x19\x82\xd3\xe4\x93\x02\x13\"\x11/api/v1/order/payB=Z;github.com/xgmsx/rsf/shared/pkg/proto/payment/v1;payment_v1b\x06proto3"
+	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponse\"\x19\x82\xd3\xe4\x93\x02\x13\"\x11/api/v1/order/pay\x12r\n" +
+	"\rRefundPayment\x12 .payment.v1.RefundPaymentRequest\x1a!.payment.v1.RefundPaymentResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x14/api/v1/order/refundB=Z;github.com/xgmsx/rsf/shared/pkg/proto/payment/v1;payment_v1b\x06proto3"
 
 var (
 	file_v1_payment_proto_rawDescOnce sync.Once
@@ -221,21 +384,30 @@ func file_v1_payment_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_v1_payment_proto_goTypes = []any{
-	(PaymentMethod)(0),       // 0: payment.v1.PaymentMethod
-	(*PayOrderRequest)(nil),  // 1: payment.v1.PayOrderRequest
-	(*PayOrderResponse)(nil), // 2: payment.v1.PayOrderResponse
+	(PaymentMethod)(0),            // 0: payment.v1.PaymentMethod
+	(*PayOrderRequest)(nil),       // 1: payment.v1.PayOrderRequest
+	(*PayOrderResponse)(nil),      // 2: payment.v1.PayOrderResponse
+	(*RefundPaymentRequest)(nil),  // 3: payment.v1.RefundPaymentRequest
+	(*RefundPaymentResponse)(nil), // 4: payment.v1.RefundPaymentResponse
+	(*v1.Money)(nil),              // 5: common.v1.Money
 }
 var file_v1_payment_proto_depIdxs = []int32{
 	0, // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
-	1, // 1: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
-	2, // 2: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 1: payment.v1.PayOrderRequest.amount:type_name -> common.v1.Money
+	5, // 2: payment.v1.RefundPaymentRequest.amount:type_name -> common.v1.Money
+	5, // 3: payment.v1.RefundPaymentResponse.refunded_amount:type_name -> common.v1.Money
+	5, // 4: payment.v1.RefundPaymentResponse.remaining_amount:type_name -> common.v1.Money
+	1, // 5: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
+	3, // 6: payment.v1.PaymentService.RefundPayment:input_type -> payment.v1.RefundPaymentRequest
+	2, // 7: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
+	4, // 8: payment.v1.PaymentService.RefundPayment:output_type -> payment.v1.RefundPaymentResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_v1_payment_proto_init() }
@@ -249,7 +421,7 @@ func file_v1_payment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_payment_proto_rawDesc), len(file_v1_payment_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,111 @@
+// Package tracing настраивает распределенную трассировку OpenTelemetry:
+// экспорт спанов, передачу W3C trace context и инструментирование HTTP и gRPC.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+const (
+	ExporterOTLP    = "otlp"
+	ExporterConsole = "console"
+	ExporterNone    = "none"
+)
+
+// Config - настройки экспорта спанов. Имена переменных совпадают
+// со стандартными переменными окружения OpenTelemetry.
+type Config struct {
+	// Exporter - куда отправлять спаны: OTLP-коллектор, stdout или никуда.
+	// Trace context передается дальше при любом значении.
+	Exporter string `env:"OTEL_TRACES_EXPORTER" default:"none" oneof:"otlp console none"`
+	// Endpoint - адрес OTLP/gRPC коллектора
+	Endpoint string `env:"OTEL_EXPORTER_OTLP_ENDPOINT" default:"http://localhost:4317"`
+}
+
+// Init регистрирует глобальные TracerProvider сервиса serviceName и W3C propagator.
+// Возвращаемая функция отправляет накопленные спаны и останавливает провайдер.
+func Init(ctx context.Context, serviceName string, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch cfg.Exporter {
+	case ExporterOTLP:
+		exporter, err = otlptracegrpc.New(ctx, otlptracegrpc.WithEndpointURL(cfg.Endpoint))
+	case ExporterConsole:
+		exporter, err = stdouttrace.New()
+	default:
+		return func(context.Context) error { return nil }, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s trace exporter: %w", cfg.Exporter, err)
+	}
+
+	provider := NewProvider(serviceName, exporter)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// NewProvider создает TracerProvider, который пакетами отправляет спаны в exporter.
+// В тестах удобно передать tracetest.NewInMemoryExporter.
+func NewProvider(serviceName string, exporter sdktrace.SpanExporter) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+		)),
+	)
+}
+
+// ServerOption инструментирует gRPC сервер: каждый вызов получает спан,
+// продолжающий trace context из метаданных клиента
+func ServerOption() grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler())
+}
+
+// DialOption инструментирует gRPC клиент: каждая попытка вызова получает спан,
+// а trace context передается в метаданных traceparent
+func DialOption() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler())
+}
+
+// HTTPMiddleware создает спан на каждый HTTP-запрос, продолжая trace context
+// из заголовка traceparent. Если route не nil, после обработки запроса спан
+// называется по шаблону маршрута, например "GET /api/v1/orders/{order_uuid}/events".
+func HTTPMiddleware(service string, route func(*http.Request) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		named := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r)
+
+			if route == nil {
+				return
+			}
+			if pattern := route(r); pattern != "" {
+				span := trace.SpanFromContext(r.Context())
+				span.SetName(r.Method + " " + pattern)
+				span.SetAttributes(semconv.HTTPRoute(pattern))
+			}
+		})
+		return otelhttp.NewHandler(named, service)
+	}
+}
@@ -0,0 +1,103 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestTraceContextPropagation(t *testing.T) {
+	ctx := context.Background()
+
+	// Init без экспортера регистрирует только W3C propagator
+	shutdown, err := Init(ctx, "test", Config{Exporter: ExporterNone})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = shutdown(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := NewProvider("test", exporter)
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	// gRPC сервер и клиент, соединенные через память
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(ServerOption())
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		DialOption(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	client := healthpb.NewHealthClient(conn)
+
+	// HTTP обработчик вызывает gRPC сервер в контексте запроса
+	handler := HTTPMiddleware("test", func(*http.Request) string { return "/orders/{order_uuid}" })(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, callErr := client.Check(r.Context(), &healthpb.HealthCheckRequest{})
+			if callErr != nil {
+				t.Errorf("unexpected error: %v", callErr)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+	const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	req := httptest.NewRequest(http.MethodGet, "/orders/42", nil)
+	req.Header.Set("traceparent", traceparent)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if err = provider.ForceFlush(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("expected HTTP server, gRPC client and gRPC server spans, got %d", len(spans))
+	}
+	var httpSpan, clientSpan, serverSpan tracetest.SpanStub
+	for _, span := range spans {
+		if span.SpanContext.TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" {
+			t.Fatalf("span %q is not in the incoming trace: %s", span.Name, span.SpanContext.TraceID())
+		}
+		switch {
+		case span.Name == "GET /orders/{order_uuid}":
+			httpSpan = span
+		case span.SpanKind == trace.SpanKindClient:
+			clientSpan = span
+		case span.SpanKind == trace.SpanKindServer:
+			serverSpan = span
+		}
+	}
+
+	if httpSpan.Parent.SpanID().String() != "00f067aa0ba902b7" {
+		t.Fatalf("HTTP span is not a child of the incoming span: %+v", httpSpan.Parent)
+	}
+	if clientSpan.Name != "grpc.health.v1.Health/Check" || clientSpan.Parent.SpanID() != httpSpan.SpanContext.SpanID() {
+		t.Fatalf("unexpected gRPC client span %q", clientSpan.Name)
+	}
+	if serverSpan.Name != "grpc.health.v1.Health/Check" || serverSpan.Parent.SpanID() != clientSpan.SpanContext.SpanID() {
+		t.Fatalf("unexpected gRPC server span %q", serverSpan.Name)
+	}
+}